@@ -7,11 +7,12 @@
 package jobv1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -29,7 +30,9 @@ const (
 type JobType int32
 
 const (
-	JobType_TEST_JOB               JobType = 0
+	// 测试任务,记录任务参数,用于检查任务服务器是否正常
+	JobType_TEST_JOB JobType = 0
+	// 测试定时任务
 	JobType_TEST_CRON_JOB          JobType = 1
 	JobType_GOOGLE_INSTANCE_ADJUST JobType = 2
 	// 订阅自动续费
@@ -46,21 +49,27 @@ const (
 	JobType_CLEAN_USER_EXPIRED_POINTS JobType = 8
 	// 重置每日字符数
 	JobType_RESET_DAILY_CHARACTERS JobType = 9
+	// 清理过期的分片上传会话
+	JobType_CLEAN_EXPIRED_UPLOADS JobType = 10
+	// 处理上传的图片和视频
+	JobType_PROCESS_MEDIA JobType = 11
 )
 
 // Enum value maps for JobType.
 var (
 	JobType_name = map[int32]string{
-		0: "TEST_JOB",
-		1: "TEST_CRON_JOB",
-		2: "GOOGLE_INSTANCE_ADJUST",
-		3: "SUBSCRIPTION_RENEWAL",
-		4: "TASK_TIMEOUT_REFUND",
-		5: "UPDATE_VIDEO_SORT",
-		6: "CLEAN_USER_UPLOAD_FILES",
-		7: "CLEAN_OLD_LOG_FILES",
-		8: "CLEAN_USER_EXPIRED_POINTS",
-		9: "RESET_DAILY_CHARACTERS",
+		0:  "TEST_JOB",
+		1:  "TEST_CRON_JOB",
+		2:  "GOOGLE_INSTANCE_ADJUST",
+		3:  "SUBSCRIPTION_RENEWAL",
+		4:  "TASK_TIMEOUT_REFUND",
+		5:  "UPDATE_VIDEO_SORT",
+		6:  "CLEAN_USER_UPLOAD_FILES",
+		7:  "CLEAN_OLD_LOG_FILES",
+		8:  "CLEAN_USER_EXPIRED_POINTS",
+		9:  "RESET_DAILY_CHARACTERS",
+		10: "CLEAN_EXPIRED_UPLOADS",
+		11: "PROCESS_MEDIA",
 	}
 	JobType_value = map[string]int32{
 		"TEST_JOB":                  0,
@@ -73,6 +82,8 @@ var (
 		"CLEAN_OLD_LOG_FILES":       7,
 		"CLEAN_USER_EXPIRED_POINTS": 8,
 		"RESET_DAILY_CHARACTERS":    9,
+		"CLEAN_EXPIRED_UPLOADS":     10,
+		"PROCESS_MEDIA":             11,
 	}
 )
 
@@ -154,13 +165,14 @@ func (AdminJob) EnumDescriptor() ([]byte, []int) {
 type EnqueueRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	JobType   JobType                `protobuf:"varint,1,opt,name=job_type,json=jobType,proto3,enum=job.v1.JobType" json:"job_type,omitempty"` // 任务类型
-	Payload   []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`                                     // 任务参数
-	RetryTime int64                  `protobuf:"varint,3,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`               // 重试次数
+	Payload   []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`                                     // 任务参数，任务参数消息的 JSON 编码，HTTP 接口中为 base64
+	RetryTime int64                  `protobuf:"varint,3,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`               // 重试次数，为 0 时使用默认值
 	ProcessAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=process_at,json=processAt,proto3" json:"process_at,omitempty"`                // 任务执行时间
-	ProcessIn *durationpb.Duration   `protobuf:"bytes,5,opt,name=process_in,json=processIn,proto3" json:"process_in,omitempty"`
-	Deadline  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	ProcessIn *durationpb.Duration   `protobuf:"bytes,5,opt,name=process_in,json=processIn,proto3" json:"process_in,omitempty"`                // 延迟执行时间
+	Deadline  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`                                   // 任务执行截止时间
 	// 任务完成后保留时间
 	Retention     *durationpb.Duration `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"`
+	Queue         string               `protobuf:"bytes,8,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，可选 critical、default、low，默认使用任务类型的队列
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -244,6 +256,13 @@ func (x *EnqueueRequest) GetRetention() *durationpb.Duration {
 	return nil
 }
 
+func (x *EnqueueRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
 type EnqueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -291,6 +310,7 @@ func (x *EnqueueResponse) GetTaskId() string {
 type QueryTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskIds       []string               `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -332,6 +352,13 @@ func (x *QueryTasksRequest) GetTaskIds() []string {
 	return nil
 }
 
+func (x *QueryTasksRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
 type QueryTasksResponse struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Tasks         []*QueryTasksResponse_TaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -376,6 +403,539 @@ func (x *QueryTasksResponse) GetTasks() []*QueryTasksResponse_TaskInfo {
 	return nil
 }
 
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CancelTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+type RetryTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryTaskRequest) Reset() {
+	*x = RetryTaskRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryTaskRequest) ProtoMessage() {}
+
+func (x *RetryTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryTaskRequest.ProtoReflect.Descriptor instead.
+func (*RetryTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RetryTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RetryTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+type ListArchivedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`                        // 队列名，默认 default
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始，默认1
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedTasksRequest) Reset() {
+	*x = ListArchivedTasksRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedTasksRequest) ProtoMessage() {}
+
+func (x *ListArchivedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListArchivedTasksRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListArchivedTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListArchivedTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListArchivedTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*ArchivedTask        `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 队列中已归档的任务总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivedTasksResponse) Reset() {
+	*x = ListArchivedTasksResponse{}
+	mi := &file_api_job_v1_job_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivedTasksResponse) ProtoMessage() {}
+
+func (x *ListArchivedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListArchivedTasksResponse) GetTasks() []*ArchivedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListArchivedTasksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 已归档的任务
+type ArchivedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                                                  // 任务类型
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                                                                            // 任务参数消息的 JSON 编码，任务类型未知或无法解码时为空
+	RawPayload    []byte                 `protobuf:"bytes,5,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`                                                    // 任务参数无法解码时的原始内容
+	Retried       int32                  `protobuf:"varint,6,opt,name=retried,proto3" json:"retried,omitempty"`                                                                           // 已重试次数
+	MaxRetry      int32                  `protobuf:"varint,7,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`                                                         // 最大重试次数
+	LastErr       string                 `protobuf:"bytes,8,opt,name=last_err,json=lastErr,proto3" json:"last_err,omitempty"`                                                             // 最近一次失败原因
+	LastFailedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`                                            // 最近一次失败时间
+	Headers       map[string]string      `protobuf:"bytes,10,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 任务头，包括链路追踪信息和定时任务标识
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedTask) Reset() {
+	*x = ArchivedTask{}
+	mi := &file_api_job_v1_job_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedTask) ProtoMessage() {}
+
+func (x *ArchivedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedTask.ProtoReflect.Descriptor instead.
+func (*ArchivedTask) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArchivedTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ArchivedTask) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ArchivedTask) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ArchivedTask) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ArchivedTask) GetRawPayload() []byte {
+	if x != nil {
+		return x.RawPayload
+	}
+	return nil
+}
+
+func (x *ArchivedTask) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *ArchivedTask) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *ArchivedTask) GetLastErr() string {
+	if x != nil {
+		return x.LastErr
+	}
+	return ""
+}
+
+func (x *ArchivedTask) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *ArchivedTask) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type GetArchivedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchivedTaskRequest) Reset() {
+	*x = GetArchivedTaskRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchivedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivedTaskRequest) ProtoMessage() {}
+
+func (x *GetArchivedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivedTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetArchivedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetArchivedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+type RequeueArchivedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueArchivedTaskRequest) Reset() {
+	*x = RequeueArchivedTaskRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueArchivedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueArchivedTaskRequest) ProtoMessage() {}
+
+func (x *RequeueArchivedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueArchivedTaskRequest.ProtoReflect.Descriptor instead.
+func (*RequeueArchivedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequeueArchivedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RequeueArchivedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+type RequeueArchivedTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // 新任务的ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueArchivedTaskResponse) Reset() {
+	*x = RequeueArchivedTaskResponse{}
+	mi := &file_api_job_v1_job_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueArchivedTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueArchivedTaskResponse) ProtoMessage() {}
+
+func (x *RequeueArchivedTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueArchivedTaskResponse.ProtoReflect.Descriptor instead.
+func (*RequeueArchivedTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequeueArchivedTaskResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type DeleteArchivedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArchivedTaskRequest) Reset() {
+	*x = DeleteArchivedTaskRequest{}
+	mi := &file_api_job_v1_job_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArchivedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArchivedTaskRequest) ProtoMessage() {}
+
+func (x *DeleteArchivedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArchivedTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArchivedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteArchivedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DeleteArchivedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
 // 任务超时积分退还
 type PayLoadTaskTimeout struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -386,7 +946,7 @@ type PayLoadTaskTimeout struct {
 
 func (x *PayLoadTaskTimeout) Reset() {
 	*x = PayLoadTaskTimeout{}
-	mi := &file_api_job_v1_job_proto_msgTypes[4]
+	mi := &file_api_job_v1_job_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +958,7 @@ func (x *PayLoadTaskTimeout) String() string {
 func (*PayLoadTaskTimeout) ProtoMessage() {}
 
 func (x *PayLoadTaskTimeout) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[4]
+	mi := &file_api_job_v1_job_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +971,7 @@ func (x *PayLoadTaskTimeout) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayLoadTaskTimeout.ProtoReflect.Descriptor instead.
 func (*PayLoadTaskTimeout) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{4}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PayLoadTaskTimeout) GetTaskId() int32 {
@@ -430,7 +990,7 @@ type PayLoadTest struct {
 
 func (x *PayLoadTest) Reset() {
 	*x = PayLoadTest{}
-	mi := &file_api_job_v1_job_proto_msgTypes[5]
+	mi := &file_api_job_v1_job_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +1002,7 @@ func (x *PayLoadTest) String() string {
 func (*PayLoadTest) ProtoMessage() {}
 
 func (x *PayLoadTest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[5]
+	mi := &file_api_job_v1_job_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +1015,7 @@ func (x *PayLoadTest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayLoadTest.ProtoReflect.Descriptor instead.
 func (*PayLoadTest) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{5}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PayLoadTest) GetMsg() string {
@@ -474,7 +1034,7 @@ type PayLoadOrderPaymentCompleted struct {
 
 func (x *PayLoadOrderPaymentCompleted) Reset() {
 	*x = PayLoadOrderPaymentCompleted{}
-	mi := &file_api_job_v1_job_proto_msgTypes[6]
+	mi := &file_api_job_v1_job_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +1046,7 @@ func (x *PayLoadOrderPaymentCompleted) String() string {
 func (*PayLoadOrderPaymentCompleted) ProtoMessage() {}
 
 func (x *PayLoadOrderPaymentCompleted) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[6]
+	mi := &file_api_job_v1_job_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +1059,7 @@ func (x *PayLoadOrderPaymentCompleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayLoadOrderPaymentCompleted.ProtoReflect.Descriptor instead.
 func (*PayLoadOrderPaymentCompleted) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{6}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PayLoadOrderPaymentCompleted) GetOrderId() int64 {
@@ -518,7 +1078,7 @@ type PayLoadOrderTimeout struct {
 
 func (x *PayLoadOrderTimeout) Reset() {
 	*x = PayLoadOrderTimeout{}
-	mi := &file_api_job_v1_job_proto_msgTypes[7]
+	mi := &file_api_job_v1_job_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +1090,7 @@ func (x *PayLoadOrderTimeout) String() string {
 func (*PayLoadOrderTimeout) ProtoMessage() {}
 
 func (x *PayLoadOrderTimeout) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[7]
+	mi := &file_api_job_v1_job_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +1103,7 @@ func (x *PayLoadOrderTimeout) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayLoadOrderTimeout.ProtoReflect.Descriptor instead.
 func (*PayLoadOrderTimeout) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{7}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PayLoadOrderTimeout) GetOrderNum() string {
@@ -553,16 +1113,62 @@ func (x *PayLoadOrderTimeout) GetOrderNum() string {
 	return ""
 }
 
+// 处理上传的图片和视频
+type PayLoadProcessMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        int64                  `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayLoadProcessMedia) Reset() {
+	*x = PayLoadProcessMedia{}
+	mi := &file_api_job_v1_job_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayLoadProcessMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayLoadProcessMedia) ProtoMessage() {}
+
+func (x *PayLoadProcessMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_api_job_v1_job_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayLoadProcessMedia.ProtoReflect.Descriptor instead.
+func (*PayLoadProcessMedia) Descriptor() ([]byte, []int) {
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PayLoadProcessMedia) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
 type QueuingTimeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"` // 队列名，默认 default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueuingTimeRequest) Reset() {
 	*x = QueuingTimeRequest{}
-	mi := &file_api_job_v1_job_proto_msgTypes[8]
+	mi := &file_api_job_v1_job_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +1180,7 @@ func (x *QueuingTimeRequest) String() string {
 func (*QueuingTimeRequest) ProtoMessage() {}
 
 func (x *QueuingTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[8]
+	mi := &file_api_job_v1_job_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,27 +1193,36 @@ func (x *QueuingTimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuingTimeRequest.ProtoReflect.Descriptor instead.
 func (*QueuingTimeRequest) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{8}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueuingTimeRequest) GetTaskId() int64 {
+func (x *QueuingTimeRequest) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
-	return 0
+	return ""
+}
+
+func (x *QueuingTimeRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
 }
 
 type QueuingTimeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Seconds       int64                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// 定时和等待重试的任务为距离下次执行的秒数，待执行的任务为队列中最早任务已等待的秒数，其他状态为 0
+	Seconds       int64  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // 任务状态
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueuingTimeResponse) Reset() {
 	*x = QueuingTimeResponse{}
-	mi := &file_api_job_v1_job_proto_msgTypes[9]
+	mi := &file_api_job_v1_job_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +1234,7 @@ func (x *QueuingTimeResponse) String() string {
 func (*QueuingTimeResponse) ProtoMessage() {}
 
 func (x *QueuingTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[9]
+	mi := &file_api_job_v1_job_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,14 +1247,14 @@ func (x *QueuingTimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuingTimeResponse.ProtoReflect.Descriptor instead.
 func (*QueuingTimeResponse) Descriptor() ([]byte, []int) {
-	return file_api_job_v1_job_proto_rawDescGZIP(), []int{9}
+	return file_api_job_v1_job_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueuingTimeResponse) GetTaskId() int64 {
+func (x *QueuingTimeResponse) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
-	return 0
+	return ""
 }
 
 func (x *QueuingTimeResponse) GetSeconds() int64 {
@@ -649,18 +1264,31 @@ func (x *QueuingTimeResponse) GetSeconds() int64 {
 	return 0
 }
 
+func (x *QueuingTimeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type QueryTasksResponse_TaskInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	Result        []byte                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                      // active/pending/scheduled/retry/archived/completed，任务不存在时为 not_found
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                          // 任务类型
+	Retried       int32                  `protobuf:"varint,5,opt,name=retried,proto3" json:"retried,omitempty"`                                   // 已重试次数
+	MaxRetry      int32                  `protobuf:"varint,6,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`                 // 最大重试次数
+	LastErr       string                 `protobuf:"bytes,7,opt,name=last_err,json=lastErr,proto3" json:"last_err,omitempty"`                     // 最近一次失败原因
+	NextProcessAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_process_at,json=nextProcessAt,proto3" json:"next_process_at,omitempty"` // 下次执行时间
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`         // 完成时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueryTasksResponse_TaskInfo) Reset() {
 	*x = QueryTasksResponse_TaskInfo{}
-	mi := &file_api_job_v1_job_proto_msgTypes[10]
+	mi := &file_api_job_v1_job_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +1300,7 @@ func (x *QueryTasksResponse_TaskInfo) String() string {
 func (*QueryTasksResponse_TaskInfo) ProtoMessage() {}
 
 func (x *QueryTasksResponse_TaskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_job_v1_job_proto_msgTypes[10]
+	mi := &file_api_job_v1_job_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,32 +1337,123 @@ func (x *QueryTasksResponse_TaskInfo) GetStatus() string {
 	return ""
 }
 
+func (x *QueryTasksResponse_TaskInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QueryTasksResponse_TaskInfo) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *QueryTasksResponse_TaskInfo) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *QueryTasksResponse_TaskInfo) GetLastErr() string {
+	if x != nil {
+		return x.LastErr
+	}
+	return ""
+}
+
+func (x *QueryTasksResponse_TaskInfo) GetNextProcessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProcessAt
+	}
+	return nil
+}
+
+func (x *QueryTasksResponse_TaskInfo) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
 var File_api_job_v1_job_proto protoreflect.FileDescriptor
 
 const file_api_job_v1_job_proto_rawDesc = "" +
 	"\n" +
-	"\x14api/job/v1/job.proto\x12\x06job.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x02\n" +
-	"\x0eEnqueueRequest\x12*\n" +
-	"\bjob_type\x18\x01 \x01(\x0e2\x0f.job.v1.JobTypeR\ajobType\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload\x12\x1d\n" +
+	"\x14api/job/v1/job.proto\x12\x06job.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x86\x03\n" +
+	"\x0eEnqueueRequest\x124\n" +
+	"\bjob_type\x18\x01 \x01(\x0e2\x0f.job.v1.JobTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\ajobType\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x12(\n" +
 	"\n" +
-	"retry_time\x18\x03 \x01(\x03R\tretryTime\x129\n" +
+	"retry_time\x18\x03 \x01(\x03B\t\xfaB\x06\"\x04\x18d(\x00R\tretryTime\x129\n" +
 	"\n" +
 	"process_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tprocessAt\x128\n" +
 	"\n" +
 	"process_in\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tprocessIn\x126\n" +
 	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x127\n" +
-	"\tretention\x18\a \x01(\v2\x19.google.protobuf.DurationR\tretention\"*\n" +
+	"\tretention\x18\a \x01(\v2\x19.google.protobuf.DurationR\tretention\x12\x14\n" +
+	"\x05queue\x18\b \x01(\tR\x05queue\"*\n" +
 	"\x0fEnqueueResponse\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\".\n" +
-	"\x11QueryTasksRequest\x12\x19\n" +
-	"\btask_ids\x18\x01 \x03(\tR\ataskIds\"\xa4\x01\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"P\n" +
+	"\x11QueryTasksRequest\x12%\n" +
+	"\btask_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\ataskIds\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"\x8e\x03\n" +
 	"\x12QueryTasksResponse\x129\n" +
-	"\x05tasks\x18\x01 \x03(\v2#.job.v1.QueryTasksResponse.TaskInfoR\x05tasks\x1aS\n" +
+	"\x05tasks\x18\x01 \x03(\v2#.job.v1.QueryTasksResponse.TaskInfoR\x05tasks\x1a\xbc\x02\n" +
 	"\bTaskInfo\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
 	"\x06result\x18\x02 \x01(\fR\x06result\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"-\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\aretried\x18\x05 \x01(\x05R\aretried\x12\x1b\n" +
+	"\tmax_retry\x18\x06 \x01(\x05R\bmaxRetry\x12\x19\n" +
+	"\blast_err\x18\a \x01(\tR\alastErr\x12B\n" +
+	"\x0fnext_process_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextProcessAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"K\n" +
+	"\x11CancelTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"J\n" +
+	"\x10RetryTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"u\n" +
+	"\x18ListArchivedTasksRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"]\n" +
+	"\x19ListArchivedTasksResponse\x12*\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x14.job.v1.ArchivedTaskR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x99\x03\n" +
+	"\fArchivedTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\tR\apayload\x12\x1f\n" +
+	"\vraw_payload\x18\x05 \x01(\fR\n" +
+	"rawPayload\x12\x18\n" +
+	"\aretried\x18\x06 \x01(\x05R\aretried\x12\x1b\n" +
+	"\tmax_retry\x18\a \x01(\x05R\bmaxRetry\x12\x19\n" +
+	"\blast_err\x18\b \x01(\tR\alastErr\x12@\n" +
+	"\x0elast_failed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastFailedAt\x12;\n" +
+	"\aheaders\x18\n" +
+	" \x03(\v2!.job.v1.ArchivedTask.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
+	"\x16GetArchivedTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"T\n" +
+	"\x1aRequeueArchivedTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"6\n" +
+	"\x1bRequeueArchivedTaskResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"S\n" +
+	"\x19DeleteArchivedTaskRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"-\n" +
 	"\x12PayLoadTaskTimeout\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\x05R\x06taskId\"\x1f\n" +
 	"\vPayLoadTest\x12\x10\n" +
@@ -742,12 +1461,16 @@ const file_api_job_v1_job_proto_rawDesc = "" +
 	"\x1cPayLoadOrderPaymentCompleted\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\"2\n" +
 	"\x13PayLoadOrderTimeout\x12\x1b\n" +
-	"\torder_num\x18\x01 \x01(\tR\borderNum\"-\n" +
-	"\x12QueuingTimeRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"H\n" +
+	"\torder_num\x18\x01 \x01(\tR\borderNum\".\n" +
+	"\x13PayLoadProcessMedia\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\x03R\x06fileId\"L\n" +
+	"\x12QueuingTimeRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06taskId\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\"`\n" +
 	"\x13QueuingTimeResponse\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x18\n" +
-	"\aseconds\x18\x02 \x01(\x03R\aseconds*\x81\x02\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x03R\aseconds\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status*\xaf\x02\n" +
 	"\aJobType\x12\f\n" +
 	"\bTEST_JOB\x10\x00\x12\x11\n" +
 	"\rTEST_CRON_JOB\x10\x01\x12\x1a\n" +
@@ -758,10 +1481,26 @@ const file_api_job_v1_job_proto_rawDesc = "" +
 	"\x17CLEAN_USER_UPLOAD_FILES\x10\x06\x12\x17\n" +
 	"\x13CLEAN_OLD_LOG_FILES\x10\a\x12\x1d\n" +
 	"\x19CLEAN_USER_EXPIRED_POINTS\x10\b\x12\x1a\n" +
-	"\x16RESET_DAILY_CHARACTERS\x10\t*@\n" +
+	"\x16RESET_DAILY_CHARACTERS\x10\t\x12\x19\n" +
+	"\x15CLEAN_EXPIRED_UPLOADS\x10\n" +
+	"\x12\x11\n" +
+	"\rPROCESS_MEDIA\x10\v*@\n" +
 	"\bAdminJob\x12\x19\n" +
 	"\x15GENERATE_DAILY_REPORT\x10\x00\x12\x19\n" +
-	"\x15GENERATE_TODAY_REPORT\x10\x01B.Z,github.com/ydssx/kratos-kit/api/job/v1;jobv1b\x06proto3"
+	"\x15GENERATE_TODAY_REPORT\x10\x012\xf5\a\n" +
+	"\n" +
+	"JobService\x12R\n" +
+	"\aEnqueue\x12\x16.job.v1.EnqueueRequest\x1a\x17.job.v1.EnqueueResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/admin/jobs\x12X\n" +
+	"\n" +
+	"QueryTasks\x12\x19.job.v1.QueryTasksRequest\x1a\x1a.job.v1.QueryTasksResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/admin/jobs\x12h\n" +
+	"\n" +
+	"CancelTask\x12\x19.job.v1.CancelTaskRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/admin/jobs/{task_id}/cancel\x12e\n" +
+	"\tRetryTask\x12\x18.job.v1.RetryTaskRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/admin/jobs/{task_id}/retry\x12r\n" +
+	"\vQueuingTime\x12\x1a.job.v1.QueuingTimeRequest\x1a\x1b.job.v1.QueuingTimeResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/admin/jobs/{task_id}/queuing_time\x12v\n" +
+	"\x11ListArchivedTasks\x12 .job.v1.ListArchivedTasksRequest\x1a!.job.v1.ListArchivedTasksResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/archived_jobs\x12o\n" +
+	"\x0fGetArchivedTask\x12\x1e.job.v1.GetArchivedTaskRequest\x1a\x14.job.v1.ArchivedTask\"&\x82\xd3\xe4\x93\x02 \x12\x1e/admin/archived_jobs/{task_id}\x12\x91\x01\n" +
+	"\x13RequeueArchivedTask\x12\".job.v1.RequeueArchivedTaskRequest\x1a#.job.v1.RequeueArchivedTaskResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/admin/archived_jobs/{task_id}/requeue\x12w\n" +
+	"\x12DeleteArchivedTask\x12!.job.v1.DeleteArchivedTaskRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/admin/archived_jobs/{task_id}B.Z,github.com/ydssx/kratos-kit/api/job/v1;jobv1b\x06proto3"
 
 var (
 	file_api_job_v1_job_proto_rawDescOnce sync.Once
@@ -776,7 +1515,7 @@ func file_api_job_v1_job_proto_rawDescGZIP() []byte {
 }
 
 var file_api_job_v1_job_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_job_v1_job_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_job_v1_job_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_api_job_v1_job_proto_goTypes = []any{
 	(JobType)(0),                         // 0: job.v1.JobType
 	(AdminJob)(0),                        // 1: job.v1.AdminJob
@@ -784,28 +1523,63 @@ var file_api_job_v1_job_proto_goTypes = []any{
 	(*EnqueueResponse)(nil),              // 3: job.v1.EnqueueResponse
 	(*QueryTasksRequest)(nil),            // 4: job.v1.QueryTasksRequest
 	(*QueryTasksResponse)(nil),           // 5: job.v1.QueryTasksResponse
-	(*PayLoadTaskTimeout)(nil),           // 6: job.v1.PayLoadTaskTimeout
-	(*PayLoadTest)(nil),                  // 7: job.v1.PayLoadTest
-	(*PayLoadOrderPaymentCompleted)(nil), // 8: job.v1.PayLoadOrderPaymentCompleted
-	(*PayLoadOrderTimeout)(nil),          // 9: job.v1.PayLoadOrderTimeout
-	(*QueuingTimeRequest)(nil),           // 10: job.v1.QueuingTimeRequest
-	(*QueuingTimeResponse)(nil),          // 11: job.v1.QueuingTimeResponse
-	(*QueryTasksResponse_TaskInfo)(nil),  // 12: job.v1.QueryTasksResponse.TaskInfo
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),          // 14: google.protobuf.Duration
+	(*CancelTaskRequest)(nil),            // 6: job.v1.CancelTaskRequest
+	(*RetryTaskRequest)(nil),             // 7: job.v1.RetryTaskRequest
+	(*ListArchivedTasksRequest)(nil),     // 8: job.v1.ListArchivedTasksRequest
+	(*ListArchivedTasksResponse)(nil),    // 9: job.v1.ListArchivedTasksResponse
+	(*ArchivedTask)(nil),                 // 10: job.v1.ArchivedTask
+	(*GetArchivedTaskRequest)(nil),       // 11: job.v1.GetArchivedTaskRequest
+	(*RequeueArchivedTaskRequest)(nil),   // 12: job.v1.RequeueArchivedTaskRequest
+	(*RequeueArchivedTaskResponse)(nil),  // 13: job.v1.RequeueArchivedTaskResponse
+	(*DeleteArchivedTaskRequest)(nil),    // 14: job.v1.DeleteArchivedTaskRequest
+	(*PayLoadTaskTimeout)(nil),           // 15: job.v1.PayLoadTaskTimeout
+	(*PayLoadTest)(nil),                  // 16: job.v1.PayLoadTest
+	(*PayLoadOrderPaymentCompleted)(nil), // 17: job.v1.PayLoadOrderPaymentCompleted
+	(*PayLoadOrderTimeout)(nil),          // 18: job.v1.PayLoadOrderTimeout
+	(*PayLoadProcessMedia)(nil),          // 19: job.v1.PayLoadProcessMedia
+	(*QueuingTimeRequest)(nil),           // 20: job.v1.QueuingTimeRequest
+	(*QueuingTimeResponse)(nil),          // 21: job.v1.QueuingTimeResponse
+	(*QueryTasksResponse_TaskInfo)(nil),  // 22: job.v1.QueryTasksResponse.TaskInfo
+	nil,                                  // 23: job.v1.ArchivedTask.HeadersEntry
+	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 25: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                // 26: google.protobuf.Empty
 }
 var file_api_job_v1_job_proto_depIdxs = []int32{
 	0,  // 0: job.v1.EnqueueRequest.job_type:type_name -> job.v1.JobType
-	13, // 1: job.v1.EnqueueRequest.process_at:type_name -> google.protobuf.Timestamp
-	14, // 2: job.v1.EnqueueRequest.process_in:type_name -> google.protobuf.Duration
-	13, // 3: job.v1.EnqueueRequest.deadline:type_name -> google.protobuf.Timestamp
-	14, // 4: job.v1.EnqueueRequest.retention:type_name -> google.protobuf.Duration
-	12, // 5: job.v1.QueryTasksResponse.tasks:type_name -> job.v1.QueryTasksResponse.TaskInfo
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	24, // 1: job.v1.EnqueueRequest.process_at:type_name -> google.protobuf.Timestamp
+	25, // 2: job.v1.EnqueueRequest.process_in:type_name -> google.protobuf.Duration
+	24, // 3: job.v1.EnqueueRequest.deadline:type_name -> google.protobuf.Timestamp
+	25, // 4: job.v1.EnqueueRequest.retention:type_name -> google.protobuf.Duration
+	22, // 5: job.v1.QueryTasksResponse.tasks:type_name -> job.v1.QueryTasksResponse.TaskInfo
+	10, // 6: job.v1.ListArchivedTasksResponse.tasks:type_name -> job.v1.ArchivedTask
+	24, // 7: job.v1.ArchivedTask.last_failed_at:type_name -> google.protobuf.Timestamp
+	23, // 8: job.v1.ArchivedTask.headers:type_name -> job.v1.ArchivedTask.HeadersEntry
+	24, // 9: job.v1.QueryTasksResponse.TaskInfo.next_process_at:type_name -> google.protobuf.Timestamp
+	24, // 10: job.v1.QueryTasksResponse.TaskInfo.completed_at:type_name -> google.protobuf.Timestamp
+	2,  // 11: job.v1.JobService.Enqueue:input_type -> job.v1.EnqueueRequest
+	4,  // 12: job.v1.JobService.QueryTasks:input_type -> job.v1.QueryTasksRequest
+	6,  // 13: job.v1.JobService.CancelTask:input_type -> job.v1.CancelTaskRequest
+	7,  // 14: job.v1.JobService.RetryTask:input_type -> job.v1.RetryTaskRequest
+	20, // 15: job.v1.JobService.QueuingTime:input_type -> job.v1.QueuingTimeRequest
+	8,  // 16: job.v1.JobService.ListArchivedTasks:input_type -> job.v1.ListArchivedTasksRequest
+	11, // 17: job.v1.JobService.GetArchivedTask:input_type -> job.v1.GetArchivedTaskRequest
+	12, // 18: job.v1.JobService.RequeueArchivedTask:input_type -> job.v1.RequeueArchivedTaskRequest
+	14, // 19: job.v1.JobService.DeleteArchivedTask:input_type -> job.v1.DeleteArchivedTaskRequest
+	3,  // 20: job.v1.JobService.Enqueue:output_type -> job.v1.EnqueueResponse
+	5,  // 21: job.v1.JobService.QueryTasks:output_type -> job.v1.QueryTasksResponse
+	26, // 22: job.v1.JobService.CancelTask:output_type -> google.protobuf.Empty
+	26, // 23: job.v1.JobService.RetryTask:output_type -> google.protobuf.Empty
+	21, // 24: job.v1.JobService.QueuingTime:output_type -> job.v1.QueuingTimeResponse
+	9,  // 25: job.v1.JobService.ListArchivedTasks:output_type -> job.v1.ListArchivedTasksResponse
+	10, // 26: job.v1.JobService.GetArchivedTask:output_type -> job.v1.ArchivedTask
+	13, // 27: job.v1.JobService.RequeueArchivedTask:output_type -> job.v1.RequeueArchivedTaskResponse
+	26, // 28: job.v1.JobService.DeleteArchivedTask:output_type -> google.protobuf.Empty
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_job_v1_job_proto_init() }
@@ -819,9 +1593,9 @@ func file_api_job_v1_job_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_job_v1_job_proto_rawDesc), len(file_api_job_v1_job_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   22,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_api_job_v1_job_proto_goTypes,
 		DependencyIndexes: file_api_job_v1_job_proto_depIdxs,
@@ -57,11 +57,29 @@ func (m *EnqueueRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for JobType
+	if _, ok := JobType_name[int32(m.GetJobType())]; !ok {
+		err := EnqueueRequestValidationError{
+			field:  "JobType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Payload
 
-	// no validation rules for RetryTime
+	if val := m.GetRetryTime(); val < 0 || val > 100 {
+		err := EnqueueRequestValidationError{
+			field:  "RetryTime",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
 		switch v := interface{}(m.GetProcessAt()).(type) {
@@ -179,6 +197,8 @@ func (m *EnqueueRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Queue
+
 	if len(errors) > 0 {
 		return EnqueueRequestMultiError(errors)
 	}
@@ -381,6 +401,19 @@ func (m *QueryTasksRequest) validate(all bool) error {
 
 	var errors []error
 
+	if l := len(m.GetTaskIds()); l < 1 || l > 100 {
+		err := QueryTasksRequestValidationError{
+			field:  "TaskIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
+
 	if len(errors) > 0 {
 		return QueryTasksRequestMultiError(errors)
 	}
@@ -597,44 +630,55 @@ var _ interface {
 	ErrorName() string
 } = QueryTasksResponseValidationError{}
 
-// Validate checks the field values on PayLoadTaskTimeout with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PayLoadTaskTimeout) Validate() error {
+// Validate checks the field values on CancelTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PayLoadTaskTimeout with the rules
+// ValidateAll checks the field values on CancelTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PayLoadTaskTimeoutMultiError, or nil if none found.
-func (m *PayLoadTaskTimeout) ValidateAll() error {
+// CancelTaskRequestMultiError, or nil if none found.
+func (m *CancelTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PayLoadTaskTimeout) validate(all bool) error {
+func (m *CancelTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TaskId
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := CancelTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
 
 	if len(errors) > 0 {
-		return PayLoadTaskTimeoutMultiError(errors)
+		return CancelTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PayLoadTaskTimeoutMultiError is an error wrapping multiple validation errors
-// returned by PayLoadTaskTimeout.ValidateAll() if the designated constraints
+// CancelTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelTaskRequest.ValidateAll() if the designated constraints
 // aren't met.
-type PayLoadTaskTimeoutMultiError []error
+type CancelTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PayLoadTaskTimeoutMultiError) Error() string {
+func (m CancelTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -643,11 +687,11 @@ func (m PayLoadTaskTimeoutMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PayLoadTaskTimeoutMultiError) AllErrors() []error { return m }
+func (m CancelTaskRequestMultiError) AllErrors() []error { return m }
 
-// PayLoadTaskTimeoutValidationError is the validation error returned by
-// PayLoadTaskTimeout.Validate if the designated constraints aren't met.
-type PayLoadTaskTimeoutValidationError struct {
+// CancelTaskRequestValidationError is the validation error returned by
+// CancelTaskRequest.Validate if the designated constraints aren't met.
+type CancelTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -655,24 +699,24 @@ type PayLoadTaskTimeoutValidationError struct {
 }
 
 // Field function returns field value.
-func (e PayLoadTaskTimeoutValidationError) Field() string { return e.field }
+func (e CancelTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PayLoadTaskTimeoutValidationError) Reason() string { return e.reason }
+func (e CancelTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PayLoadTaskTimeoutValidationError) Cause() error { return e.cause }
+func (e CancelTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PayLoadTaskTimeoutValidationError) Key() bool { return e.key }
+func (e CancelTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PayLoadTaskTimeoutValidationError) ErrorName() string {
-	return "PayLoadTaskTimeoutValidationError"
+func (e CancelTaskRequestValidationError) ErrorName() string {
+	return "CancelTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PayLoadTaskTimeoutValidationError) Error() string {
+func (e CancelTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -684,14 +728,14 @@ func (e PayLoadTaskTimeoutValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPayLoadTaskTimeout.%s: %s%s",
+		"invalid %sCancelTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PayLoadTaskTimeoutValidationError{}
+var _ error = CancelTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -699,45 +743,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PayLoadTaskTimeoutValidationError{}
+} = CancelTaskRequestValidationError{}
 
-// Validate checks the field values on PayLoadTest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PayLoadTest) Validate() error {
+// Validate checks the field values on RetryTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetryTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PayLoadTest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PayLoadTestMultiError, or
-// nil if none found.
-func (m *PayLoadTest) ValidateAll() error {
+// ValidateAll checks the field values on RetryTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryTaskRequestMultiError, or nil if none found.
+func (m *RetryTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PayLoadTest) validate(all bool) error {
+func (m *RetryTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Msg
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := RetryTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
 
 	if len(errors) > 0 {
-		return PayLoadTestMultiError(errors)
+		return RetryTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PayLoadTestMultiError is an error wrapping multiple validation errors
-// returned by PayLoadTest.ValidateAll() if the designated constraints aren't met.
-type PayLoadTestMultiError []error
+// RetryTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by RetryTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RetryTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PayLoadTestMultiError) Error() string {
+func (m RetryTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -746,11 +802,11 @@ func (m PayLoadTestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PayLoadTestMultiError) AllErrors() []error { return m }
+func (m RetryTaskRequestMultiError) AllErrors() []error { return m }
 
-// PayLoadTestValidationError is the validation error returned by
-// PayLoadTest.Validate if the designated constraints aren't met.
-type PayLoadTestValidationError struct {
+// RetryTaskRequestValidationError is the validation error returned by
+// RetryTaskRequest.Validate if the designated constraints aren't met.
+type RetryTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -758,22 +814,22 @@ type PayLoadTestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PayLoadTestValidationError) Field() string { return e.field }
+func (e RetryTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PayLoadTestValidationError) Reason() string { return e.reason }
+func (e RetryTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PayLoadTestValidationError) Cause() error { return e.cause }
+func (e RetryTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PayLoadTestValidationError) Key() bool { return e.key }
+func (e RetryTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PayLoadTestValidationError) ErrorName() string { return "PayLoadTestValidationError" }
+func (e RetryTaskRequestValidationError) ErrorName() string { return "RetryTaskRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PayLoadTestValidationError) Error() string {
+func (e RetryTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -785,14 +841,14 @@ func (e PayLoadTestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPayLoadTest.%s: %s%s",
+		"invalid %sRetryTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PayLoadTestValidationError{}
+var _ error = RetryTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -800,46 +856,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PayLoadTestValidationError{}
+} = RetryTaskRequestValidationError{}
 
-// Validate checks the field values on PayLoadOrderPaymentCompleted with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListArchivedTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PayLoadOrderPaymentCompleted) Validate() error {
+func (m *ListArchivedTasksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PayLoadOrderPaymentCompleted with the
+// ValidateAll checks the field values on ListArchivedTasksRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PayLoadOrderPaymentCompletedMultiError, or nil if none found.
-func (m *PayLoadOrderPaymentCompleted) ValidateAll() error {
+// ListArchivedTasksRequestMultiError, or nil if none found.
+func (m *ListArchivedTasksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PayLoadOrderPaymentCompleted) validate(all bool) error {
+func (m *ListArchivedTasksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderId
+	// no validation rules for Queue
+
+	if m.GetPage() < 0 {
+		err := ListArchivedTasksRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListArchivedTasksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return PayLoadOrderPaymentCompletedMultiError(errors)
+		return ListArchivedTasksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PayLoadOrderPaymentCompletedMultiError is an error wrapping multiple
-// validation errors returned by PayLoadOrderPaymentCompleted.ValidateAll() if
-// the designated constraints aren't met.
-type PayLoadOrderPaymentCompletedMultiError []error
+// ListArchivedTasksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListArchivedTasksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListArchivedTasksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PayLoadOrderPaymentCompletedMultiError) Error() string {
+func (m ListArchivedTasksRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -848,12 +926,11 @@ func (m PayLoadOrderPaymentCompletedMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PayLoadOrderPaymentCompletedMultiError) AllErrors() []error { return m }
+func (m ListArchivedTasksRequestMultiError) AllErrors() []error { return m }
 
-// PayLoadOrderPaymentCompletedValidationError is the validation error returned
-// by PayLoadOrderPaymentCompleted.Validate if the designated constraints
-// aren't met.
-type PayLoadOrderPaymentCompletedValidationError struct {
+// ListArchivedTasksRequestValidationError is the validation error returned by
+// ListArchivedTasksRequest.Validate if the designated constraints aren't met.
+type ListArchivedTasksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -861,24 +938,24 @@ type PayLoadOrderPaymentCompletedValidationError struct {
 }
 
 // Field function returns field value.
-func (e PayLoadOrderPaymentCompletedValidationError) Field() string { return e.field }
+func (e ListArchivedTasksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PayLoadOrderPaymentCompletedValidationError) Reason() string { return e.reason }
+func (e ListArchivedTasksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PayLoadOrderPaymentCompletedValidationError) Cause() error { return e.cause }
+func (e ListArchivedTasksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PayLoadOrderPaymentCompletedValidationError) Key() bool { return e.key }
+func (e ListArchivedTasksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PayLoadOrderPaymentCompletedValidationError) ErrorName() string {
-	return "PayLoadOrderPaymentCompletedValidationError"
+func (e ListArchivedTasksRequestValidationError) ErrorName() string {
+	return "ListArchivedTasksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PayLoadOrderPaymentCompletedValidationError) Error() string {
+func (e ListArchivedTasksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -890,14 +967,14 @@ func (e PayLoadOrderPaymentCompletedValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPayLoadOrderPaymentCompleted.%s: %s%s",
+		"invalid %sListArchivedTasksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PayLoadOrderPaymentCompletedValidationError{}
+var _ error = ListArchivedTasksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -905,46 +982,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PayLoadOrderPaymentCompletedValidationError{}
+} = ListArchivedTasksRequestValidationError{}
 
-// Validate checks the field values on PayLoadOrderTimeout with the rules
+// Validate checks the field values on ListArchivedTasksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PayLoadOrderTimeout) Validate() error {
+func (m *ListArchivedTasksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PayLoadOrderTimeout with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListArchivedTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PayLoadOrderTimeoutMultiError, or nil if none found.
-func (m *PayLoadOrderTimeout) ValidateAll() error {
+// ListArchivedTasksResponseMultiError, or nil if none found.
+func (m *ListArchivedTasksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PayLoadOrderTimeout) validate(all bool) error {
+func (m *ListArchivedTasksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderNum
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArchivedTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArchivedTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArchivedTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return PayLoadOrderTimeoutMultiError(errors)
+		return ListArchivedTasksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PayLoadOrderTimeoutMultiError is an error wrapping multiple validation
-// errors returned by PayLoadOrderTimeout.ValidateAll() if the designated
-// constraints aren't met.
-type PayLoadOrderTimeoutMultiError []error
+// ListArchivedTasksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListArchivedTasksResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListArchivedTasksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PayLoadOrderTimeoutMultiError) Error() string {
+func (m ListArchivedTasksResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -953,11 +1064,11 @@ func (m PayLoadOrderTimeoutMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PayLoadOrderTimeoutMultiError) AllErrors() []error { return m }
+func (m ListArchivedTasksResponseMultiError) AllErrors() []error { return m }
 
-// PayLoadOrderTimeoutValidationError is the validation error returned by
-// PayLoadOrderTimeout.Validate if the designated constraints aren't met.
-type PayLoadOrderTimeoutValidationError struct {
+// ListArchivedTasksResponseValidationError is the validation error returned by
+// ListArchivedTasksResponse.Validate if the designated constraints aren't met.
+type ListArchivedTasksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -965,24 +1076,24 @@ type PayLoadOrderTimeoutValidationError struct {
 }
 
 // Field function returns field value.
-func (e PayLoadOrderTimeoutValidationError) Field() string { return e.field }
+func (e ListArchivedTasksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PayLoadOrderTimeoutValidationError) Reason() string { return e.reason }
+func (e ListArchivedTasksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PayLoadOrderTimeoutValidationError) Cause() error { return e.cause }
+func (e ListArchivedTasksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PayLoadOrderTimeoutValidationError) Key() bool { return e.key }
+func (e ListArchivedTasksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PayLoadOrderTimeoutValidationError) ErrorName() string {
-	return "PayLoadOrderTimeoutValidationError"
+func (e ListArchivedTasksResponseValidationError) ErrorName() string {
+	return "ListArchivedTasksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PayLoadOrderTimeoutValidationError) Error() string {
+func (e ListArchivedTasksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -994,14 +1105,14 @@ func (e PayLoadOrderTimeoutValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPayLoadOrderTimeout.%s: %s%s",
+		"invalid %sListArchivedTasksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PayLoadOrderTimeoutValidationError{}
+var _ error = ListArchivedTasksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1009,24 +1120,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PayLoadOrderTimeoutValidationError{}
+} = ListArchivedTasksResponseValidationError{}
 
-// Validate checks the field values on QueuingTimeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueuingTimeRequest) Validate() error {
+// Validate checks the field values on ArchivedTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ArchivedTask) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueuingTimeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueuingTimeRequestMultiError, or nil if none found.
-func (m *QueuingTimeRequest) ValidateAll() error {
+// ValidateAll checks the field values on ArchivedTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArchivedTaskMultiError, or
+// nil if none found.
+func (m *ArchivedTask) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueuingTimeRequest) validate(all bool) error {
+func (m *ArchivedTask) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1035,20 +1146,64 @@ func (m *QueuingTimeRequest) validate(all bool) error {
 
 	// no validation rules for TaskId
 
+	// no validation rules for Queue
+
+	// no validation rules for Type
+
+	// no validation rules for Payload
+
+	// no validation rules for RawPayload
+
+	// no validation rules for Retried
+
+	// no validation rules for MaxRetry
+
+	// no validation rules for LastErr
+
+	if all {
+		switch v := interface{}(m.GetLastFailedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArchivedTaskValidationError{
+					field:  "LastFailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArchivedTaskValidationError{
+					field:  "LastFailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArchivedTaskValidationError{
+				field:  "LastFailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Headers
+
 	if len(errors) > 0 {
-		return QueuingTimeRequestMultiError(errors)
+		return ArchivedTaskMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueuingTimeRequestMultiError is an error wrapping multiple validation errors
-// returned by QueuingTimeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type QueuingTimeRequestMultiError []error
+// ArchivedTaskMultiError is an error wrapping multiple validation errors
+// returned by ArchivedTask.ValidateAll() if the designated constraints aren't met.
+type ArchivedTaskMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueuingTimeRequestMultiError) Error() string {
+func (m ArchivedTaskMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1057,11 +1212,11 @@ func (m QueuingTimeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueuingTimeRequestMultiError) AllErrors() []error { return m }
+func (m ArchivedTaskMultiError) AllErrors() []error { return m }
 
-// QueuingTimeRequestValidationError is the validation error returned by
-// QueuingTimeRequest.Validate if the designated constraints aren't met.
-type QueuingTimeRequestValidationError struct {
+// ArchivedTaskValidationError is the validation error returned by
+// ArchivedTask.Validate if the designated constraints aren't met.
+type ArchivedTaskValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1069,24 +1224,22 @@ type QueuingTimeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueuingTimeRequestValidationError) Field() string { return e.field }
+func (e ArchivedTaskValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueuingTimeRequestValidationError) Reason() string { return e.reason }
+func (e ArchivedTaskValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueuingTimeRequestValidationError) Cause() error { return e.cause }
+func (e ArchivedTaskValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueuingTimeRequestValidationError) Key() bool { return e.key }
+func (e ArchivedTaskValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueuingTimeRequestValidationError) ErrorName() string {
-	return "QueuingTimeRequestValidationError"
-}
+func (e ArchivedTaskValidationError) ErrorName() string { return "ArchivedTaskValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueuingTimeRequestValidationError) Error() string {
+func (e ArchivedTaskValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1098,14 +1251,14 @@ func (e QueuingTimeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueuingTimeRequest.%s: %s%s",
+		"invalid %sArchivedTask.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueuingTimeRequestValidationError{}
+var _ error = ArchivedTaskValidationError{}
 
 var _ interface {
 	Field() string
@@ -1113,34 +1266,1119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueuingTimeRequestValidationError{}
+} = ArchivedTaskValidationError{}
 
-// Validate checks the field values on QueuingTimeResponse with the rules
+// Validate checks the field values on GetArchivedTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueuingTimeResponse) Validate() error {
+func (m *GetArchivedTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueuingTimeResponse with the rules
+// ValidateAll checks the field values on GetArchivedTaskRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// QueuingTimeResponseMultiError, or nil if none found.
-func (m *QueuingTimeResponse) ValidateAll() error {
+// GetArchivedTaskRequestMultiError, or nil if none found.
+func (m *GetArchivedTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueuingTimeResponse) validate(all bool) error {
+func (m *GetArchivedTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TaskId
-
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := GetArchivedTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
+
+	if len(errors) > 0 {
+		return GetArchivedTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArchivedTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArchivedTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetArchivedTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArchivedTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArchivedTaskRequestMultiError) AllErrors() []error { return m }
+
+// GetArchivedTaskRequestValidationError is the validation error returned by
+// GetArchivedTaskRequest.Validate if the designated constraints aren't met.
+type GetArchivedTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArchivedTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArchivedTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArchivedTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArchivedTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArchivedTaskRequestValidationError) ErrorName() string {
+	return "GetArchivedTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArchivedTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArchivedTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArchivedTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArchivedTaskRequestValidationError{}
+
+// Validate checks the field values on RequeueArchivedTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequeueArchivedTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequeueArchivedTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequeueArchivedTaskRequestMultiError, or nil if none found.
+func (m *RequeueArchivedTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequeueArchivedTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := RequeueArchivedTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
+
+	if len(errors) > 0 {
+		return RequeueArchivedTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequeueArchivedTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by RequeueArchivedTaskRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequeueArchivedTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequeueArchivedTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequeueArchivedTaskRequestMultiError) AllErrors() []error { return m }
+
+// RequeueArchivedTaskRequestValidationError is the validation error returned
+// by RequeueArchivedTaskRequest.Validate if the designated constraints aren't met.
+type RequeueArchivedTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequeueArchivedTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequeueArchivedTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequeueArchivedTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequeueArchivedTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequeueArchivedTaskRequestValidationError) ErrorName() string {
+	return "RequeueArchivedTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequeueArchivedTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequeueArchivedTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequeueArchivedTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequeueArchivedTaskRequestValidationError{}
+
+// Validate checks the field values on RequeueArchivedTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequeueArchivedTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequeueArchivedTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequeueArchivedTaskResponseMultiError, or nil if none found.
+func (m *RequeueArchivedTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequeueArchivedTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	if len(errors) > 0 {
+		return RequeueArchivedTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequeueArchivedTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by RequeueArchivedTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RequeueArchivedTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequeueArchivedTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequeueArchivedTaskResponseMultiError) AllErrors() []error { return m }
+
+// RequeueArchivedTaskResponseValidationError is the validation error returned
+// by RequeueArchivedTaskResponse.Validate if the designated constraints
+// aren't met.
+type RequeueArchivedTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequeueArchivedTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequeueArchivedTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequeueArchivedTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequeueArchivedTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequeueArchivedTaskResponseValidationError) ErrorName() string {
+	return "RequeueArchivedTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequeueArchivedTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequeueArchivedTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequeueArchivedTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequeueArchivedTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteArchivedTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteArchivedTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteArchivedTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteArchivedTaskRequestMultiError, or nil if none found.
+func (m *DeleteArchivedTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteArchivedTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := DeleteArchivedTaskRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
+
+	if len(errors) > 0 {
+		return DeleteArchivedTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteArchivedTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteArchivedTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteArchivedTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteArchivedTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteArchivedTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteArchivedTaskRequestValidationError is the validation error returned by
+// DeleteArchivedTaskRequest.Validate if the designated constraints aren't met.
+type DeleteArchivedTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteArchivedTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteArchivedTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteArchivedTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteArchivedTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteArchivedTaskRequestValidationError) ErrorName() string {
+	return "DeleteArchivedTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteArchivedTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteArchivedTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteArchivedTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteArchivedTaskRequestValidationError{}
+
+// Validate checks the field values on PayLoadTaskTimeout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayLoadTaskTimeout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayLoadTaskTimeout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayLoadTaskTimeoutMultiError, or nil if none found.
+func (m *PayLoadTaskTimeout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayLoadTaskTimeout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	if len(errors) > 0 {
+		return PayLoadTaskTimeoutMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayLoadTaskTimeoutMultiError is an error wrapping multiple validation errors
+// returned by PayLoadTaskTimeout.ValidateAll() if the designated constraints
+// aren't met.
+type PayLoadTaskTimeoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayLoadTaskTimeoutMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayLoadTaskTimeoutMultiError) AllErrors() []error { return m }
+
+// PayLoadTaskTimeoutValidationError is the validation error returned by
+// PayLoadTaskTimeout.Validate if the designated constraints aren't met.
+type PayLoadTaskTimeoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayLoadTaskTimeoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayLoadTaskTimeoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayLoadTaskTimeoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayLoadTaskTimeoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayLoadTaskTimeoutValidationError) ErrorName() string {
+	return "PayLoadTaskTimeoutValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayLoadTaskTimeoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayLoadTaskTimeout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayLoadTaskTimeoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayLoadTaskTimeoutValidationError{}
+
+// Validate checks the field values on PayLoadTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PayLoadTest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayLoadTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PayLoadTestMultiError, or
+// nil if none found.
+func (m *PayLoadTest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayLoadTest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Msg
+
+	if len(errors) > 0 {
+		return PayLoadTestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayLoadTestMultiError is an error wrapping multiple validation errors
+// returned by PayLoadTest.ValidateAll() if the designated constraints aren't met.
+type PayLoadTestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayLoadTestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayLoadTestMultiError) AllErrors() []error { return m }
+
+// PayLoadTestValidationError is the validation error returned by
+// PayLoadTest.Validate if the designated constraints aren't met.
+type PayLoadTestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayLoadTestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayLoadTestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayLoadTestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayLoadTestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayLoadTestValidationError) ErrorName() string { return "PayLoadTestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PayLoadTestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayLoadTest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayLoadTestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayLoadTestValidationError{}
+
+// Validate checks the field values on PayLoadOrderPaymentCompleted with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayLoadOrderPaymentCompleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayLoadOrderPaymentCompleted with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayLoadOrderPaymentCompletedMultiError, or nil if none found.
+func (m *PayLoadOrderPaymentCompleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayLoadOrderPaymentCompleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return PayLoadOrderPaymentCompletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayLoadOrderPaymentCompletedMultiError is an error wrapping multiple
+// validation errors returned by PayLoadOrderPaymentCompleted.ValidateAll() if
+// the designated constraints aren't met.
+type PayLoadOrderPaymentCompletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayLoadOrderPaymentCompletedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayLoadOrderPaymentCompletedMultiError) AllErrors() []error { return m }
+
+// PayLoadOrderPaymentCompletedValidationError is the validation error returned
+// by PayLoadOrderPaymentCompleted.Validate if the designated constraints
+// aren't met.
+type PayLoadOrderPaymentCompletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayLoadOrderPaymentCompletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayLoadOrderPaymentCompletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayLoadOrderPaymentCompletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayLoadOrderPaymentCompletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayLoadOrderPaymentCompletedValidationError) ErrorName() string {
+	return "PayLoadOrderPaymentCompletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayLoadOrderPaymentCompletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayLoadOrderPaymentCompleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayLoadOrderPaymentCompletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayLoadOrderPaymentCompletedValidationError{}
+
+// Validate checks the field values on PayLoadOrderTimeout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayLoadOrderTimeout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayLoadOrderTimeout with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayLoadOrderTimeoutMultiError, or nil if none found.
+func (m *PayLoadOrderTimeout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayLoadOrderTimeout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderNum
+
+	if len(errors) > 0 {
+		return PayLoadOrderTimeoutMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayLoadOrderTimeoutMultiError is an error wrapping multiple validation
+// errors returned by PayLoadOrderTimeout.ValidateAll() if the designated
+// constraints aren't met.
+type PayLoadOrderTimeoutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayLoadOrderTimeoutMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayLoadOrderTimeoutMultiError) AllErrors() []error { return m }
+
+// PayLoadOrderTimeoutValidationError is the validation error returned by
+// PayLoadOrderTimeout.Validate if the designated constraints aren't met.
+type PayLoadOrderTimeoutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayLoadOrderTimeoutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayLoadOrderTimeoutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayLoadOrderTimeoutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayLoadOrderTimeoutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayLoadOrderTimeoutValidationError) ErrorName() string {
+	return "PayLoadOrderTimeoutValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayLoadOrderTimeoutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayLoadOrderTimeout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayLoadOrderTimeoutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayLoadOrderTimeoutValidationError{}
+
+// Validate checks the field values on PayLoadProcessMedia with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PayLoadProcessMedia) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayLoadProcessMedia with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PayLoadProcessMediaMultiError, or nil if none found.
+func (m *PayLoadProcessMedia) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayLoadProcessMedia) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return PayLoadProcessMediaMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayLoadProcessMediaMultiError is an error wrapping multiple validation
+// errors returned by PayLoadProcessMedia.ValidateAll() if the designated
+// constraints aren't met.
+type PayLoadProcessMediaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayLoadProcessMediaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayLoadProcessMediaMultiError) AllErrors() []error { return m }
+
+// PayLoadProcessMediaValidationError is the validation error returned by
+// PayLoadProcessMedia.Validate if the designated constraints aren't met.
+type PayLoadProcessMediaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayLoadProcessMediaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayLoadProcessMediaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayLoadProcessMediaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayLoadProcessMediaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayLoadProcessMediaValidationError) ErrorName() string {
+	return "PayLoadProcessMediaValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayLoadProcessMediaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayLoadProcessMedia.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayLoadProcessMediaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayLoadProcessMediaValidationError{}
+
+// Validate checks the field values on QueuingTimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueuingTimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueuingTimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueuingTimeRequestMultiError, or nil if none found.
+func (m *QueuingTimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueuingTimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTaskId()) < 1 {
+		err := QueuingTimeRequestValidationError{
+			field:  "TaskId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Queue
+
+	if len(errors) > 0 {
+		return QueuingTimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueuingTimeRequestMultiError is an error wrapping multiple validation errors
+// returned by QueuingTimeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type QueuingTimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueuingTimeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueuingTimeRequestMultiError) AllErrors() []error { return m }
+
+// QueuingTimeRequestValidationError is the validation error returned by
+// QueuingTimeRequest.Validate if the designated constraints aren't met.
+type QueuingTimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueuingTimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueuingTimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueuingTimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueuingTimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueuingTimeRequestValidationError) ErrorName() string {
+	return "QueuingTimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueuingTimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueuingTimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueuingTimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueuingTimeRequestValidationError{}
+
+// Validate checks the field values on QueuingTimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueuingTimeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueuingTimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueuingTimeResponseMultiError, or nil if none found.
+func (m *QueuingTimeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueuingTimeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
 	// no validation rules for Seconds
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
 		return QueuingTimeResponseMultiError(errors)
 	}
@@ -1249,6 +2487,72 @@ func (m *QueryTasksResponse_TaskInfo) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Type
+
+	// no validation rules for Retried
+
+	// no validation rules for MaxRetry
+
+	// no validation rules for LastErr
+
+	if all {
+		switch v := interface{}(m.GetNextProcessAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryTasksResponse_TaskInfoValidationError{
+					field:  "NextProcessAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryTasksResponse_TaskInfoValidationError{
+					field:  "NextProcessAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextProcessAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryTasksResponse_TaskInfoValidationError{
+				field:  "NextProcessAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryTasksResponse_TaskInfoValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryTasksResponse_TaskInfoValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryTasksResponse_TaskInfoValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return QueryTasksResponse_TaskInfoMultiError(errors)
 	}
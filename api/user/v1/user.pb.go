@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -466,9 +467,12 @@ func (x *LoginRequest) GetCode() string {
 
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                     // 用户uuid（兼容保留）
-	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // 访问令牌（可选，若启用JWT）
-	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 刷新令牌（可选，若启用JWT）
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                     // 用户uuid（兼容保留，不再作为访问凭证）
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`    // 访问令牌，请求时放在 Authorization: Bearer 中
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 刷新令牌，用于调用 RefreshToken 换取新的令牌对
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`         // 访问令牌有效期（秒）
+	MfaRequired   bool                   `protobuf:"varint,5,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`   // 是否需要两步验证，为true时不返回令牌，需调用 VerifyTOTP
+	MfaToken      string                 `protobuf:"bytes,6,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`             // 两步验证挑战令牌，有效期5分钟
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -524,6 +528,635 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 刷新令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                     // 会话ID
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`                             // 设备/平台
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`                                     // 登录IP
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`      // User-Agent
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // 登录时间
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // 最后活跃时间
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`                          // 是否为当前会话
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_api_user_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_user_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 会话ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeAllSessionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeCurrent bool                   `protobuf:"varint,1,opt,name=include_current,json=includeCurrent,proto3" json:"include_current,omitempty"` // 是否同时撤销当前会话，默认保留当前会话
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeAllSessionsRequest) GetIncludeCurrent() bool {
+	if x != nil {
+		return x.IncludeCurrent
+	}
+	return false
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // 邮箱
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // 邮件中的重置令牌
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"` // 旧密码
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"` // 新密码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                           // TOTP密钥（base32），用于手动输入
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"` // otpauth:// 地址，用于生成二维码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_api_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // 验证器中的6位验证码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"` // 恢复码，每个只能使用一次，仅返回一次请妥善保存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_api_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"` // 登录返回的两步验证挑战令牌
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                         // 6位验证码或恢复码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTOTPRequest) Reset() {
+	*x = VerifyTOTPRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTOTPRequest) ProtoMessage() {}
+
+func (x *VerifyTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTOTPRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyTOTPRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 type CreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	XUKey         string                 `protobuf:"bytes,1,opt,name=x_u_key,json=xUKey,proto3" json:"x_u_key,omitempty"`                    // 加密算法key
@@ -535,7 +1168,7 @@ type CreateRequest struct {
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[6]
+	mi := &file_api_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +1180,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[6]
+	mi := &file_api_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +1193,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateRequest) GetXUKey() string {
@@ -593,7 +1226,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[7]
+	mi := &file_api_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +1238,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[7]
+	mi := &file_api_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +1251,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LogoutRequest) GetUserId() int64 {
@@ -639,7 +1272,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[8]
+	mi := &file_api_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +1284,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[8]
+	mi := &file_api_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +1297,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateProfileRequest) GetEmail() string {
@@ -690,14 +1323,14 @@ func (x *UpdateProfileRequest) GetUsername() string {
 
 type GetUserPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID，为空时获取当前用户
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserPermissionRequest) Reset() {
 	*x = GetUserPermissionRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[9]
+	mi := &file_api_user_v1_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +1342,7 @@ func (x *GetUserPermissionRequest) String() string {
 func (*GetUserPermissionRequest) ProtoMessage() {}
 
 func (x *GetUserPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[9]
+	mi := &file_api_user_v1_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +1355,7 @@ func (x *GetUserPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetUserPermissionRequest) GetUserId() int64 {
@@ -741,7 +1374,7 @@ type UserPermissionListResponse struct {
 
 func (x *UserPermissionListResponse) Reset() {
 	*x = UserPermissionListResponse{}
-	mi := &file_api_user_v1_user_proto_msgTypes[10]
+	mi := &file_api_user_v1_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +1386,7 @@ func (x *UserPermissionListResponse) String() string {
 func (*UserPermissionListResponse) ProtoMessage() {}
 
 func (x *UserPermissionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[10]
+	mi := &file_api_user_v1_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1399,7 @@ func (x *UserPermissionListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPermissionListResponse.ProtoReflect.Descriptor instead.
 func (*UserPermissionListResponse) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UserPermissionListResponse) GetPermission() []*UserPermission {
@@ -787,7 +1420,7 @@ type UserPermission struct {
 
 func (x *UserPermission) Reset() {
 	*x = UserPermission{}
-	mi := &file_api_user_v1_user_proto_msgTypes[11]
+	mi := &file_api_user_v1_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +1432,7 @@ func (x *UserPermission) String() string {
 func (*UserPermission) ProtoMessage() {}
 
 func (x *UserPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[11]
+	mi := &file_api_user_v1_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1445,7 @@ func (x *UserPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPermission.ProtoReflect.Descriptor instead.
 func (*UserPermission) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UserPermission) GetResource() string {
@@ -845,7 +1478,7 @@ type IsAccountExistRequest struct {
 
 func (x *IsAccountExistRequest) Reset() {
 	*x = IsAccountExistRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[12]
+	mi := &file_api_user_v1_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +1490,7 @@ func (x *IsAccountExistRequest) String() string {
 func (*IsAccountExistRequest) ProtoMessage() {}
 
 func (x *IsAccountExistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[12]
+	mi := &file_api_user_v1_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1503,7 @@ func (x *IsAccountExistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsAccountExistRequest.ProtoReflect.Descriptor instead.
 func (*IsAccountExistRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *IsAccountExistRequest) GetEmail() string {
@@ -889,7 +1522,7 @@ type IsAccountExistResponse struct {
 
 func (x *IsAccountExistResponse) Reset() {
 	*x = IsAccountExistResponse{}
-	mi := &file_api_user_v1_user_proto_msgTypes[13]
+	mi := &file_api_user_v1_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -901,7 +1534,7 @@ func (x *IsAccountExistResponse) String() string {
 func (*IsAccountExistResponse) ProtoMessage() {}
 
 func (x *IsAccountExistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[13]
+	mi := &file_api_user_v1_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1547,7 @@ func (x *IsAccountExistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsAccountExistResponse.ProtoReflect.Descriptor instead.
 func (*IsAccountExistResponse) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *IsAccountExistResponse) GetIsExist() bool {
@@ -933,7 +1566,7 @@ type GoogleLoginResponse struct {
 
 func (x *GoogleLoginResponse) Reset() {
 	*x = GoogleLoginResponse{}
-	mi := &file_api_user_v1_user_proto_msgTypes[14]
+	mi := &file_api_user_v1_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1578,7 @@ func (x *GoogleLoginResponse) String() string {
 func (*GoogleLoginResponse) ProtoMessage() {}
 
 func (x *GoogleLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[14]
+	mi := &file_api_user_v1_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1591,7 @@ func (x *GoogleLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoogleLoginResponse.ProtoReflect.Descriptor instead.
 func (*GoogleLoginResponse) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GoogleLoginResponse) GetUrl() string {
@@ -968,6 +1601,294 @@ func (x *GoogleLoginResponse) GetUrl() string {
 	return ""
 }
 
+type OAuthLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // 提供方，如 google/github/apple
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthLoginRequest) Reset() {
+	*x = OAuthLoginRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthLoginRequest) ProtoMessage() {}
+
+func (x *OAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*OAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type OAuthLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // 授权地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthLoginResponse) Reset() {
+	*x = OAuthLoginResponse{}
+	mi := &file_api_user_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthLoginResponse) ProtoMessage() {}
+
+func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OAuthLoginResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // 提供方
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // 提供方
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_api_user_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type Identity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                    // 提供方
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                          // 第三方账号邮箱
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // 第三方账号昵称
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 关联时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_api_user_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Identity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*Identity            `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_api_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
 type GoogleCallbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -978,7 +1899,7 @@ type GoogleCallbackRequest struct {
 
 func (x *GoogleCallbackRequest) Reset() {
 	*x = GoogleCallbackRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[15]
+	mi := &file_api_user_v1_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1911,7 @@ func (x *GoogleCallbackRequest) String() string {
 func (*GoogleCallbackRequest) ProtoMessage() {}
 
 func (x *GoogleCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[15]
+	mi := &file_api_user_v1_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1924,7 @@ func (x *GoogleCallbackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoogleCallbackRequest.ProtoReflect.Descriptor instead.
 func (*GoogleCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GoogleCallbackRequest) GetCode() string {
@@ -1029,7 +1950,7 @@ type SendVerificationCodeRequest struct {
 
 func (x *SendVerificationCodeRequest) Reset() {
 	*x = SendVerificationCodeRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[16]
+	mi := &file_api_user_v1_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1041,7 +1962,7 @@ func (x *SendVerificationCodeRequest) String() string {
 func (*SendVerificationCodeRequest) ProtoMessage() {}
 
 func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[16]
+	mi := &file_api_user_v1_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1975,7 @@ func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
 func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SendVerificationCodeRequest) GetEmail() string {
@@ -1074,7 +1995,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_api_user_v1_user_proto_msgTypes[17]
+	mi := &file_api_user_v1_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +2007,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_user_v1_user_proto_msgTypes[17]
+	mi := &file_api_user_v1_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +2020,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_api_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_api_user_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UpdateUserRequest) GetUsername() string {
@@ -1120,7 +2041,7 @@ var File_api_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_api_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x16api/user/v1/user.proto\x12\x06userv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\"k\n" +
+	"\x16api/user/v1/user.proto\x12\x06userv1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"k\n" +
 	"\x0fRegisterRequest\x12\x1d\n" +
 	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12%\n" +
 	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\bpassword\x12\x12\n" +
@@ -1156,11 +2077,54 @@ const file_api_user_v1_user_proto_rawDesc = "" +
 	"\fLoginRequest\x12\x1d\n" +
 	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\"k\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"\xca\x01\n" +
 	"\rLoginResponse\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"b\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fmfa_required\x18\x05 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x06 \x01(\tR\bmfaToken\"C\n" +
+	"\x13RefreshTokenRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\frefreshToken\"\xf3\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"C\n" +
+	"\x14ListSessionsResponse\x12+\n" +
+	"\bsessions\x18\x01 \x03(\v2\x0f.userv1.SessionR\bsessions\">\n" +
+	"\x14RevokeSessionRequest\x12&\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tsessionId\"C\n" +
+	"\x18RevokeAllSessionsRequest\x12'\n" +
+	"\x0finclude_current\x18\x01 \x01(\bR\x0eincludeCurrent\"<\n" +
+	"\x1bRequestPasswordResetRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\"c\n" +
+	"\x14ResetPasswordRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12,\n" +
+	"\fnew_password\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\vnewPassword\"q\n" +
+	"\x15ChangePasswordRequest\x12*\n" +
+	"\fold_password\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\voldPassword\x12,\n" +
+	"\fnew_password\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x14R\vnewPassword\"M\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"2\n" +
+	"\x12ConfirmTOTPRequest\x12\x1c\n" +
+	"\x04code\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\x04code\"<\n" +
+	"\x13ConfirmTOTPResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"V\n" +
+	"\x11VerifyTOTPRequest\x12$\n" +
+	"\tmfa_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bmfaToken\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"b\n" +
 	"\rCreateRequest\x12\x16\n" +
 	"\ax_u_key\x18\x01 \x01(\tR\x05xUKey\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
@@ -1170,9 +2134,9 @@ const file_api_user_v1_user_proto_rawDesc = "" +
 	"\x14UpdateProfileRequest\x12\x1d\n" +
 	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12-\n" +
 	"\x05phone\x18\x02 \x01(\tB\x17\xfaB\x14r\x122\r^1[3-9]\\d{9}$\x98\x01\vR\x05phone\x12%\n" +
-	"\busername\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x03\x18\x14R\busername\"3\n" +
-	"\x18GetUserPermissionRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"T\n" +
+	"\busername\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x03\x18\x14R\busername\"<\n" +
+	"\x18GetUserPermissionRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06userId\"T\n" +
 	"\x1aUserPermissionListResponse\x126\n" +
 	"\n" +
 	"permission\x18\x01 \x03(\v2\x16.userv1.UserPermissionR\n" +
@@ -1186,7 +2150,25 @@ const file_api_user_v1_user_proto_rawDesc = "" +
 	"\x16IsAccountExistResponse\x12\x19\n" +
 	"\bis_exist\x18\x01 \x01(\bR\aisExist\"'\n" +
 	"\x13GoogleLoginResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"A\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"8\n" +
+	"\x11OAuthLoginRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bprovider\"&\n" +
+	"\x12OAuthLoginResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\":\n" +
+	"\x13LinkIdentityRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bprovider\"<\n" +
+	"\x15UnlinkIdentityRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bprovider\"\x8b\x01\n" +
+	"\bIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x16ListIdentitiesResponse\x120\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x10.userv1.IdentityR\n" +
+	"identities\"A\n" +
 	"\x15GoogleCallbackRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\"<\n" +
@@ -1195,18 +2177,36 @@ const file_api_user_v1_user_proto_rawDesc = "" +
 	"\x11UpdateUserRequest\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1f\n" +
 	"\vavatar_path\x18\x03 \x01(\tR\n" +
-	"avatarPath2\xe6\x06\n" +
+	"avatarPath2\xe5\x13\n" +
 	"\vUserService\x12Q\n" +
 	"\x05Login\x12\x14.userv1.LoginRequest\x1a\x15.userv1.LoginResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/users/login\x12Z\n" +
 	"\bRegister\x12\x17.userv1.RegisterRequest\x1a\x15.userv1.LoginResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/users/register\x128\n" +
 	"\x06Create\x12\x15.userv1.CreateRequest\x1a\x15.userv1.LoginResponse\"\x00\x12q\n" +
 	"\x0eIsAccountExist\x12\x1d.userv1.IsAccountExistRequest\x1a\x1e.userv1.IsAccountExistResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/users/account_exist\x12W\n" +
-	"\aGetUser\x12\x16.google.protobuf.Empty\x1a\x17.userv1.GetUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/users/get_user\x12c\n" +
-	"\vGoogleLogin\x12\x16.google.protobuf.Empty\x1a\x1b.userv1.GoogleLoginResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/users/google-login\x12U\n" +
+	"\aGetUser\x12\x16.google.protobuf.Empty\x1a\x17.userv1.GetUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/users/get_user\x12y\n" +
+	"\x11GetUserPermission\x12 .userv1.GetUserPermissionRequest\x1a\".userv1.UserPermissionListResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/users/permissions\x12c\n" +
+	"\vGoogleLogin\x12\x16.google.protobuf.Empty\x1a\x1b.userv1.GoogleLoginResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/users/google-login\x12n\n" +
+	"\n" +
+	"OAuthLogin\x12\x19.userv1.OAuthLoginRequest\x1a\x1a.userv1.OAuthLoginResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/users/oauth/{provider}/login\x12n\n" +
+	"\fLinkIdentity\x12\x1b.userv1.LinkIdentityRequest\x1a\x1a.userv1.OAuthLoginResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/users/identities/link\x12p\n" +
+	"\x0eUnlinkIdentity\x12\x1d.userv1.UnlinkIdentityRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/users/identities/unlink\x12g\n" +
+	"\x0eListIdentities\x12\x16.google.protobuf.Empty\x1a\x1e.userv1.ListIdentitiesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/users/identities\x12U\n" +
 	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x15.userv1.LoginResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/users/logout\x12\x81\x01\n" +
 	"\x14SendVerificationCode\x12#.userv1.SendVerificationCodeRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/users/send_verification_code\x12b\n" +
 	"\n" +
-	"UpdateUser\x12\x19.userv1.UpdateUserRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/users/update_userB0Z.github.com/ydssx/kratos-kit/api/user/v1;userv1b\x06proto3"
+	"UpdateUser\x12\x19.userv1.UpdateUserRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/users/update_user\x12g\n" +
+	"\fRefreshToken\x12\x1b.userv1.RefreshTokenRequest\x1a\x15.userv1.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/users/refresh_token\x12a\n" +
+	"\fListSessions\x12\x16.google.protobuf.Empty\x1a\x1c.userv1.ListSessionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/users/sessions\x12l\n" +
+	"\rRevokeSession\x12\x1c.userv1.RevokeSessionRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/users/sessions/revoke\x12x\n" +
+	"\x11RevokeAllSessions\x12 .userv1.RevokeAllSessionsRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/users/sessions/revoke_all\x12\x81\x01\n" +
+	"\x14RequestPasswordReset\x12#.userv1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/users/password/reset_request\x12k\n" +
+	"\rResetPassword\x12\x1c.userv1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/users/password/reset\x12n\n" +
+	"\x0eChangePassword\x12\x1d.userv1.ChangePasswordRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/users/password/change\x12c\n" +
+	"\n" +
+	"EnrollTOTP\x12\x16.google.protobuf.Empty\x1a\x1a.userv1.EnrollTOTPResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/users/totp/enroll\x12j\n" +
+	"\vConfirmTOTP\x12\x1a.userv1.ConfirmTOTPRequest\x1a\x1b.userv1.ConfirmTOTPResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/users/totp/confirm\x12a\n" +
+	"\n" +
+	"VerifyTOTP\x12\x19.userv1.VerifyTOTPRequest\x1a\x15.userv1.LoginResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/users/totp/verifyB0Z.github.com/ydssx/kratos-kit/api/user/v1;userv1b\x06proto3"
 
 var (
 	file_api_user_v1_user_proto_rawDescOnce sync.Once
@@ -1221,7 +2221,7 @@ func file_api_user_v1_user_proto_rawDescGZIP() []byte {
 }
 
 var file_api_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_api_user_v1_user_proto_goTypes = []any{
 	(RegistrationRequest_RegisterType)(0), // 0: userv1.RegistrationRequest.RegisterType
 	(*RegisterRequest)(nil),               // 1: userv1.RegisterRequest
@@ -1230,46 +2230,100 @@ var file_api_user_v1_user_proto_goTypes = []any{
 	(*RegistrationRequest)(nil),           // 4: userv1.RegistrationRequest
 	(*LoginRequest)(nil),                  // 5: userv1.LoginRequest
 	(*LoginResponse)(nil),                 // 6: userv1.LoginResponse
-	(*CreateRequest)(nil),                 // 7: userv1.CreateRequest
-	(*LogoutRequest)(nil),                 // 8: userv1.LogoutRequest
-	(*UpdateProfileRequest)(nil),          // 9: userv1.UpdateProfileRequest
-	(*GetUserPermissionRequest)(nil),      // 10: userv1.GetUserPermissionRequest
-	(*UserPermissionListResponse)(nil),    // 11: userv1.UserPermissionListResponse
-	(*UserPermission)(nil),                // 12: userv1.UserPermission
-	(*IsAccountExistRequest)(nil),         // 13: userv1.IsAccountExistRequest
-	(*IsAccountExistResponse)(nil),        // 14: userv1.IsAccountExistResponse
-	(*GoogleLoginResponse)(nil),           // 15: userv1.GoogleLoginResponse
-	(*GoogleCallbackRequest)(nil),         // 16: userv1.GoogleCallbackRequest
-	(*SendVerificationCodeRequest)(nil),   // 17: userv1.SendVerificationCodeRequest
-	(*UpdateUserRequest)(nil),             // 18: userv1.UpdateUserRequest
-	(*emptypb.Empty)(nil),                 // 19: google.protobuf.Empty
+	(*RefreshTokenRequest)(nil),           // 7: userv1.RefreshTokenRequest
+	(*Session)(nil),                       // 8: userv1.Session
+	(*ListSessionsResponse)(nil),          // 9: userv1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),          // 10: userv1.RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),      // 11: userv1.RevokeAllSessionsRequest
+	(*RequestPasswordResetRequest)(nil),   // 12: userv1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),          // 13: userv1.ResetPasswordRequest
+	(*ChangePasswordRequest)(nil),         // 14: userv1.ChangePasswordRequest
+	(*EnrollTOTPResponse)(nil),            // 15: userv1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),            // 16: userv1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),           // 17: userv1.ConfirmTOTPResponse
+	(*VerifyTOTPRequest)(nil),             // 18: userv1.VerifyTOTPRequest
+	(*CreateRequest)(nil),                 // 19: userv1.CreateRequest
+	(*LogoutRequest)(nil),                 // 20: userv1.LogoutRequest
+	(*UpdateProfileRequest)(nil),          // 21: userv1.UpdateProfileRequest
+	(*GetUserPermissionRequest)(nil),      // 22: userv1.GetUserPermissionRequest
+	(*UserPermissionListResponse)(nil),    // 23: userv1.UserPermissionListResponse
+	(*UserPermission)(nil),                // 24: userv1.UserPermission
+	(*IsAccountExistRequest)(nil),         // 25: userv1.IsAccountExistRequest
+	(*IsAccountExistResponse)(nil),        // 26: userv1.IsAccountExistResponse
+	(*GoogleLoginResponse)(nil),           // 27: userv1.GoogleLoginResponse
+	(*OAuthLoginRequest)(nil),             // 28: userv1.OAuthLoginRequest
+	(*OAuthLoginResponse)(nil),            // 29: userv1.OAuthLoginResponse
+	(*LinkIdentityRequest)(nil),           // 30: userv1.LinkIdentityRequest
+	(*UnlinkIdentityRequest)(nil),         // 31: userv1.UnlinkIdentityRequest
+	(*Identity)(nil),                      // 32: userv1.Identity
+	(*ListIdentitiesResponse)(nil),        // 33: userv1.ListIdentitiesResponse
+	(*GoogleCallbackRequest)(nil),         // 34: userv1.GoogleCallbackRequest
+	(*SendVerificationCodeRequest)(nil),   // 35: userv1.SendVerificationCodeRequest
+	(*UpdateUserRequest)(nil),             // 36: userv1.UpdateUserRequest
+	(*timestamppb.Timestamp)(nil),         // 37: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 38: google.protobuf.Empty
 }
 var file_api_user_v1_user_proto_depIdxs = []int32{
 	0,  // 0: userv1.RegistrationRequest.register_type:type_name -> userv1.RegistrationRequest.RegisterType
-	12, // 1: userv1.UserPermissionListResponse.permission:type_name -> userv1.UserPermission
-	5,  // 2: userv1.UserService.Login:input_type -> userv1.LoginRequest
-	1,  // 3: userv1.UserService.Register:input_type -> userv1.RegisterRequest
-	7,  // 4: userv1.UserService.Create:input_type -> userv1.CreateRequest
-	13, // 5: userv1.UserService.IsAccountExist:input_type -> userv1.IsAccountExistRequest
-	19, // 6: userv1.UserService.GetUser:input_type -> google.protobuf.Empty
-	19, // 7: userv1.UserService.GoogleLogin:input_type -> google.protobuf.Empty
-	19, // 8: userv1.UserService.Logout:input_type -> google.protobuf.Empty
-	17, // 9: userv1.UserService.SendVerificationCode:input_type -> userv1.SendVerificationCodeRequest
-	18, // 10: userv1.UserService.UpdateUser:input_type -> userv1.UpdateUserRequest
-	6,  // 11: userv1.UserService.Login:output_type -> userv1.LoginResponse
-	6,  // 12: userv1.UserService.Register:output_type -> userv1.LoginResponse
-	6,  // 13: userv1.UserService.Create:output_type -> userv1.LoginResponse
-	14, // 14: userv1.UserService.IsAccountExist:output_type -> userv1.IsAccountExistResponse
-	2,  // 15: userv1.UserService.GetUser:output_type -> userv1.GetUserResponse
-	15, // 16: userv1.UserService.GoogleLogin:output_type -> userv1.GoogleLoginResponse
-	6,  // 17: userv1.UserService.Logout:output_type -> userv1.LoginResponse
-	19, // 18: userv1.UserService.SendVerificationCode:output_type -> google.protobuf.Empty
-	19, // 19: userv1.UserService.UpdateUser:output_type -> google.protobuf.Empty
-	11, // [11:20] is the sub-list for method output_type
-	2,  // [2:11] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	37, // 1: userv1.Session.created_at:type_name -> google.protobuf.Timestamp
+	37, // 2: userv1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: userv1.ListSessionsResponse.sessions:type_name -> userv1.Session
+	24, // 4: userv1.UserPermissionListResponse.permission:type_name -> userv1.UserPermission
+	37, // 5: userv1.Identity.created_at:type_name -> google.protobuf.Timestamp
+	32, // 6: userv1.ListIdentitiesResponse.identities:type_name -> userv1.Identity
+	5,  // 7: userv1.UserService.Login:input_type -> userv1.LoginRequest
+	1,  // 8: userv1.UserService.Register:input_type -> userv1.RegisterRequest
+	19, // 9: userv1.UserService.Create:input_type -> userv1.CreateRequest
+	25, // 10: userv1.UserService.IsAccountExist:input_type -> userv1.IsAccountExistRequest
+	38, // 11: userv1.UserService.GetUser:input_type -> google.protobuf.Empty
+	22, // 12: userv1.UserService.GetUserPermission:input_type -> userv1.GetUserPermissionRequest
+	38, // 13: userv1.UserService.GoogleLogin:input_type -> google.protobuf.Empty
+	28, // 14: userv1.UserService.OAuthLogin:input_type -> userv1.OAuthLoginRequest
+	30, // 15: userv1.UserService.LinkIdentity:input_type -> userv1.LinkIdentityRequest
+	31, // 16: userv1.UserService.UnlinkIdentity:input_type -> userv1.UnlinkIdentityRequest
+	38, // 17: userv1.UserService.ListIdentities:input_type -> google.protobuf.Empty
+	38, // 18: userv1.UserService.Logout:input_type -> google.protobuf.Empty
+	35, // 19: userv1.UserService.SendVerificationCode:input_type -> userv1.SendVerificationCodeRequest
+	36, // 20: userv1.UserService.UpdateUser:input_type -> userv1.UpdateUserRequest
+	7,  // 21: userv1.UserService.RefreshToken:input_type -> userv1.RefreshTokenRequest
+	38, // 22: userv1.UserService.ListSessions:input_type -> google.protobuf.Empty
+	10, // 23: userv1.UserService.RevokeSession:input_type -> userv1.RevokeSessionRequest
+	11, // 24: userv1.UserService.RevokeAllSessions:input_type -> userv1.RevokeAllSessionsRequest
+	12, // 25: userv1.UserService.RequestPasswordReset:input_type -> userv1.RequestPasswordResetRequest
+	13, // 26: userv1.UserService.ResetPassword:input_type -> userv1.ResetPasswordRequest
+	14, // 27: userv1.UserService.ChangePassword:input_type -> userv1.ChangePasswordRequest
+	38, // 28: userv1.UserService.EnrollTOTP:input_type -> google.protobuf.Empty
+	16, // 29: userv1.UserService.ConfirmTOTP:input_type -> userv1.ConfirmTOTPRequest
+	18, // 30: userv1.UserService.VerifyTOTP:input_type -> userv1.VerifyTOTPRequest
+	6,  // 31: userv1.UserService.Login:output_type -> userv1.LoginResponse
+	6,  // 32: userv1.UserService.Register:output_type -> userv1.LoginResponse
+	6,  // 33: userv1.UserService.Create:output_type -> userv1.LoginResponse
+	26, // 34: userv1.UserService.IsAccountExist:output_type -> userv1.IsAccountExistResponse
+	2,  // 35: userv1.UserService.GetUser:output_type -> userv1.GetUserResponse
+	23, // 36: userv1.UserService.GetUserPermission:output_type -> userv1.UserPermissionListResponse
+	27, // 37: userv1.UserService.GoogleLogin:output_type -> userv1.GoogleLoginResponse
+	29, // 38: userv1.UserService.OAuthLogin:output_type -> userv1.OAuthLoginResponse
+	29, // 39: userv1.UserService.LinkIdentity:output_type -> userv1.OAuthLoginResponse
+	38, // 40: userv1.UserService.UnlinkIdentity:output_type -> google.protobuf.Empty
+	33, // 41: userv1.UserService.ListIdentities:output_type -> userv1.ListIdentitiesResponse
+	6,  // 42: userv1.UserService.Logout:output_type -> userv1.LoginResponse
+	38, // 43: userv1.UserService.SendVerificationCode:output_type -> google.protobuf.Empty
+	38, // 44: userv1.UserService.UpdateUser:output_type -> google.protobuf.Empty
+	6,  // 45: userv1.UserService.RefreshToken:output_type -> userv1.LoginResponse
+	9,  // 46: userv1.UserService.ListSessions:output_type -> userv1.ListSessionsResponse
+	38, // 47: userv1.UserService.RevokeSession:output_type -> google.protobuf.Empty
+	38, // 48: userv1.UserService.RevokeAllSessions:output_type -> google.protobuf.Empty
+	38, // 49: userv1.UserService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	38, // 50: userv1.UserService.ResetPassword:output_type -> google.protobuf.Empty
+	38, // 51: userv1.UserService.ChangePassword:output_type -> google.protobuf.Empty
+	15, // 52: userv1.UserService.EnrollTOTP:output_type -> userv1.EnrollTOTPResponse
+	17, // 53: userv1.UserService.ConfirmTOTP:output_type -> userv1.ConfirmTOTPResponse
+	6,  // 54: userv1.UserService.VerifyTOTP:output_type -> userv1.LoginResponse
+	31, // [31:55] is the sub-list for method output_type
+	7,  // [7:31] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_user_v1_user_proto_init() }
@@ -1283,7 +2337,7 @@ func file_api_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_user_v1_user_proto_rawDesc), len(file_api_user_v1_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -812,6 +812,12 @@ func (m *LoginResponse) validate(all bool) error {
 
 	// no validation rules for RefreshToken
 
+	// no validation rules for ExpiresIn
+
+	// no validation rules for MfaRequired
+
+	// no validation rules for MfaToken
+
 	if len(errors) > 0 {
 		return LoginResponseMultiError(errors)
 	}
@@ -890,48 +896,53 @@ var _ interface {
 	ErrorName() string
 } = LoginResponseValidationError{}
 
-// Validate checks the field values on CreateRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CreateRequest) Validate() error {
+// Validate checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreateRequestMultiError, or
-// nil if none found.
-func (m *CreateRequest) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshTokenRequestMultiError, or nil if none found.
+func (m *RefreshTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRequest) validate(all bool) error {
+func (m *RefreshTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for XUKey
-
-	// no validation rules for Token
-
-	// no validation rules for SourceDomain
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := RefreshTokenRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateRequestMultiError(errors)
+		return RefreshTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRequestMultiError []error
+// RefreshTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRequestMultiError) Error() string {
+func (m RefreshTokenRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -940,11 +951,11 @@ func (m CreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateRequestValidationError is the validation error returned by
-// CreateRequest.Validate if the designated constraints aren't met.
-type CreateRequestValidationError struct {
+// RefreshTokenRequestValidationError is the validation error returned by
+// RefreshTokenRequest.Validate if the designated constraints aren't met.
+type RefreshTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -952,22 +963,24 @@ type CreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRequestValidationError) Field() string { return e.field }
+func (e RefreshTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRequestValidationError) Key() bool { return e.key }
+func (e RefreshTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRequestValidationError) ErrorName() string { return "CreateRequestValidationError" }
+func (e RefreshTokenRequestValidationError) ErrorName() string {
+	return "RefreshTokenRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateRequestValidationError) Error() string {
+func (e RefreshTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -979,14 +992,14 @@ func (e CreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRequest.%s: %s%s",
+		"invalid %sRefreshTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRequestValidationError{}
+var _ error = RefreshTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -994,46 +1007,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRequestValidationError{}
+} = RefreshTokenRequestValidationError{}
 
-// Validate checks the field values on LogoutRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LogoutRequest) Validate() error {
+func (m *Session) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LogoutRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LogoutRequestMultiError, or
-// nil if none found.
-func (m *LogoutRequest) ValidateAll() error {
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LogoutRequest) validate(all bool) error {
+func (m *Session) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Id
+
+	// no validation rules for Device
+
+	// no validation rules for Ip
+
+	// no validation rules for UserAgent
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Current
 
 	if len(errors) > 0 {
-		return LogoutRequestMultiError(errors)
+		return SessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// LogoutRequestMultiError is an error wrapping multiple validation errors
-// returned by LogoutRequest.ValidateAll() if the designated constraints
-// aren't met.
-type LogoutRequestMultiError []error
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LogoutRequestMultiError) Error() string {
+func (m SessionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1042,11 +1119,11 @@ func (m LogoutRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LogoutRequestMultiError) AllErrors() []error { return m }
+func (m SessionMultiError) AllErrors() []error { return m }
 
-// LogoutRequestValidationError is the validation error returned by
-// LogoutRequest.Validate if the designated constraints aren't met.
-type LogoutRequestValidationError struct {
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1054,22 +1131,22 @@ type LogoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LogoutRequestValidationError) Field() string { return e.field }
+func (e SessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LogoutRequestValidationError) Reason() string { return e.reason }
+func (e SessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LogoutRequestValidationError) Cause() error { return e.cause }
+func (e SessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LogoutRequestValidationError) Key() bool { return e.key }
+func (e SessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LogoutRequestValidationError) Error() string {
+func (e SessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1081,14 +1158,14 @@ func (e LogoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLogoutRequest.%s: %s%s",
+		"invalid %sSession.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LogoutRequestValidationError{}
+var _ error = SessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1096,140 +1173,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LogoutRequestValidationError{}
+} = SessionValidationError{}
 
-// Validate checks the field values on UpdateProfileRequest with the rules
+// Validate checks the field values on ListSessionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProfileRequest) Validate() error {
+func (m *ListSessionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProfileRequest with the rules
+// ValidateAll checks the field values on ListSessionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProfileRequestMultiError, or nil if none found.
-func (m *UpdateProfileRequest) ValidateAll() error {
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProfileRequest) validate(all bool) error {
+func (m *ListSessionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = UpdateProfileRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPhone()) != 11 {
-		err := UpdateProfileRequestValidationError{
-			field:  "Phone",
-			reason: "value length must be 11 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-
-	}
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
 
-	if !_UpdateProfileRequest_Phone_Pattern.MatchString(m.GetPhone()) {
-		err := UpdateProfileRequestValidationError{
-			field:  "Phone",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 20 {
-		err := UpdateProfileRequestValidationError{
-			field:  "Username",
-			reason: "value length must be between 3 and 20 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateProfileRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *UpdateProfileRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return ListSessionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UpdateProfileRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// UpdateProfileRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProfileRequest.ValidateAll() if the designated
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProfileRequestMultiError []error
+type ListSessionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProfileRequestMultiError) Error() string {
+func (m ListSessionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1238,11 +1253,11 @@ func (m UpdateProfileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProfileRequestMultiError) AllErrors() []error { return m }
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateProfileRequestValidationError is the validation error returned by
-// UpdateProfileRequest.Validate if the designated constraints aren't met.
-type UpdateProfileRequestValidationError struct {
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1250,24 +1265,24 @@ type UpdateProfileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProfileRequestValidationError) Field() string { return e.field }
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProfileRequestValidationError) Reason() string { return e.reason }
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProfileRequestValidationError) Cause() error { return e.cause }
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProfileRequestValidationError) Key() bool { return e.key }
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProfileRequestValidationError) ErrorName() string {
-	return "UpdateProfileRequestValidationError"
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProfileRequestValidationError) Error() string {
+func (e ListSessionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1279,14 +1294,14 @@ func (e UpdateProfileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProfileRequest.%s: %s%s",
+		"invalid %sListSessionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProfileRequestValidationError{}
+var _ error = ListSessionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1294,26 +1309,1206 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProfileRequestValidationError{}
-
-var _UpdateProfileRequest_Phone_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+} = ListSessionsResponseValidationError{}
 
-// Validate checks the field values on GetUserPermissionRequest with the rules
+// Validate checks the field values on RevokeSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserPermissionRequest) Validate() error {
+func (m *RevokeSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserPermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserPermissionRequestMultiError, or nil if none found.
-func (m *GetUserPermissionRequest) ValidateAll() error {
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserPermissionRequest) validate(all bool) error {
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionId()) < 1 {
+		err := RevokeSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeAllSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAllSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAllSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAllSessionsRequestMultiError, or nil if none found.
+func (m *RevokeAllSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAllSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeCurrent
+
+	if len(errors) > 0 {
+		return RevokeAllSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAllSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAllSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAllSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAllSessionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAllSessionsRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAllSessionsRequestValidationError is the validation error returned by
+// RevokeAllSessionsRequest.Validate if the designated constraints aren't met.
+type RevokeAllSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAllSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAllSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAllSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAllSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAllSessionsRequestValidationError) ErrorName() string {
+	return "RevokeAllSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAllSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAllSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAllSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAllSessionsRequestValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RequestPasswordResetRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestPasswordResetRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RequestPasswordResetRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := ResetPasswordRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 6 || l > 20 {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 6 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOldPassword()) < 1 {
+		err := ChangePasswordRequestValidationError{
+			field:  "OldPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 6 || l > 20 {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 6 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPResponseMultiError, or nil if none found.
+func (m *EnrollTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for OtpauthUri
+
+	if len(errors) > 0 {
+		return EnrollTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPResponseValidationError is the validation error returned by
+// EnrollTOTPResponse.Validate if the designated constraints aren't met.
+type EnrollTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPResponseValidationError) ErrorName() string {
+	return "EnrollTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPResponseValidationError{}
+
+// Validate checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPRequestMultiError, or nil if none found.
+func (m *ConfirmTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := ConfirmTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPRequestValidationError is the validation error returned by
+// ConfirmTOTPRequest.Validate if the designated constraints aren't met.
+type ConfirmTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPRequestValidationError) ErrorName() string {
+	return "ConfirmTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPRequestValidationError{}
+
+// Validate checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPResponseMultiError, or nil if none found.
+func (m *ConfirmTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPResponseValidationError is the validation error returned by
+// ConfirmTOTPResponse.Validate if the designated constraints aren't met.
+type ConfirmTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPResponseValidationError) ErrorName() string {
+	return "ConfirmTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPResponseValidationError{}
+
+// Validate checks the field values on VerifyTOTPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyTOTPRequestMultiError, or nil if none found.
+func (m *VerifyTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMfaToken()) < 1 {
+		err := VerifyTOTPRequestValidationError{
+			field:  "MfaToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := VerifyTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyTOTPRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyTOTPRequestMultiError) AllErrors() []error { return m }
+
+// VerifyTOTPRequestValidationError is the validation error returned by
+// VerifyTOTPRequest.Validate if the designated constraints aren't met.
+type VerifyTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyTOTPRequestValidationError) ErrorName() string {
+	return "VerifyTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyTOTPRequestValidationError{}
+
+// Validate checks the field values on CreateRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateRequestMultiError, or
+// nil if none found.
+func (m *CreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for XUKey
+
+	// no validation rules for Token
+
+	// no validation rules for SourceDomain
+
+	if len(errors) > 0 {
+		return CreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRequestMultiError) AllErrors() []error { return m }
+
+// CreateRequestValidationError is the validation error returned by
+// CreateRequest.Validate if the designated constraints aren't met.
+type CreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRequestValidationError) ErrorName() string { return "CreateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRequestValidationError{}
+
+// Validate checks the field values on LogoutRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutRequestMultiError, or
+// nil if none found.
+func (m *LogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1323,19 +2518,993 @@ func (m *GetUserPermissionRequest) validate(all bool) error {
 	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return GetUserPermissionRequestMultiError(errors)
+		return LogoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by LogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutRequestMultiError) AllErrors() []error { return m }
+
+// LogoutRequestValidationError is the validation error returned by
+// LogoutRequest.Validate if the designated constraints aren't met.
+type LogoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutRequestValidationError) ErrorName() string { return "LogoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutRequestValidationError{}
+
+// Validate checks the field values on UpdateProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProfileRequestMultiError, or nil if none found.
+func (m *UpdateProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = UpdateProfileRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPhone()) != 11 {
+		err := UpdateProfileRequestValidationError{
+			field:  "Phone",
+			reason: "value length must be 11 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_UpdateProfileRequest_Phone_Pattern.MatchString(m.GetPhone()) {
+		err := UpdateProfileRequestValidationError{
+			field:  "Phone",
+			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 20 {
+		err := UpdateProfileRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 3 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateProfileRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateProfileRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UpdateProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProfileRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProfileRequestValidationError is the validation error returned by
+// UpdateProfileRequest.Validate if the designated constraints aren't met.
+type UpdateProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProfileRequestValidationError) ErrorName() string {
+	return "UpdateProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProfileRequestValidationError{}
+
+var _UpdateProfileRequest_Phone_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+
+// Validate checks the field values on GetUserPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserPermissionRequestMultiError, or nil if none found.
+func (m *GetUserPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := GetUserPermissionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserPermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserPermissionRequestMultiError) AllErrors() []error { return m }
+
+// GetUserPermissionRequestValidationError is the validation error returned by
+// GetUserPermissionRequest.Validate if the designated constraints aren't met.
+type GetUserPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserPermissionRequestValidationError) ErrorName() string {
+	return "GetUserPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserPermissionRequestValidationError{}
+
+// Validate checks the field values on UserPermissionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPermissionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPermissionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPermissionListResponseMultiError, or nil if none found.
+func (m *UserPermissionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPermissionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermission() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserPermissionListResponseValidationError{
+						field:  fmt.Sprintf("Permission[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserPermissionListResponseValidationError{
+						field:  fmt.Sprintf("Permission[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserPermissionListResponseValidationError{
+					field:  fmt.Sprintf("Permission[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserPermissionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPermissionListResponseMultiError is an error wrapping multiple
+// validation errors returned by UserPermissionListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UserPermissionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPermissionListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPermissionListResponseMultiError) AllErrors() []error { return m }
+
+// UserPermissionListResponseValidationError is the validation error returned
+// by UserPermissionListResponse.Validate if the designated constraints aren't met.
+type UserPermissionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPermissionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPermissionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPermissionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPermissionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPermissionListResponseValidationError) ErrorName() string {
+	return "UserPermissionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPermissionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPermissionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPermissionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPermissionListResponseValidationError{}
+
+// Validate checks the field values on UserPermission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPermissionMultiError,
+// or nil if none found.
+func (m *UserPermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Resource
+
+	if len(errors) > 0 {
+		return UserPermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPermissionMultiError is an error wrapping multiple validation errors
+// returned by UserPermission.ValidateAll() if the designated constraints
+// aren't met.
+type UserPermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPermissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPermissionMultiError) AllErrors() []error { return m }
+
+// UserPermissionValidationError is the validation error returned by
+// UserPermission.Validate if the designated constraints aren't met.
+type UserPermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPermissionValidationError) ErrorName() string { return "UserPermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPermissionValidationError{}
+
+// Validate checks the field values on IsAccountExistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsAccountExistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsAccountExistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsAccountExistRequestMultiError, or nil if none found.
+func (m *IsAccountExistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsAccountExistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return IsAccountExistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsAccountExistRequestMultiError is an error wrapping multiple validation
+// errors returned by IsAccountExistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IsAccountExistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsAccountExistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsAccountExistRequestMultiError) AllErrors() []error { return m }
+
+// IsAccountExistRequestValidationError is the validation error returned by
+// IsAccountExistRequest.Validate if the designated constraints aren't met.
+type IsAccountExistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsAccountExistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsAccountExistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsAccountExistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsAccountExistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsAccountExistRequestValidationError) ErrorName() string {
+	return "IsAccountExistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsAccountExistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsAccountExistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsAccountExistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsAccountExistRequestValidationError{}
+
+// Validate checks the field values on IsAccountExistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsAccountExistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsAccountExistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsAccountExistResponseMultiError, or nil if none found.
+func (m *IsAccountExistResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsAccountExistResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsExist
+
+	if len(errors) > 0 {
+		return IsAccountExistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserPermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserPermissionRequest.ValidateAll() if the designated
+// IsAccountExistResponseMultiError is an error wrapping multiple validation
+// errors returned by IsAccountExistResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserPermissionRequestMultiError []error
+type IsAccountExistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsAccountExistResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsAccountExistResponseMultiError) AllErrors() []error { return m }
+
+// IsAccountExistResponseValidationError is the validation error returned by
+// IsAccountExistResponse.Validate if the designated constraints aren't met.
+type IsAccountExistResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsAccountExistResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsAccountExistResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsAccountExistResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsAccountExistResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsAccountExistResponseValidationError) ErrorName() string {
+	return "IsAccountExistResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsAccountExistResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsAccountExistResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsAccountExistResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsAccountExistResponseValidationError{}
+
+// Validate checks the field values on GoogleLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoogleLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoogleLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoogleLoginResponseMultiError, or nil if none found.
+func (m *GoogleLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoogleLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return GoogleLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoogleLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by GoogleLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoogleLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoogleLoginResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoogleLoginResponseMultiError) AllErrors() []error { return m }
+
+// GoogleLoginResponseValidationError is the validation error returned by
+// GoogleLoginResponse.Validate if the designated constraints aren't met.
+type GoogleLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoogleLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoogleLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoogleLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoogleLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoogleLoginResponseValidationError) ErrorName() string {
+	return "GoogleLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoogleLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoogleLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoogleLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoogleLoginResponseValidationError{}
+
+// Validate checks the field values on OAuthLoginRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OAuthLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthLoginRequestMultiError, or nil if none found.
+func (m *OAuthLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProvider()) < 1 {
+		err := OAuthLoginRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OAuthLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by OAuthLoginRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OAuthLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserPermissionRequestMultiError) Error() string {
+func (m OAuthLoginRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1344,11 +3513,11 @@ func (m GetUserPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserPermissionRequestMultiError) AllErrors() []error { return m }
+func (m OAuthLoginRequestMultiError) AllErrors() []error { return m }
 
-// GetUserPermissionRequestValidationError is the validation error returned by
-// GetUserPermissionRequest.Validate if the designated constraints aren't met.
-type GetUserPermissionRequestValidationError struct {
+// OAuthLoginRequestValidationError is the validation error returned by
+// OAuthLoginRequest.Validate if the designated constraints aren't met.
+type OAuthLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1356,24 +3525,24 @@ type GetUserPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserPermissionRequestValidationError) Field() string { return e.field }
+func (e OAuthLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserPermissionRequestValidationError) Reason() string { return e.reason }
+func (e OAuthLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserPermissionRequestValidationError) Cause() error { return e.cause }
+func (e OAuthLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserPermissionRequestValidationError) Key() bool { return e.key }
+func (e OAuthLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserPermissionRequestValidationError) ErrorName() string {
-	return "GetUserPermissionRequestValidationError"
+func (e OAuthLoginRequestValidationError) ErrorName() string {
+	return "OAuthLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserPermissionRequestValidationError) Error() string {
+func (e OAuthLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1385,14 +3554,14 @@ func (e GetUserPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserPermissionRequest.%s: %s%s",
+		"invalid %sOAuthLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserPermissionRequestValidationError{}
+var _ error = OAuthLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1400,78 +3569,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserPermissionRequestValidationError{}
+} = OAuthLoginRequestValidationError{}
 
-// Validate checks the field values on UserPermissionListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on OAuthLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserPermissionListResponse) Validate() error {
+func (m *OAuthLoginResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPermissionListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OAuthLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPermissionListResponseMultiError, or nil if none found.
-func (m *UserPermissionListResponse) ValidateAll() error {
+// OAuthLoginResponseMultiError, or nil if none found.
+func (m *OAuthLoginResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPermissionListResponse) validate(all bool) error {
+func (m *OAuthLoginResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPermission() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UserPermissionListResponseValidationError{
-						field:  fmt.Sprintf("Permission[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UserPermissionListResponseValidationError{
-						field:  fmt.Sprintf("Permission[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UserPermissionListResponseValidationError{
-					field:  fmt.Sprintf("Permission[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Url
 
 	if len(errors) > 0 {
-		return UserPermissionListResponseMultiError(errors)
+		return OAuthLoginResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPermissionListResponseMultiError is an error wrapping multiple
-// validation errors returned by UserPermissionListResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UserPermissionListResponseMultiError []error
+// OAuthLoginResponseMultiError is an error wrapping multiple validation errors
+// returned by OAuthLoginResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OAuthLoginResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPermissionListResponseMultiError) Error() string {
+func (m OAuthLoginResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1480,11 +3617,11 @@ func (m UserPermissionListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPermissionListResponseMultiError) AllErrors() []error { return m }
+func (m OAuthLoginResponseMultiError) AllErrors() []error { return m }
 
-// UserPermissionListResponseValidationError is the validation error returned
-// by UserPermissionListResponse.Validate if the designated constraints aren't met.
-type UserPermissionListResponseValidationError struct {
+// OAuthLoginResponseValidationError is the validation error returned by
+// OAuthLoginResponse.Validate if the designated constraints aren't met.
+type OAuthLoginResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1492,24 +3629,24 @@ type UserPermissionListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPermissionListResponseValidationError) Field() string { return e.field }
+func (e OAuthLoginResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPermissionListResponseValidationError) Reason() string { return e.reason }
+func (e OAuthLoginResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPermissionListResponseValidationError) Cause() error { return e.cause }
+func (e OAuthLoginResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPermissionListResponseValidationError) Key() bool { return e.key }
+func (e OAuthLoginResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPermissionListResponseValidationError) ErrorName() string {
-	return "UserPermissionListResponseValidationError"
+func (e OAuthLoginResponseValidationError) ErrorName() string {
+	return "OAuthLoginResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserPermissionListResponseValidationError) Error() string {
+func (e OAuthLoginResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1521,14 +3658,14 @@ func (e UserPermissionListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPermissionListResponse.%s: %s%s",
+		"invalid %sOAuthLoginResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPermissionListResponseValidationError{}
+var _ error = OAuthLoginResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1536,46 +3673,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPermissionListResponseValidationError{}
+} = OAuthLoginResponseValidationError{}
 
-// Validate checks the field values on UserPermission with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserPermission) Validate() error {
+// Validate checks the field values on LinkIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LinkIdentityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPermission with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserPermissionMultiError,
-// or nil if none found.
-func (m *UserPermission) ValidateAll() error {
+// ValidateAll checks the field values on LinkIdentityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkIdentityRequestMultiError, or nil if none found.
+func (m *LinkIdentityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPermission) validate(all bool) error {
+func (m *LinkIdentityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Resource
+	if utf8.RuneCountInString(m.GetProvider()) < 1 {
+		err := LinkIdentityRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UserPermissionMultiError(errors)
+		return LinkIdentityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPermissionMultiError is an error wrapping multiple validation errors
-// returned by UserPermission.ValidateAll() if the designated constraints
-// aren't met.
-type UserPermissionMultiError []error
+// LinkIdentityRequestMultiError is an error wrapping multiple validation
+// errors returned by LinkIdentityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LinkIdentityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPermissionMultiError) Error() string {
+func (m LinkIdentityRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1584,11 +3730,11 @@ func (m UserPermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPermissionMultiError) AllErrors() []error { return m }
+func (m LinkIdentityRequestMultiError) AllErrors() []error { return m }
 
-// UserPermissionValidationError is the validation error returned by
-// UserPermission.Validate if the designated constraints aren't met.
-type UserPermissionValidationError struct {
+// LinkIdentityRequestValidationError is the validation error returned by
+// LinkIdentityRequest.Validate if the designated constraints aren't met.
+type LinkIdentityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1596,22 +3742,24 @@ type UserPermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPermissionValidationError) Field() string { return e.field }
+func (e LinkIdentityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPermissionValidationError) Reason() string { return e.reason }
+func (e LinkIdentityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPermissionValidationError) Cause() error { return e.cause }
+func (e LinkIdentityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPermissionValidationError) Key() bool { return e.key }
+func (e LinkIdentityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPermissionValidationError) ErrorName() string { return "UserPermissionValidationError" }
+func (e LinkIdentityRequestValidationError) ErrorName() string {
+	return "LinkIdentityRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPermissionValidationError) Error() string {
+func (e LinkIdentityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1623,14 +3771,14 @@ func (e UserPermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPermission.%s: %s%s",
+		"invalid %sLinkIdentityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPermissionValidationError{}
+var _ error = LinkIdentityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1638,46 +3786,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPermissionValidationError{}
+} = LinkIdentityRequestValidationError{}
 
-// Validate checks the field values on IsAccountExistRequest with the rules
+// Validate checks the field values on UnlinkIdentityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsAccountExistRequest) Validate() error {
+func (m *UnlinkIdentityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsAccountExistRequest with the rules
+// ValidateAll checks the field values on UnlinkIdentityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsAccountExistRequestMultiError, or nil if none found.
-func (m *IsAccountExistRequest) ValidateAll() error {
+// UnlinkIdentityRequestMultiError, or nil if none found.
+func (m *UnlinkIdentityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsAccountExistRequest) validate(all bool) error {
+func (m *UnlinkIdentityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Email
+	if utf8.RuneCountInString(m.GetProvider()) < 1 {
+		err := UnlinkIdentityRequestValidationError{
+			field:  "Provider",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IsAccountExistRequestMultiError(errors)
+		return UnlinkIdentityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsAccountExistRequestMultiError is an error wrapping multiple validation
-// errors returned by IsAccountExistRequest.ValidateAll() if the designated
+// UnlinkIdentityRequestMultiError is an error wrapping multiple validation
+// errors returned by UnlinkIdentityRequest.ValidateAll() if the designated
 // constraints aren't met.
-type IsAccountExistRequestMultiError []error
+type UnlinkIdentityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsAccountExistRequestMultiError) Error() string {
+func (m UnlinkIdentityRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1686,11 +3843,11 @@ func (m IsAccountExistRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsAccountExistRequestMultiError) AllErrors() []error { return m }
+func (m UnlinkIdentityRequestMultiError) AllErrors() []error { return m }
 
-// IsAccountExistRequestValidationError is the validation error returned by
-// IsAccountExistRequest.Validate if the designated constraints aren't met.
-type IsAccountExistRequestValidationError struct {
+// UnlinkIdentityRequestValidationError is the validation error returned by
+// UnlinkIdentityRequest.Validate if the designated constraints aren't met.
+type UnlinkIdentityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1698,24 +3855,24 @@ type IsAccountExistRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsAccountExistRequestValidationError) Field() string { return e.field }
+func (e UnlinkIdentityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsAccountExistRequestValidationError) Reason() string { return e.reason }
+func (e UnlinkIdentityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsAccountExistRequestValidationError) Cause() error { return e.cause }
+func (e UnlinkIdentityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsAccountExistRequestValidationError) Key() bool { return e.key }
+func (e UnlinkIdentityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsAccountExistRequestValidationError) ErrorName() string {
-	return "IsAccountExistRequestValidationError"
+func (e UnlinkIdentityRequestValidationError) ErrorName() string {
+	return "UnlinkIdentityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsAccountExistRequestValidationError) Error() string {
+func (e UnlinkIdentityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1727,14 +3884,14 @@ func (e IsAccountExistRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsAccountExistRequest.%s: %s%s",
+		"invalid %sUnlinkIdentityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsAccountExistRequestValidationError{}
+var _ error = UnlinkIdentityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1742,46 +3899,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsAccountExistRequestValidationError{}
+} = UnlinkIdentityRequestValidationError{}
 
-// Validate checks the field values on IsAccountExistResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsAccountExistResponse) Validate() error {
+// Validate checks the field values on Identity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Identity) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsAccountExistResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IsAccountExistResponseMultiError, or nil if none found.
-func (m *IsAccountExistResponse) ValidateAll() error {
+// ValidateAll checks the field values on Identity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentityMultiError, or nil
+// if none found.
+func (m *Identity) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsAccountExistResponse) validate(all bool) error {
+func (m *Identity) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsExist
+	// no validation rules for Provider
+
+	// no validation rules for Email
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdentityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdentityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdentityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return IsAccountExistResponseMultiError(errors)
+		return IdentityMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsAccountExistResponseMultiError is an error wrapping multiple validation
-// errors returned by IsAccountExistResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IsAccountExistResponseMultiError []error
+// IdentityMultiError is an error wrapping multiple validation errors returned
+// by Identity.ValidateAll() if the designated constraints aren't met.
+type IdentityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsAccountExistResponseMultiError) Error() string {
+func (m IdentityMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1790,11 +3979,11 @@ func (m IsAccountExistResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsAccountExistResponseMultiError) AllErrors() []error { return m }
+func (m IdentityMultiError) AllErrors() []error { return m }
 
-// IsAccountExistResponseValidationError is the validation error returned by
-// IsAccountExistResponse.Validate if the designated constraints aren't met.
-type IsAccountExistResponseValidationError struct {
+// IdentityValidationError is the validation error returned by
+// Identity.Validate if the designated constraints aren't met.
+type IdentityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1802,24 +3991,22 @@ type IsAccountExistResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsAccountExistResponseValidationError) Field() string { return e.field }
+func (e IdentityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsAccountExistResponseValidationError) Reason() string { return e.reason }
+func (e IdentityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsAccountExistResponseValidationError) Cause() error { return e.cause }
+func (e IdentityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsAccountExistResponseValidationError) Key() bool { return e.key }
+func (e IdentityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsAccountExistResponseValidationError) ErrorName() string {
-	return "IsAccountExistResponseValidationError"
-}
+func (e IdentityValidationError) ErrorName() string { return "IdentityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IsAccountExistResponseValidationError) Error() string {
+func (e IdentityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1831,14 +4018,14 @@ func (e IsAccountExistResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsAccountExistResponse.%s: %s%s",
+		"invalid %sIdentity.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsAccountExistResponseValidationError{}
+var _ error = IdentityValidationError{}
 
 var _ interface {
 	Field() string
@@ -1846,46 +4033,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsAccountExistResponseValidationError{}
+} = IdentityValidationError{}
 
-// Validate checks the field values on GoogleLoginResponse with the rules
+// Validate checks the field values on ListIdentitiesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GoogleLoginResponse) Validate() error {
+func (m *ListIdentitiesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GoogleLoginResponse with the rules
+// ValidateAll checks the field values on ListIdentitiesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GoogleLoginResponseMultiError, or nil if none found.
-func (m *GoogleLoginResponse) ValidateAll() error {
+// ListIdentitiesResponseMultiError, or nil if none found.
+func (m *ListIdentitiesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GoogleLoginResponse) validate(all bool) error {
+func (m *ListIdentitiesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Url
+	for idx, item := range m.GetIdentities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIdentitiesResponseValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIdentitiesResponseValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIdentitiesResponseValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GoogleLoginResponseMultiError(errors)
+		return ListIdentitiesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GoogleLoginResponseMultiError is an error wrapping multiple validation
-// errors returned by GoogleLoginResponse.ValidateAll() if the designated
+// ListIdentitiesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListIdentitiesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GoogleLoginResponseMultiError []error
+type ListIdentitiesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GoogleLoginResponseMultiError) Error() string {
+func (m ListIdentitiesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1894,11 +4113,11 @@ func (m GoogleLoginResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GoogleLoginResponseMultiError) AllErrors() []error { return m }
+func (m ListIdentitiesResponseMultiError) AllErrors() []error { return m }
 
-// GoogleLoginResponseValidationError is the validation error returned by
-// GoogleLoginResponse.Validate if the designated constraints aren't met.
-type GoogleLoginResponseValidationError struct {
+// ListIdentitiesResponseValidationError is the validation error returned by
+// ListIdentitiesResponse.Validate if the designated constraints aren't met.
+type ListIdentitiesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1906,24 +4125,24 @@ type GoogleLoginResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GoogleLoginResponseValidationError) Field() string { return e.field }
+func (e ListIdentitiesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GoogleLoginResponseValidationError) Reason() string { return e.reason }
+func (e ListIdentitiesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GoogleLoginResponseValidationError) Cause() error { return e.cause }
+func (e ListIdentitiesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GoogleLoginResponseValidationError) Key() bool { return e.key }
+func (e ListIdentitiesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GoogleLoginResponseValidationError) ErrorName() string {
-	return "GoogleLoginResponseValidationError"
+func (e ListIdentitiesResponseValidationError) ErrorName() string {
+	return "ListIdentitiesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GoogleLoginResponseValidationError) Error() string {
+func (e ListIdentitiesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1935,14 +4154,14 @@ func (e GoogleLoginResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGoogleLoginResponse.%s: %s%s",
+		"invalid %sListIdentitiesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GoogleLoginResponseValidationError{}
+var _ error = ListIdentitiesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1950,7 +4169,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GoogleLoginResponseValidationError{}
+} = ListIdentitiesResponseValidationError{}
 
 // Validate checks the field values on GoogleCallbackRequest with the rules
 // defined in the proto definition for this message. If any rules are
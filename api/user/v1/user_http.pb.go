@@ -20,32 +20,77 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserServiceChangePassword = "/userv1.UserService/ChangePassword"
+const OperationUserServiceConfirmTOTP = "/userv1.UserService/ConfirmTOTP"
+const OperationUserServiceEnrollTOTP = "/userv1.UserService/EnrollTOTP"
 const OperationUserServiceGetUser = "/userv1.UserService/GetUser"
+const OperationUserServiceGetUserPermission = "/userv1.UserService/GetUserPermission"
 const OperationUserServiceGoogleLogin = "/userv1.UserService/GoogleLogin"
 const OperationUserServiceIsAccountExist = "/userv1.UserService/IsAccountExist"
+const OperationUserServiceLinkIdentity = "/userv1.UserService/LinkIdentity"
+const OperationUserServiceListIdentities = "/userv1.UserService/ListIdentities"
+const OperationUserServiceListSessions = "/userv1.UserService/ListSessions"
 const OperationUserServiceLogin = "/userv1.UserService/Login"
 const OperationUserServiceLogout = "/userv1.UserService/Logout"
+const OperationUserServiceOAuthLogin = "/userv1.UserService/OAuthLogin"
+const OperationUserServiceRefreshToken = "/userv1.UserService/RefreshToken"
 const OperationUserServiceRegister = "/userv1.UserService/Register"
+const OperationUserServiceRequestPasswordReset = "/userv1.UserService/RequestPasswordReset"
+const OperationUserServiceResetPassword = "/userv1.UserService/ResetPassword"
+const OperationUserServiceRevokeAllSessions = "/userv1.UserService/RevokeAllSessions"
+const OperationUserServiceRevokeSession = "/userv1.UserService/RevokeSession"
 const OperationUserServiceSendVerificationCode = "/userv1.UserService/SendVerificationCode"
+const OperationUserServiceUnlinkIdentity = "/userv1.UserService/UnlinkIdentity"
 const OperationUserServiceUpdateUser = "/userv1.UserService/UpdateUser"
+const OperationUserServiceVerifyTOTP = "/userv1.UserService/VerifyTOTP"
 
 type UserServiceHTTPServer interface {
+	// ChangePassword 修改密码（需登录）
+	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// ConfirmTOTP 使用验证器中的验证码确认绑定，启用两步验证并返回恢复码（需登录）
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	// EnrollTOTP 开始绑定两步验证，返回TOTP密钥和otpauth地址（需登录）
+	EnrollTOTP(context.Context, *emptypb.Empty) (*EnrollTOTPResponse, error)
 	// GetUser 获取用户信息
 	GetUser(context.Context, *emptypb.Empty) (*GetUserResponse, error)
-	// GoogleLogin Google登录
+	// GetUserPermission 获取用户权限，user_id为空时获取当前用户，查看其他用户需要 permission:read 权限
+	GetUserPermission(context.Context, *GetUserPermissionRequest) (*UserPermissionListResponse, error)
+	// GoogleLogin Google登录（兼容保留，等同于 OAuthLogin provider=google）
 	GoogleLogin(context.Context, *emptypb.Empty) (*GoogleLoginResponse, error)
 	// IsAccountExist 检测账号是否存在
 	IsAccountExist(context.Context, *IsAccountExistRequest) (*IsAccountExistResponse, error)
+	// LinkIdentity 获取关联第三方账号的授权地址（需登录），授权完成后回调中完成关联
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*OAuthLoginResponse, error)
+	// ListIdentities 获取已关联的第三方账号（需登录）
+	ListIdentities(context.Context, *emptypb.Empty) (*ListIdentitiesResponse, error)
+	// ListSessions 获取当前用户的登录会话列表
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error)
 	// Login 用户登录
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	// Logout 用户登出
 	Logout(context.Context, *emptypb.Empty) (*LoginResponse, error)
+	// OAuthLogin 获取第三方登录授权地址，授权完成后回调 /api/users/oauth/{provider}/callback
+	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	// RefreshToken 刷新令牌（使用刷新令牌换取新的令牌对）
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
 	// Register 用户注册
 	Register(context.Context, *RegisterRequest) (*LoginResponse, error)
+	// RequestPasswordReset 申请重置密码（发送重置邮件）
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResetPassword 使用邮件中的令牌重置密码
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
+	// RevokeAllSessions 撤销所有会话（下线所有设备）
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*emptypb.Empty, error)
+	// RevokeSession 撤销指定会话（下线某个设备）
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
 	// SendVerificationCode 发送验证码
 	SendVerificationCode(context.Context, *SendVerificationCodeRequest) (*emptypb.Empty, error)
+	// UnlinkIdentity 解除第三方账号关联（需登录），不能解除唯一的登录方式
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*emptypb.Empty, error)
 	// UpdateUser 更新用户信息
 	UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error)
+	// VerifyTOTP 登录返回 mfa_required 时，使用 mfa_token 和验证码（或恢复码）换取令牌
+	VerifyTOTP(context.Context, *VerifyTOTPRequest) (*LoginResponse, error)
 }
 
 func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
@@ -54,10 +99,25 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.POST("/api/users/register", _UserService_Register0_HTTP_Handler(srv))
 	r.GET("/api/users/account_exist", _UserService_IsAccountExist0_HTTP_Handler(srv))
 	r.GET("/api/users/get_user", _UserService_GetUser0_HTTP_Handler(srv))
+	r.GET("/api/users/permissions", _UserService_GetUserPermission0_HTTP_Handler(srv))
 	r.GET("/api/users/google-login", _UserService_GoogleLogin0_HTTP_Handler(srv))
+	r.GET("/api/users/oauth/{provider}/login", _UserService_OAuthLogin0_HTTP_Handler(srv))
+	r.POST("/api/users/identities/link", _UserService_LinkIdentity0_HTTP_Handler(srv))
+	r.POST("/api/users/identities/unlink", _UserService_UnlinkIdentity0_HTTP_Handler(srv))
+	r.GET("/api/users/identities", _UserService_ListIdentities0_HTTP_Handler(srv))
 	r.POST("/api/users/logout", _UserService_Logout0_HTTP_Handler(srv))
 	r.POST("/api/users/send_verification_code", _UserService_SendVerificationCode0_HTTP_Handler(srv))
 	r.POST("/api/users/update_user", _UserService_UpdateUser0_HTTP_Handler(srv))
+	r.POST("/api/users/refresh_token", _UserService_RefreshToken0_HTTP_Handler(srv))
+	r.GET("/api/users/sessions", _UserService_ListSessions0_HTTP_Handler(srv))
+	r.POST("/api/users/sessions/revoke", _UserService_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/api/users/sessions/revoke_all", _UserService_RevokeAllSessions0_HTTP_Handler(srv))
+	r.POST("/api/users/password/reset_request", _UserService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/users/password/reset", _UserService_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/api/users/password/change", _UserService_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/api/users/totp/enroll", _UserService_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/api/users/totp/confirm", _UserService_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/api/users/totp/verify", _UserService_VerifyTOTP0_HTTP_Handler(srv))
 }
 
 func _UserService_Login0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -142,6 +202,25 @@ func _UserService_GetUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
+func _UserService_GetUserPermission0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserPermissionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetUserPermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserPermission(ctx, req.(*GetUserPermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserPermissionListResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserService_GoogleLogin0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -161,6 +240,91 @@ func _UserService_GoogleLogin0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx
 	}
 }
 
+func _UserService_OAuthLogin0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OAuthLoginRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceOAuthLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OAuthLogin(ctx, req.(*OAuthLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuthLoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_LinkIdentity0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceLinkIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkIdentity(ctx, req.(*LinkIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuthLoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UnlinkIdentity0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlinkIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUnlinkIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ListIdentities0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListIdentities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIdentities(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListIdentitiesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserService_Logout0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -227,15 +391,247 @@ func _UserService_UpdateUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx h
 	}
 }
 
+func _UserService_RefreshToken0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ListSessions0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RevokeSession0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RevokeAllSessions0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAllSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRevokeAllSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RequestPasswordReset0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ResetPassword0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ChangePassword0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_EnrollTOTP0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ConfirmTOTP0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_VerifyTOTP0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceVerifyTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyTOTP(ctx, req.(*VerifyTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest, opts ...http.CallOption) (rsp *ConfirmTOTPResponse, err error)
+	EnrollTOTP(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *EnrollTOTPResponse, err error)
 	GetUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GetUserResponse, err error)
+	GetUserPermission(ctx context.Context, req *GetUserPermissionRequest, opts ...http.CallOption) (rsp *UserPermissionListResponse, err error)
 	GoogleLogin(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GoogleLoginResponse, err error)
 	IsAccountExist(ctx context.Context, req *IsAccountExistRequest, opts ...http.CallOption) (rsp *IsAccountExistResponse, err error)
+	LinkIdentity(ctx context.Context, req *LinkIdentityRequest, opts ...http.CallOption) (rsp *OAuthLoginResponse, err error)
+	ListIdentities(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListIdentitiesResponse, err error)
+	ListSessions(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListSessionsResponse, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	Logout(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	OAuthLogin(ctx context.Context, req *OAuthLoginRequest, opts ...http.CallOption) (rsp *OAuthLoginResponse, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RevokeAllSessions(ctx context.Context, req *RevokeAllSessionsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	SendVerificationCode(ctx context.Context, req *SendVerificationCodeRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UnlinkIdentity(ctx context.Context, req *UnlinkIdentityRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	VerifyTOTP(ctx context.Context, req *VerifyTOTPRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 }
 
 type UserServiceHTTPClientImpl struct {
@@ -246,6 +642,45 @@ func NewUserServiceHTTPClient(client *http.Client) UserServiceHTTPClient {
 	return &UserServiceHTTPClientImpl{client}
 }
 
+func (c *UserServiceHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/password/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...http.CallOption) (*ConfirmTOTPResponse, error) {
+	var out ConfirmTOTPResponse
+	pattern := "/api/users/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) EnrollTOTP(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*EnrollTOTPResponse, error) {
+	var out EnrollTOTPResponse
+	pattern := "/api/users/totp/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GetUserResponse, error) {
 	var out GetUserResponse
 	pattern := "/api/users/get_user"
@@ -259,6 +694,19 @@ func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *emptypb.Emp
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) GetUserPermission(ctx context.Context, in *GetUserPermissionRequest, opts ...http.CallOption) (*UserPermissionListResponse, error) {
+	var out UserPermissionListResponse
+	pattern := "/api/users/permissions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetUserPermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) GoogleLogin(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GoogleLoginResponse, error) {
 	var out GoogleLoginResponse
 	pattern := "/api/users/google-login"
@@ -285,6 +733,45 @@ func (c *UserServiceHTTPClientImpl) IsAccountExist(ctx context.Context, in *IsAc
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...http.CallOption) (*OAuthLoginResponse, error) {
+	var out OAuthLoginResponse
+	pattern := "/api/users/identities/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceLinkIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ListIdentities(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListIdentitiesResponse, error) {
+	var out ListIdentitiesResponse
+	pattern := "/api/users/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListIdentities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListSessionsResponse, error) {
+	var out ListSessionsResponse
+	pattern := "/api/users/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/api/users/login"
@@ -311,6 +798,32 @@ func (c *UserServiceHTTPClientImpl) Logout(ctx context.Context, in *emptypb.Empt
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...http.CallOption) (*OAuthLoginResponse, error) {
+	var out OAuthLoginResponse
+	pattern := "/api/users/oauth/{provider}/login"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceOAuthLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/api/users/refresh_token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/api/users/register"
@@ -324,6 +837,58 @@ func (c *UserServiceHTTPClientImpl) Register(ctx context.Context, in *RegisterRe
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/password/reset_request"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/sessions/revoke_all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRevokeAllSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/sessions/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/api/users/send_verification_code"
@@ -337,6 +902,19 @@ func (c *UserServiceHTTPClientImpl) SendVerificationCode(ctx context.Context, in
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/users/identities/unlink"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUnlinkIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
 	pattern := "/api/users/update_user"
@@ -349,3 +927,16 @@ func (c *UserServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUs
 	}
 	return &out, nil
 }
+
+func (c *UserServiceHTTPClientImpl) VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/api/users/totp/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceVerifyTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
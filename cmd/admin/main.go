@@ -35,6 +35,12 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	shutdownTracing, err := common.SetupTracing(ctx, &config)
+	if err != nil {
+		panic(err)
+	}
+	defer shutdownTracing()
+
 	application, cleanup, err := wireApp(ctx, &config, logger.DefaultLogger)
 	if err != nil {
 		panic(err)
@@ -40,18 +40,57 @@ func wireApp(ctx context.Context, c *conf.Bootstrap, logger log.Logger) (*kratos
 		return nil, nil, err
 	}
 	transaction := data.NewTransaction(dataData)
-	googleCloudStorage, cleanup := common.NewGoogleCloudStorage(c)
+	storage, cleanup, err := common.NewStorage(c)
+	if err != nil {
+		return nil, nil, err
+	}
 	userRepo := data.NewUserRepo(dataData, logger)
 	cache := data.NewRedisCache(client)
 	bizUserRepo := data.NewUserRepoCacheDecorator(userRepo, cache)
-	commonUseCase := biz.NewCommonUseCase(transaction, googleCloudStorage, bizUserRepo)
-	adminUseCase := biz.NewAdminUseCase(commonUseCase, bizUserRepo, transaction)
-	adminService := service.NewAdminService(adminUseCase)
-	server := admin.NewHttpServer(c, redisLimiter, adminService)
-	jobServer := admin.NewJobServer(c, adminUseCase)
+	fileRepo := data.NewFileRepo(dataData)
+	queueClient, cleanup2 := common.NewQueueClient(c)
+	manager := common.NewJWTManager(c)
+	redisStore := common.NewSessionStore(client)
+	wsService := common.NewWsService(ctx, logger, manager, redisStore)
+	mediaUseCase := biz.NewMediaUseCase(storage, fileRepo, queueClient, wsService)
+	uploadSessionRepo := data.NewUploadSessionRepo(dataData)
+	scanner := common.NewFileScanner(c)
+	fileInspector := biz.NewFileInspector(c, storage, scanner)
+	fileUseCase := biz.NewFileUseCase(c, transaction, storage, fileRepo, uploadSessionRepo, mediaUseCase, fileInspector)
+	commonUseCase := biz.NewCommonUseCase(transaction, storage, bizUserRepo, mediaUseCase, fileUseCase, fileInspector)
+	redisLocker := common.NewRedisLocker(client)
+	registry, err := common.NewOAuthRegistry(c)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	email := common.NewEmail(c)
+	hasher := common.NewPasswordHasher(c)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData)
+	loginGuard := biz.NewLoginGuard(client, redisLimiter, cache)
+	totpRepo := data.NewTOTPRepo(dataData)
+	oAuthStateRepo := data.NewOAuthStateRepo(dataData)
+	identityRepo := data.NewIdentityRepo(dataData)
+	reader := common.NewGeoipDB(ctx, c)
+	userUseCase := biz.NewUserUseCase(bizUserRepo, logger, transaction, commonUseCase, redisLocker, registry, cache, email, manager, redisStore, hasher, passwordResetRepo, loginGuard, totpRepo, oAuthStateRepo, identityRepo, reader, c)
+	rbacRepo := data.NewRBACRepo(dataData)
+	rbacUseCase := biz.NewRBACUseCase(rbacRepo, bizUserRepo, cache, logger)
+	adminUseCase := biz.NewAdminUseCase(commonUseCase, bizUserRepo, transaction, userUseCase, rbacUseCase, redisStore)
+	auditRepo := data.NewAuditRepo(dataData)
+	auditUseCase, cleanup3 := biz.NewAuditUseCase(auditRepo, rbacUseCase, logger)
+	cronJobRepo := data.NewCronJobRepo(dataData)
+	cronUseCase := biz.NewCronUseCase(cronJobRepo, queueClient)
+	adminService := service.NewAdminService(adminUseCase, auditUseCase, cronUseCase)
+	jobUseCase := biz.NewJobUseCase(queueClient)
+	jobService := service.NewJobService(jobUseCase)
+	server := admin.NewHttpServer(c, redisLimiter, adminService, jobService, manager, redisStore, rbacUseCase, auditUseCase)
+	jobServer := admin.NewJobServer(c, adminUseCase, redisLocker)
 	v := admin.NewServer(server, jobServer)
 	app := newApp(ctx, c, v...)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
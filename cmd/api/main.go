@@ -7,6 +7,7 @@ import (
 
 	"github.com/ydssx/kratos-kit/common"
 	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/pkg/health"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 
 	"github.com/go-kratos/kratos/v2"
@@ -16,6 +17,9 @@ import (
 
 var flagconf string
 
+// defaultShutdownDelay 未配置时停止前等待负载均衡摘除实例的时间
+const defaultShutdownDelay = 5 * time.Second
+
 func init() {
 	flag.StringVar(&flagconf, "f", "./configs/config.local.yaml", "config path, eg: -conf config.yaml")
 }
@@ -38,6 +42,12 @@ func main() {
 		time.Sleep(time.Millisecond * 10)
 	}()
 
+	shutdownTracing, err := common.SetupTracing(ctx, &config)
+	if err != nil {
+		panic(err)
+	}
+	defer shutdownTracing()
+
 	application, cleanup, err := wireApp(ctx, &config, logger.DefaultLogger)
 	if err != nil {
 		panic(err)
@@ -53,7 +63,7 @@ func main() {
 // newApp 创建一个新的 Kratos 应用程序实例。它接收配置和服务器作为参数,
 // 并根据配置来注册服务发现、追踪和指标中间件。
 // 返回构建好的 Kratos 应用程序实例。
-func newApp(ctx context.Context, c *conf.Bootstrap, srv ...transport.Server) *kratos.App {
+func newApp(ctx context.Context, c *conf.Bootstrap, hs *health.HealthService, srv ...transport.Server) *kratos.App {
 	options := []kratos.Option{
 		kratos.Name(c.Name),
 		kratos.Context(ctx),
@@ -63,6 +73,23 @@ func newApp(ctx context.Context, c *conf.Bootstrap, srv ...transport.Server) *kr
 			logger.Infof(ctx, "service %s is starting...", c.Name)
 			return nil
 		}),
+		// 停止前先让就绪检查失败,等待负载均衡摘除本实例后再关闭服务器
+		kratos.BeforeStop(func(ctx context.Context) error {
+			hs.Shutdown()
+			delay := defaultShutdownDelay
+			if d := c.Server.GetShutdownDelay(); d != nil {
+				delay = d.AsDuration()
+			}
+			if delay <= 0 {
+				return nil
+			}
+			logger.Infof(ctx, "service %s is draining for %s before stopping", c.Name, delay)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+			}
+			return nil
+		}),
 	}
 
 	return kratos.New(options...)
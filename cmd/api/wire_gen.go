@@ -26,21 +26,27 @@ import (
 
 // wireApp init kratos application.
 func wireApp(ctx context.Context, c *conf.Bootstrap, logger log.Logger) (*kratos.App, func(), error) {
-	wsService := common.NewWsService(ctx, logger)
-	reader := common.NewGeoipDB(ctx, c)
+	db, err := common.NewMysqlDB(c)
+	if err != nil {
+		return nil, nil, err
+	}
 	client, err := common.NewRedisCLient(c)
 	if err != nil {
 		return nil, nil, err
 	}
-	redisLimiter := common.NewRateLimiter(client)
-	googleCloudStorage, cleanup := common.NewGoogleCloudStorage(c)
-	db, err := common.NewMysqlDB(c)
+	reader := common.NewGeoipDB(ctx, c)
+	storage, cleanup, err := common.NewStorage(c)
 	if err != nil {
-		cleanup()
 		return nil, nil, err
 	}
+	healthService, cleanup2 := common.NewHealthService(c, db, client, reader, storage)
+	manager := common.NewJWTManager(c)
+	redisStore := common.NewSessionStore(client)
+	wsService := common.NewWsService(ctx, logger, manager, redisStore)
+	redisLimiter := common.NewRateLimiter(client)
 	dataData, err := data.NewData(ctx, logger, client, db)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
@@ -48,22 +54,48 @@ func wireApp(ctx context.Context, c *conf.Bootstrap, logger log.Logger) (*kratos
 	userRepo := data.NewUserRepo(dataData, logger)
 	cache := data.NewRedisCache(client)
 	bizUserRepo := data.NewUserRepoCacheDecorator(userRepo, cache)
-	commonUseCase := biz.NewCommonUseCase(transaction, googleCloudStorage, bizUserRepo)
-	uploadUseCase := biz.NewUploadUseCase(googleCloudStorage, c, commonUseCase)
+	fileRepo := data.NewFileRepo(dataData)
+	queueClient, cleanup3 := common.NewQueueClient(c)
+	mediaUseCase := biz.NewMediaUseCase(storage, fileRepo, queueClient, wsService)
+	uploadSessionRepo := data.NewUploadSessionRepo(dataData)
+	scanner := common.NewFileScanner(c)
+	fileInspector := biz.NewFileInspector(c, storage, scanner)
+	fileUseCase := biz.NewFileUseCase(c, transaction, storage, fileRepo, uploadSessionRepo, mediaUseCase, fileInspector)
+	commonUseCase := biz.NewCommonUseCase(transaction, storage, bizUserRepo, mediaUseCase, fileUseCase, fileInspector)
+	uploadUseCase := biz.NewUploadUseCase(storage, c, commonUseCase)
 	commonService := service.NewCommonService(uploadUseCase, commonUseCase)
 	redisLocker := common.NewRedisLocker(client)
-	config := common.InitGoogleOAuth(c)
+	registry, err := common.NewOAuthRegistry(c)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	email := common.NewEmail(c)
-	userUseCase := biz.NewUserUseCase(bizUserRepo, logger, transaction, commonUseCase, redisLocker, config, cache, email)
-	userService := service.NewUserService(userUseCase)
-	engine := server.NewGinMux(c, reader, commonService, userService)
-	httpServer := server.NewHTTPServer(ctx, c, wsService, reader, redisLimiter, engine, userService)
-	usecaseSet := biz.NewUsecaseSet(userUseCase, uploadUseCase)
-	jobServer := server.NewJobServer(c, usecaseSet)
-	grpcServer := server.NewGRPCServer(c, reader)
+	hasher := common.NewPasswordHasher(c)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData)
+	loginGuard := biz.NewLoginGuard(client, redisLimiter, cache)
+	totpRepo := data.NewTOTPRepo(dataData)
+	oAuthStateRepo := data.NewOAuthStateRepo(dataData)
+	identityRepo := data.NewIdentityRepo(dataData)
+	userUseCase := biz.NewUserUseCase(bizUserRepo, logger, transaction, commonUseCase, redisLocker, registry, cache, email, manager, redisStore, hasher, passwordResetRepo, loginGuard, totpRepo, oAuthStateRepo, identityRepo, reader, c)
+	rbacRepo := data.NewRBACRepo(dataData)
+	rbacUseCase := biz.NewRBACUseCase(rbacRepo, bizUserRepo, cache, logger)
+	userService := service.NewUserService(userUseCase, rbacUseCase)
+	engine := server.NewGinMux(c, reader, commonService, userService, manager, redisStore)
+	fileService := service.NewFileService(fileUseCase)
+	httpServer := server.NewHTTPServer(ctx, c, wsService, reader, redisLimiter, engine, userService, manager, redisStore, rbacUseCase, healthService, storage, fileService)
+	cronJobRepo := data.NewCronJobRepo(dataData)
+	cronUseCase := biz.NewCronUseCase(cronJobRepo, queueClient)
+	usecaseSet := biz.NewUsecaseSet(userUseCase, uploadUseCase, fileUseCase, mediaUseCase, cronUseCase)
+	jobServer := server.NewJobServer(c, usecaseSet, redisLocker)
+	grpcServer := server.NewGRPCServer(c, reader, manager, redisStore, rbacUseCase)
 	v := server.NewServer(httpServer, jobServer, grpcServer)
-	app := newApp(ctx, c, v...)
+	app := newApp(ctx, c, healthService, v...)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
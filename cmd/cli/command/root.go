@@ -71,7 +71,10 @@ func NewGcsUploadCmd() *cobra.Command {
 			localPath := args[0]
 			gcsPath := args[1]
 
-			gcs, cleanup := common.NewGoogleCloudStorage(GetConfig(ctx))
+			gcs, cleanup, err := common.NewGoogleCloudStorage(GetConfig(ctx))
+			if err != nil {
+				return err
+			}
 			defer cleanup()
 
 			file, err := os.ReadFile(localPath)
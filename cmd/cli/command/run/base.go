@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport"
 	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/spf13/cobra"
@@ -43,7 +44,7 @@ func NewClientSet(ctx context.Context) *ClientSet {
 	conn, err := kgrpc.DialInsecure(ctx,
 		kgrpc.WithEndpoint(endpoint),
 		kgrpc.WithTimeout(time.Second*time.Duration(GRPC_TIMEOUT)),
-		kgrpc.WithMiddleware(authClient(token)),
+		kgrpc.WithMiddleware(tracing.Client(), authClient(token)),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to dial server: %v", err))
@@ -39,6 +39,10 @@ type Bootstrap struct {
 	ProjectId     string                 `protobuf:"bytes,12,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	Google        *Google                `protobuf:"bytes,13,opt,name=google,proto3" json:"google,omitempty"`
 	Email         *Email                 `protobuf:"bytes,14,opt,name=email,proto3" json:"email,omitempty"`
+	Password      *Password              `protobuf:"bytes,15,opt,name=password,proto3" json:"password,omitempty"`
+	Oauth         *OAuth                 `protobuf:"bytes,16,opt,name=oauth,proto3" json:"oauth,omitempty"`
+	Tracing       *Tracing               `protobuf:"bytes,17,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Storage       *Storage               `protobuf:"bytes,18,opt,name=storage,proto3" json:"storage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -171,11 +175,40 @@ func (x *Bootstrap) GetEmail() *Email {
 	return nil
 }
 
+func (x *Bootstrap) GetPassword() *Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetOauth() *OAuth {
+	if x != nil {
+		return x.Oauth
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetStorage() *Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
 	EnablePprof   bool                   `protobuf:"varint,3,opt,name=enable_pprof,json=enablePprof,proto3" json:"enable_pprof,omitempty"`
+	ShutdownDelay *durationpb.Duration   `protobuf:"bytes,4,opt,name=shutdown_delay,json=shutdownDelay,proto3" json:"shutdown_delay,omitempty"` // 停止前等待负载均衡摘除实例的时间,未设置时为 5s,0s 表示不等待
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -231,6 +264,13 @@ func (x *Server) GetEnablePprof() bool {
 	return false
 }
 
+func (x *Server) GetShutdownDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ShutdownDelay
+	}
+	return nil
+}
+
 type Auth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 基本认证用户名
@@ -765,8 +805,21 @@ type Asynq struct {
 	Concurrency    int32                  `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 	MaxRetry       int32                  `protobuf:"varint,2,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
 	StrictPriority bool                   `protobuf:"varint,3,opt,name=strict_priority,json=strictPriority,proto3" json:"strict_priority,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 定时任务调度主节点锁的有效期,默认 10s
+	LeaderTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=leader_ttl,json=leaderTtl,proto3" json:"leader_ttl,omitempty"`
+	// 重试间隔按 retry_base_delay*2^n 指数增长,不超过 retry_max_delay,默认 30s 和 1h
+	RetryBaseDelay *durationpb.Duration `protobuf:"bytes,5,opt,name=retry_base_delay,json=retryBaseDelay,proto3" json:"retry_base_delay,omitempty"`
+	RetryMaxDelay  *durationpb.Duration `protobuf:"bytes,6,opt,name=retry_max_delay,json=retryMaxDelay,proto3" json:"retry_max_delay,omitempty"`
+	// 重试间隔随机减少的最大比例,取值 [0, 1],未设置时为 0.2,为 0 时不随机
+	RetryJitter *float64 `protobuf:"fixed64,7,opt,name=retry_jitter,json=retryJitter,proto3,oneof" json:"retry_jitter,omitempty"`
+	// 队列失败率告警阈值,取值 [0, 1],为 0 时不告警;任务归档时总是告警
+	AlertFailureRate float64 `protobuf:"fixed64,8,opt,name=alert_failure_rate,json=alertFailureRate,proto3" json:"alert_failure_rate,omitempty"`
+	// 统计周期内执行次数不少于该值时才检查失败率
+	AlertMinProcessed int32 `protobuf:"varint,9,opt,name=alert_min_processed,json=alertMinProcessed,proto3" json:"alert_min_processed,omitempty"`
+	// 失败率的统计周期,默认 5m
+	AlertWindow   *durationpb.Duration `protobuf:"bytes,10,opt,name=alert_window,json=alertWindow,proto3" json:"alert_window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Asynq) Reset() {
@@ -820,6 +873,55 @@ func (x *Asynq) GetStrictPriority() bool {
 	return false
 }
 
+func (x *Asynq) GetLeaderTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LeaderTtl
+	}
+	return nil
+}
+
+func (x *Asynq) GetRetryBaseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBaseDelay
+	}
+	return nil
+}
+
+func (x *Asynq) GetRetryMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RetryMaxDelay
+	}
+	return nil
+}
+
+func (x *Asynq) GetRetryJitter() float64 {
+	if x != nil && x.RetryJitter != nil {
+		return *x.RetryJitter
+	}
+	return 0
+}
+
+func (x *Asynq) GetAlertFailureRate() float64 {
+	if x != nil {
+		return x.AlertFailureRate
+	}
+	return 0
+}
+
+func (x *Asynq) GetAlertMinProcessed() int32 {
+	if x != nil {
+		return x.AlertMinProcessed
+	}
+	return 0
+}
+
+func (x *Asynq) GetAlertWindow() *durationpb.Duration {
+	if x != nil {
+		return x.AlertWindow
+	}
+	return nil
+}
+
 type GoogleCloudStorage struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -1208,20 +1310,74 @@ func (x *Google) GetRedirectUrl() string {
 	return ""
 }
 
-type Email struct {
+// 第三方登录配置
+type OAuth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	Providers     []*OAuth_Provider      `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	StateTtl      *durationpb.Duration   `protobuf:"bytes,2,opt,name=state_ttl,json=stateTtl,proto3" json:"state_ttl,omitempty"` // state有效期，默认10分钟
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *OAuth) Reset() {
+	*x = OAuth{}
+	mi := &file_common_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth) ProtoMessage() {}
+
+func (x *OAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth.ProtoReflect.Descriptor instead.
+func (*OAuth) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OAuth) GetProviders() []*OAuth_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *OAuth) GetStateTtl() *durationpb.Duration {
+	if x != nil {
+		return x.StateTtl
+	}
+	return nil
+}
+
+type Email struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Host             string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port             int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username         string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password         string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	From             string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	ResetPasswordUrl string                 `protobuf:"bytes,6,opt,name=reset_password_url,json=resetPasswordUrl,proto3" json:"reset_password_url,omitempty"` // 重置密码页面地址，邮件中的链接为 {reset_password_url}?token=xxx
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *Email) Reset() {
 	*x = Email{}
-	mi := &file_common_conf_conf_proto_msgTypes[16]
+	mi := &file_common_conf_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1233,7 +1389,7 @@ func (x *Email) String() string {
 func (*Email) ProtoMessage() {}
 
 func (x *Email) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_conf_proto_msgTypes[16]
+	mi := &file_common_conf_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1402,7 @@ func (x *Email) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Email.ProtoReflect.Descriptor instead.
 func (*Email) Descriptor() ([]byte, []int) {
-	return file_common_conf_conf_proto_rawDescGZIP(), []int{16}
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Email) GetHost() string {
@@ -1284,38 +1440,39 @@ func (x *Email) GetFrom() string {
 	return ""
 }
 
-type Server_HTTP struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// 安全配置
-	JwtSecret     string               `protobuf:"bytes,4,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`          // JWT密钥
-	JwtExpiry     *durationpb.Duration `protobuf:"bytes,5,opt,name=jwt_expiry,json=jwtExpiry,proto3" json:"jwt_expiry,omitempty"`          // JWT过期时间
-	RateLimit     float32              `protobuf:"fixed32,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`        // 请求速率限制
-	RateBurst     int32                `protobuf:"varint,7,opt,name=rate_burst,json=rateBurst,proto3" json:"rate_burst,omitempty"`         // 速率限制突发值
-	AllowOrigins  []string             `protobuf:"bytes,8,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"` // CORS允许的源
-	Auth          *Auth                `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`                                     // 认证配置
-	Security      *Security            `protobuf:"bytes,10,opt,name=security,proto3" json:"security,omitempty"`                            // 安全配置
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Email) GetResetPasswordUrl() string {
+	if x != nil {
+		return x.ResetPasswordUrl
+	}
+	return ""
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
-	mi := &file_common_conf_conf_proto_msgTypes[17]
+type Password struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm         string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                                           // 密码哈希算法: argon2id(默认) | bcrypt
+	Argon2Memory      uint32                 `protobuf:"varint,2,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"`                // argon2id 内存开销(KiB)
+	Argon2Iterations  uint32                 `protobuf:"varint,3,opt,name=argon2_iterations,json=argon2Iterations,proto3" json:"argon2_iterations,omitempty"`    // argon2id 迭代次数
+	Argon2Parallelism uint32                 `protobuf:"varint,4,opt,name=argon2_parallelism,json=argon2Parallelism,proto3" json:"argon2_parallelism,omitempty"` // argon2id 并行度
+	BcryptCost        int32                  `protobuf:"varint,5,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`                      // bcrypt cost
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Password) Reset() {
+	*x = Password{}
+	mi := &file_common_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Password) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Password) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_conf_proto_msgTypes[17]
+func (x *Password) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,105 +1483,75 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_common_conf_conf_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *Server_HTTP) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *Server_HTTP) GetAddr() string {
-	if x != nil {
-		return x.Addr
-	}
-	return ""
-}
-
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.Timeout
-	}
-	return nil
+// Deprecated: Use Password.ProtoReflect.Descriptor instead.
+func (*Password) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Server_HTTP) GetJwtSecret() string {
+func (x *Password) GetAlgorithm() string {
 	if x != nil {
-		return x.JwtSecret
+		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetJwtExpiry() *durationpb.Duration {
-	if x != nil {
-		return x.JwtExpiry
-	}
-	return nil
-}
-
-func (x *Server_HTTP) GetRateLimit() float32 {
+func (x *Password) GetArgon2Memory() uint32 {
 	if x != nil {
-		return x.RateLimit
+		return x.Argon2Memory
 	}
 	return 0
 }
 
-func (x *Server_HTTP) GetRateBurst() int32 {
+func (x *Password) GetArgon2Iterations() uint32 {
 	if x != nil {
-		return x.RateBurst
+		return x.Argon2Iterations
 	}
 	return 0
 }
 
-func (x *Server_HTTP) GetAllowOrigins() []string {
-	if x != nil {
-		return x.AllowOrigins
-	}
-	return nil
-}
-
-func (x *Server_HTTP) GetAuth() *Auth {
+func (x *Password) GetArgon2Parallelism() uint32 {
 	if x != nil {
-		return x.Auth
+		return x.Argon2Parallelism
 	}
-	return nil
+	return 0
 }
 
-func (x *Server_HTTP) GetSecurity() *Security {
+func (x *Password) GetBcryptCost() int32 {
 	if x != nil {
-		return x.Security
+		return x.BcryptCost
 	}
-	return nil
+	return 0
 }
 
-type Server_GRPC struct {
+// 链路追踪配置
+type Tracing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Enable        bool                   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`                                                                            // 是否启用，未启用时仍会透传上游的 traceparent
+	Exporter      string                 `protobuf:"bytes,2,opt,name=exporter,proto3" json:"exporter,omitempty"`                                                                         // otlp(默认) | stdout | file
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                                                         // OTLP gRPC 地址，如 localhost:4317
+	Insecure      bool                   `protobuf:"varint,4,opt,name=insecure,proto3" json:"insecure,omitempty"`                                                                        // OTLP 不使用TLS
+	Headers       map[string]string      `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // OTLP 请求头，如鉴权信息
+	SampleRatio   float64                `protobuf:"fixed64,6,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`                                              // 采样率，0 时使用 1
+	FilePath      string                 `protobuf:"bytes,7,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`                                                         // file 导出器的文件路径
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
-	mi := &file_common_conf_conf_proto_msgTypes[18]
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	mi := &file_common_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_GRPC) String() string {
+func (x *Tracing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_GRPC) ProtoMessage() {}
+func (*Tracing) ProtoMessage() {}
 
-func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_conf_proto_msgTypes[18]
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,63 +1562,87 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
-func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_common_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Server_GRPC) GetNetwork() string {
+func (x *Tracing) GetEnable() bool {
 	if x != nil {
-		return x.Network
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Tracing) GetExporter() string {
+	if x != nil {
+		return x.Exporter
 	}
 	return ""
 }
 
-func (x *Server_GRPC) GetAddr() string {
+func (x *Tracing) GetEndpoint() string {
 	if x != nil {
-		return x.Addr
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+func (x *Tracing) GetInsecure() bool {
 	if x != nil {
-		return x.Timeout
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Tracing) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
 	}
 	return nil
 }
 
-type Data_Database struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source []string               `protobuf:"bytes,2,rep,name=source,proto3" json:"source,omitempty"`
-	// 数据库安全配置
-	MaxOpenConns    int32                `protobuf:"varint,3,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`         // 最大打开连接数
-	MaxIdleConns    int32                `protobuf:"varint,4,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`         // 最大空闲连接数
-	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"` // 连接最大生命周期
-	EnableSsl       bool                 `protobuf:"varint,6,opt,name=enable_ssl,json=enableSsl,proto3" json:"enable_ssl,omitempty"`                    // 启用SSL
-	SslCa           string               `protobuf:"bytes,7,opt,name=ssl_ca,json=sslCa,proto3" json:"ssl_ca,omitempty"`                                 // SSL CA证书路径
-	SslCert         string               `protobuf:"bytes,8,opt,name=ssl_cert,json=sslCert,proto3" json:"ssl_cert,omitempty"`                           // SSL 证书路径
-	SslKey          string               `protobuf:"bytes,9,opt,name=ssl_key,json=sslKey,proto3" json:"ssl_key,omitempty"`                              // SSL 密钥路径
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *Tracing) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
-	mi := &file_common_conf_conf_proto_msgTypes[19]
+func (x *Tracing) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// 文件存储配置
+type Storage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // gcs(默认) | local | s3
+	Local         *Storage_Local         `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	S3            *Storage_S3            `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	Upload        *Storage_Upload        `protobuf:"bytes,4,opt,name=upload,proto3" json:"upload,omitempty"`
+	Scanner       *Storage_Scanner       `protobuf:"bytes,5,opt,name=scanner,proto3" json:"scanner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storage) Reset() {
+	*x = Storage{}
+	mi := &file_common_conf_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *Storage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*Storage) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_common_conf_conf_proto_msgTypes[19]
+func (x *Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,70 +1653,776 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_common_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use Storage.ProtoReflect.Descriptor instead.
+func (*Storage) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Data_Database) GetDriver() string {
+func (x *Storage) GetDriver() string {
 	if x != nil {
 		return x.Driver
 	}
 	return ""
 }
 
-func (x *Data_Database) GetSource() []string {
+func (x *Storage) GetLocal() *Storage_Local {
 	if x != nil {
-		return x.Source
+		return x.Local
 	}
 	return nil
 }
 
-func (x *Data_Database) GetMaxOpenConns() int32 {
+func (x *Storage) GetS3() *Storage_S3 {
 	if x != nil {
-		return x.MaxOpenConns
+		return x.S3
 	}
-	return 0
+	return nil
+}
+
+func (x *Storage) GetUpload() *Storage_Upload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *Storage) GetScanner() *Storage_Scanner {
+	if x != nil {
+		return x.Scanner
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 安全配置
+	JwtSecret        string               `protobuf:"bytes,4,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`                         // JWT密钥
+	JwtExpiry        *durationpb.Duration `protobuf:"bytes,5,opt,name=jwt_expiry,json=jwtExpiry,proto3" json:"jwt_expiry,omitempty"`                         // JWT过期时间
+	RateLimit        float32              `protobuf:"fixed32,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`                       // 请求速率限制
+	RateBurst        int32                `protobuf:"varint,7,opt,name=rate_burst,json=rateBurst,proto3" json:"rate_burst,omitempty"`                        // 速率限制突发值
+	AllowOrigins     []string             `protobuf:"bytes,8,rep,name=allow_origins,json=allowOrigins,proto3" json:"allow_origins,omitempty"`                // CORS允许的源
+	Auth             *Auth                `protobuf:"bytes,9,opt,name=auth,proto3" json:"auth,omitempty"`                                                    // 认证配置
+	Security         *Security            `protobuf:"bytes,10,opt,name=security,proto3" json:"security,omitempty"`                                           // 安全配置
+	JwtRefreshExpiry *durationpb.Duration `protobuf:"bytes,11,opt,name=jwt_refresh_expiry,json=jwtRefreshExpiry,proto3" json:"jwt_refresh_expiry,omitempty"` // 刷新令牌过期时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_common_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetJwtExpiry() *durationpb.Duration {
+	if x != nil {
+		return x.JwtExpiry
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetRateLimit() float32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *Server_HTTP) GetRateBurst() int32 {
+	if x != nil {
+		return x.RateBurst
+	}
+	return 0
+}
+
+func (x *Server_HTTP) GetAllowOrigins() []string {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetSecurity() *Security {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetJwtRefreshExpiry() *durationpb.Duration {
+	if x != nil {
+		return x.JwtRefreshExpiry
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_common_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Data_Database struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source []string               `protobuf:"bytes,2,rep,name=source,proto3" json:"source,omitempty"`
+	// 数据库安全配置
+	MaxOpenConns    int32                `protobuf:"varint,3,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`         // 最大打开连接数
+	MaxIdleConns    int32                `protobuf:"varint,4,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`         // 最大空闲连接数
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"` // 连接最大生命周期
+	EnableSsl       bool                 `protobuf:"varint,6,opt,name=enable_ssl,json=enableSsl,proto3" json:"enable_ssl,omitempty"`                    // 启用SSL
+	SslCa           string               `protobuf:"bytes,7,opt,name=ssl_ca,json=sslCa,proto3" json:"ssl_ca,omitempty"`                                 // SSL CA证书路径
+	SslCert         string               `protobuf:"bytes,8,opt,name=ssl_cert,json=sslCert,proto3" json:"ssl_cert,omitempty"`                           // SSL 证书路径
+	SslKey          string               `protobuf:"bytes,9,opt,name=ssl_key,json=sslKey,proto3" json:"ssl_key,omitempty"`                              // SSL 密钥路径
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_common_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() []string {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
 }
 
 func (x *Data_Database) GetMaxIdleConns() int32 {
 	if x != nil {
-		return x.MaxIdleConns
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetEnableSsl() bool {
+	if x != nil {
+		return x.EnableSsl
+	}
+	return false
+}
+
+func (x *Data_Database) GetSslCa() string {
+	if x != nil {
+		return x.SslCa
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSslCert() string {
+	if x != nil {
+		return x.SslCert
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSslKey() string {
+	if x != nil {
+		return x.SslKey
+	}
+	return ""
+}
+
+type OAuth_Provider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 提供方标识，对应路由 /api/users/oauth/{name}/...
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // google/github/apple/oidc，为空时与name相同
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl   string                 `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	Issuer        string                 `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"` // OIDC issuer（oidc类型必填）；github类型时为GitHub Enterprise地址
+	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	TeamId        string                 `protobuf:"bytes,8,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`              // Apple Team ID
+	KeyId         string                 `protobuf:"bytes,9,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                 // Apple Key ID
+	PrivateKey    string                 `protobuf:"bytes,10,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"` // Apple .p8 私钥内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth_Provider) Reset() {
+	*x = OAuth_Provider{}
+	mi := &file_common_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth_Provider) ProtoMessage() {}
+
+func (x *OAuth_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth_Provider.ProtoReflect.Descriptor instead.
+func (*OAuth_Provider) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *OAuth_Provider) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+func (x *OAuth_Provider) GetType() string {
 	if x != nil {
-		return x.ConnMaxLifetime
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *Data_Database) GetEnableSsl() bool {
+func (x *OAuth_Provider) GetTeamId() string {
 	if x != nil {
-		return x.EnableSsl
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *OAuth_Provider) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+// 本地磁盘存储，文件通过HTTP服务的签名路由访问
+type Storage_Local struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`                                  // 文件根目录
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`             // 访问文件的外部地址，如 http://localhost:8000
+	RoutePrefix   string                 `protobuf:"bytes,3,opt,name=route_prefix,json=routePrefix,proto3" json:"route_prefix,omitempty"` // 文件路由前缀，默认 /files
+	SignKey       string                 `protobuf:"bytes,4,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"`             // URL签名密钥
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storage_Local) Reset() {
+	*x = Storage_Local{}
+	mi := &file_common_conf_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_Local) ProtoMessage() {}
+
+func (x *Storage_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_Local.ProtoReflect.Descriptor instead.
+func (*Storage_Local) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *Storage_Local) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Storage_Local) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Storage_Local) GetRoutePrefix() string {
+	if x != nil {
+		return x.RoutePrefix
+	}
+	return ""
+}
+
+func (x *Storage_Local) GetSignKey() string {
+	if x != nil {
+		return x.SignKey
+	}
+	return ""
+}
+
+// S3兼容存储，如 AWS S3、MinIO
+type Storage_S3 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // 服务地址，如 s3.amazonaws.com、localhost:9000
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket        string                 `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AccessKey     string                 `protobuf:"bytes,4,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey     string                 `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	UseSsl        bool                   `protobuf:"varint,6,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	PathStyle     bool                   `protobuf:"varint,7,opt,name=path_style,json=pathStyle,proto3" json:"path_style,omitempty"` // 使用路径风格访问存储桶，MinIO 需要开启
+	PublicUrl     string                 `protobuf:"bytes,8,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`  // 对外访问地址，为空时使用 endpoint/bucket
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Storage_S3) Reset() {
+	*x = Storage_S3{}
+	mi := &file_common_conf_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_S3) ProtoMessage() {}
+
+func (x *Storage_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_S3.ProtoReflect.Descriptor instead.
+func (*Storage_S3) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *Storage_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Storage_S3) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Storage_S3) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Storage_S3) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Storage_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Storage_S3) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
 	}
 	return false
 }
 
-func (x *Data_Database) GetSslCa() string {
+func (x *Storage_S3) GetPathStyle() bool {
 	if x != nil {
-		return x.SslCa
+		return x.PathStyle
+	}
+	return false
+}
+
+func (x *Storage_S3) GetPublicUrl() string {
+	if x != nil {
+		return x.PublicUrl
 	}
 	return ""
 }
 
-func (x *Data_Database) GetSslCert() string {
+// 分片直传配置
+type Storage_Upload struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartSize       int64                  `protobuf:"varint,1,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`                     // 分片大小(字节)，默认 8MB，不小于 5MB
+	SessionTtl     *durationpb.Duration   `protobuf:"bytes,2,opt,name=session_ttl,json=sessionTtl,proto3" json:"session_ttl,omitempty"`                // 上传会话有效期，默认 24h，过期后由定时任务清理
+	MaxSize        int64                  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`                        // 单个文件最大字节数，默认 5GB
+	UserQuota      int64                  `protobuf:"varint,4,opt,name=user_quota,json=userQuota,proto3" json:"user_quota,omitempty"`                  // 普通用户存储配额(字节)，0 为不限制
+	MaxImagePixels int64                  `protobuf:"varint,5,opt,name=max_image_pixels,json=maxImagePixels,proto3" json:"max_image_pixels,omitempty"` // 图片最大像素数(宽x高)，默认 4000万，防止解压炸弹
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Storage_Upload) Reset() {
+	*x = Storage_Upload{}
+	mi := &file_common_conf_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage_Upload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_Upload) ProtoMessage() {}
+
+func (x *Storage_Upload) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[28]
 	if x != nil {
-		return x.SslCert
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_Upload.ProtoReflect.Descriptor instead.
+func (*Storage_Upload) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{20, 2}
+}
+
+func (x *Storage_Upload) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *Storage_Upload) GetSessionTtl() *durationpb.Duration {
+	if x != nil {
+		return x.SessionTtl
+	}
+	return nil
+}
+
+func (x *Storage_Upload) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Storage_Upload) GetUserQuota() int64 {
+	if x != nil {
+		return x.UserQuota
+	}
+	return 0
+}
+
+func (x *Storage_Upload) GetMaxImagePixels() int64 {
+	if x != nil {
+		return x.MaxImagePixels
+	}
+	return 0
+}
+
+// 上传文件恶意扫描，命中的文件移动到隔离目录
+type Storage_Scanner struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Driver           string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                                             // 为空时不扫描 | clamav
+	Network          string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`                                           // clamd 连接方式，默认 unix
+	Address          string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                           // clamd 地址，如 /var/run/clamav/clamd.ctl、127.0.0.1:3310
+	Timeout          *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                                           // 单个文件扫描超时，默认 1m
+	QuarantinePrefix string                 `protobuf:"bytes,5,opt,name=quarantine_prefix,json=quarantinePrefix,proto3" json:"quarantine_prefix,omitempty"` // 隔离目录，默认 quarantine，不应允许公开访问
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Storage_Scanner) Reset() {
+	*x = Storage_Scanner{}
+	mi := &file_common_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Storage_Scanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Storage_Scanner) ProtoMessage() {}
+
+func (x *Storage_Scanner) ProtoReflect() protoreflect.Message {
+	mi := &file_common_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Storage_Scanner.ProtoReflect.Descriptor instead.
+func (*Storage_Scanner) Descriptor() ([]byte, []int) {
+	return file_common_conf_conf_proto_rawDescGZIP(), []int{20, 3}
+}
+
+func (x *Storage_Scanner) GetDriver() string {
+	if x != nil {
+		return x.Driver
 	}
 	return ""
 }
 
-func (x *Data_Database) GetSslKey() string {
+func (x *Storage_Scanner) GetNetwork() string {
 	if x != nil {
-		return x.SslKey
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Storage_Scanner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Storage_Scanner) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Storage_Scanner) GetQuarantinePrefix() string {
+	if x != nil {
+		return x.QuarantinePrefix
 	}
 	return ""
 }
@@ -1574,7 +2431,7 @@ var File_common_conf_conf_proto protoreflect.FileDescriptor
 
 const file_common_conf_conf_proto_rawDesc = "" +
 	"\n" +
-	"\x16common/conf/conf.proto\x12\vcommon.conf\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\"\xaa\x05\n" +
+	"\x16common/conf/conf.proto\x12\vcommon.conf\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\"\xe7\x06\n" +
 	"\tBootstrap\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03env\x18\x02 \x01(\tR\x03env\x125\n" +
@@ -1593,11 +2450,16 @@ const file_common_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\f \x01(\tR\tprojectId\x125\n" +
 	"\x06google\x18\r \x01(\v2\x13.common.conf.GoogleB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06google\x122\n" +
-	"\x05email\x18\x0e \x01(\v2\x12.common.conf.EmailB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05email\"\xf4\x04\n" +
+	"\x05email\x18\x0e \x01(\v2\x12.common.conf.EmailB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x05email\x121\n" +
+	"\bpassword\x18\x0f \x01(\v2\x15.common.conf.PasswordR\bpassword\x12(\n" +
+	"\x05oauth\x18\x10 \x01(\v2\x12.common.conf.OAuthR\x05oauth\x12.\n" +
+	"\atracing\x18\x11 \x01(\v2\x14.common.conf.TracingR\atracing\x12.\n" +
+	"\astorage\x18\x12 \x01(\v2\x14.common.conf.StorageR\astorage\"\xff\x05\n" +
 	"\x06Server\x12,\n" +
 	"\x04http\x18\x01 \x01(\v2\x18.common.conf.Server.HTTPR\x04http\x12,\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x18.common.conf.Server.GRPCR\x04grpc\x12!\n" +
-	"\fenable_pprof\x18\x03 \x01(\bR\venablePprof\x1a\xff\x02\n" +
+	"\fenable_pprof\x18\x03 \x01(\bR\venablePprof\x12@\n" +
+	"\x0eshutdown_delay\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rshutdownDelay\x1a\xc8\x03\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -1613,7 +2475,8 @@ const file_common_conf_conf_proto_rawDesc = "" +
 	"\rallow_origins\x18\b \x03(\tR\fallowOrigins\x12%\n" +
 	"\x04auth\x18\t \x01(\v2\x11.common.conf.AuthR\x04auth\x121\n" +
 	"\bsecurity\x18\n" +
-	" \x01(\v2\x15.common.conf.SecurityR\bsecurity\x1ai\n" +
+	" \x01(\v2\x15.common.conf.SecurityR\bsecurity\x12G\n" +
+	"\x12jwt_refresh_expiry\x18\v \x01(\v2\x19.google.protobuf.DurationR\x10jwtRefreshExpiry\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -1681,11 +2544,21 @@ const file_common_conf_conf_proto_rawDesc = "" +
 	"\vmax_backups\x18\x05 \x01(\x05R\n" +
 	"maxBackups\x12\x17\n" +
 	"\amax_age\x18\x06 \x01(\x05R\x06maxAge\x12\x1a\n" +
-	"\bcompress\x18\a \x01(\bR\bcompress\"o\n" +
+	"\bcompress\x18\a \x01(\bR\bcompress\"\xb8\x04\n" +
 	"\x05Asynq\x12 \n" +
 	"\vconcurrency\x18\x01 \x01(\x05R\vconcurrency\x12\x1b\n" +
 	"\tmax_retry\x18\x02 \x01(\x05R\bmaxRetry\x12'\n" +
-	"\x0fstrict_priority\x18\x03 \x01(\bR\x0estrictPriority\"\x7f\n" +
+	"\x0fstrict_priority\x18\x03 \x01(\bR\x0estrictPriority\x128\n" +
+	"\n" +
+	"leader_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\tleaderTtl\x12C\n" +
+	"\x10retry_base_delay\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0eretryBaseDelay\x12A\n" +
+	"\x0fretry_max_delay\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\rretryMaxDelay\x12?\n" +
+	"\fretry_jitter\x18\a \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\vretryJitter\x88\x01\x01\x12E\n" +
+	"\x12alert_failure_rate\x18\b \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x10alertFailureRate\x12.\n" +
+	"\x13alert_min_processed\x18\t \x01(\x05R\x11alertMinProcessed\x12<\n" +
+	"\falert_window\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\valertWindowB\x0f\n" +
+	"\r_retry_jitter\"\x7f\n" +
 	"\x12GoogleCloudStorage\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
@@ -1716,13 +2589,87 @@ const file_common_conf_conf_proto_rawDesc = "" +
 	"\x06Google\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12!\n" +
-	"\fredirect_url\x18\x03 \x01(\tR\vredirectUrl\"{\n" +
+	"\fredirect_url\x18\x03 \x01(\tR\vredirectUrl\"\x95\x03\n" +
+	"\x05OAuth\x129\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1b.common.conf.OAuth.ProviderR\tproviders\x126\n" +
+	"\tstate_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bstateTtl\x1a\x98\x02\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06issuer\x18\x06 \x01(\tR\x06issuer\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\x12\x17\n" +
+	"\ateam_id\x18\b \x01(\tR\x06teamId\x12\x15\n" +
+	"\x06key_id\x18\t \x01(\tR\x05keyId\x12\x1f\n" +
+	"\vprivate_key\x18\n" +
+	" \x01(\tR\n" +
+	"privateKey\"\xa9\x01\n" +
 	"\x05Email\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04from\x18\x05 \x01(\tR\x04fromB.Z,github.com/ydssx/kratos-kit/common/conf;confb\x06proto3"
+	"\x04from\x18\x05 \x01(\tR\x04from\x12,\n" +
+	"\x12reset_password_url\x18\x06 \x01(\tR\x10resetPasswordUrl\"\xca\x01\n" +
+	"\bPassword\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12#\n" +
+	"\rargon2_memory\x18\x02 \x01(\rR\fargon2Memory\x12+\n" +
+	"\x11argon2_iterations\x18\x03 \x01(\rR\x10argon2Iterations\x12-\n" +
+	"\x12argon2_parallelism\x18\x04 \x01(\rR\x11argon2Parallelism\x12\x1f\n" +
+	"\vbcrypt_cost\x18\x05 \x01(\x05R\n" +
+	"bcryptCost\"\xe4\x02\n" +
+	"\aTracing\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x127\n" +
+	"\bexporter\x18\x02 \x01(\tB\x1b\xfaB\x18r\x16R\x00R\x04otlpR\x06stdoutR\x04fileR\bexporter\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x04 \x01(\bR\binsecure\x12;\n" +
+	"\aheaders\x18\x05 \x03(\v2!.common.conf.Tracing.HeadersEntryR\aheaders\x12:\n" +
+	"\fsample_ratio\x18\x06 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\vsampleRatio\x12\x1b\n" +
+	"\tfile_path\x18\a \x01(\tR\bfilePath\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xab\b\n" +
+	"\aStorage\x12/\n" +
+	"\x06driver\x18\x01 \x01(\tB\x17\xfaB\x14r\x12R\x00R\x03gcsR\x05localR\x02s3R\x06driver\x120\n" +
+	"\x05local\x18\x02 \x01(\v2\x1a.common.conf.Storage.LocalR\x05local\x12'\n" +
+	"\x02s3\x18\x03 \x01(\v2\x17.common.conf.Storage.S3R\x02s3\x123\n" +
+	"\x06upload\x18\x04 \x01(\v2\x1b.common.conf.Storage.UploadR\x06upload\x126\n" +
+	"\ascanner\x18\x05 \x01(\v2\x1c.common.conf.Storage.ScannerR\ascanner\x1at\n" +
+	"\x05Local\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12!\n" +
+	"\froute_prefix\x18\x03 \x01(\tR\vroutePrefix\x12\x19\n" +
+	"\bsign_key\x18\x04 \x01(\tR\asignKey\x1a\xe5\x01\n" +
+	"\x02S3\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x03 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x04 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\x12\x17\n" +
+	"\ause_ssl\x18\x06 \x01(\bR\x06useSsl\x12\x1d\n" +
+	"\n" +
+	"path_style\x18\a \x01(\bR\tpathStyle\x12\x1d\n" +
+	"\n" +
+	"public_url\x18\b \x01(\tR\tpublicUrl\x1a\xe9\x01\n" +
+	"\x06Upload\x12$\n" +
+	"\tpart_size\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bpartSize\x12:\n" +
+	"\vsession_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"sessionTtl\x12\"\n" +
+	"\bmax_size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\amaxSize\x12&\n" +
+	"\n" +
+	"user_quota\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tuserQuota\x121\n" +
+	"\x10max_image_pixels\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0emaxImagePixels\x1a\xdc\x01\n" +
+	"\aScanner\x12'\n" +
+	"\x06driver\x18\x01 \x01(\tB\x0f\xfaB\fr\n" +
+	"R\x00R\x06clamavR\x06driver\x12,\n" +
+	"\anetwork\x18\x02 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x04unixR\x03tcpR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12+\n" +
+	"\x11quarantine_prefix\x18\x05 \x01(\tR\x10quarantinePrefixB.Z,github.com/ydssx/kratos-kit/common/conf;confb\x06proto3"
 
 var (
 	file_common_conf_conf_proto_rawDescOnce sync.Once
@@ -1736,7 +2683,7 @@ func file_common_conf_conf_proto_rawDescGZIP() []byte {
 	return file_common_conf_conf_proto_rawDescData
 }
 
-var file_common_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_common_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_common_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: common.conf.Bootstrap
 	(*Server)(nil),              // 1: common.conf.Server
@@ -1754,11 +2701,21 @@ var file_common_conf_conf_proto_goTypes = []any{
 	(*Webhook)(nil),             // 13: common.conf.Webhook
 	(*Aes)(nil),                 // 14: common.conf.Aes
 	(*Google)(nil),              // 15: common.conf.Google
-	(*Email)(nil),               // 16: common.conf.Email
-	(*Server_HTTP)(nil),         // 17: common.conf.Server.HTTP
-	(*Server_GRPC)(nil),         // 18: common.conf.Server.GRPC
-	(*Data_Database)(nil),       // 19: common.conf.Data.Database
-	(*durationpb.Duration)(nil), // 20: google.protobuf.Duration
+	(*OAuth)(nil),               // 16: common.conf.OAuth
+	(*Email)(nil),               // 17: common.conf.Email
+	(*Password)(nil),            // 18: common.conf.Password
+	(*Tracing)(nil),             // 19: common.conf.Tracing
+	(*Storage)(nil),             // 20: common.conf.Storage
+	(*Server_HTTP)(nil),         // 21: common.conf.Server.HTTP
+	(*Server_GRPC)(nil),         // 22: common.conf.Server.GRPC
+	(*Data_Database)(nil),       // 23: common.conf.Data.Database
+	(*OAuth_Provider)(nil),      // 24: common.conf.OAuth.Provider
+	nil,                         // 25: common.conf.Tracing.HeadersEntry
+	(*Storage_Local)(nil),       // 26: common.conf.Storage.Local
+	(*Storage_S3)(nil),          // 27: common.conf.Storage.S3
+	(*Storage_Upload)(nil),      // 28: common.conf.Storage.Upload
+	(*Storage_Scanner)(nil),     // 29: common.conf.Storage.Scanner
+	(*durationpb.Duration)(nil), // 30: google.protobuf.Duration
 }
 var file_common_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: common.conf.Bootstrap.server:type_name -> common.conf.Server
@@ -1771,34 +2728,53 @@ var file_common_conf_conf_proto_depIdxs = []int32{
 	13, // 7: common.conf.Bootstrap.webhook:type_name -> common.conf.Webhook
 	14, // 8: common.conf.Bootstrap.aes:type_name -> common.conf.Aes
 	15, // 9: common.conf.Bootstrap.google:type_name -> common.conf.Google
-	16, // 10: common.conf.Bootstrap.email:type_name -> common.conf.Email
-	17, // 11: common.conf.Server.http:type_name -> common.conf.Server.HTTP
-	18, // 12: common.conf.Server.grpc:type_name -> common.conf.Server.GRPC
-	19, // 13: common.conf.Data.database:type_name -> common.conf.Data.Database
-	5,  // 14: common.conf.Data.redis:type_name -> common.conf.Redis
-	6,  // 15: common.conf.Data.mongo:type_name -> common.conf.Mongo
-	10, // 16: common.conf.Data.geoip:type_name -> common.conf.Geoip
-	5,  // 17: common.conf.Data.job_redis:type_name -> common.conf.Redis
-	19, // 18: common.conf.Data.event_database:type_name -> common.conf.Data.Database
-	20, // 19: common.conf.Redis.read_timeout:type_name -> google.protobuf.Duration
-	20, // 20: common.conf.Redis.write_timeout:type_name -> google.protobuf.Duration
-	20, // 21: common.conf.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	20, // 22: common.conf.Redis.min_retry_backoff:type_name -> google.protobuf.Duration
-	20, // 23: common.conf.Redis.max_retry_backoff:type_name -> google.protobuf.Duration
-	20, // 24: common.conf.Mongo.read_timeout:type_name -> google.protobuf.Duration
-	20, // 25: common.conf.Mongo.write_timeout:type_name -> google.protobuf.Duration
-	20, // 26: common.conf.Mongo.dial_timeout:type_name -> google.protobuf.Duration
-	20, // 27: common.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	20, // 28: common.conf.Server.HTTP.jwt_expiry:type_name -> google.protobuf.Duration
-	2,  // 29: common.conf.Server.HTTP.auth:type_name -> common.conf.Auth
-	3,  // 30: common.conf.Server.HTTP.security:type_name -> common.conf.Security
-	20, // 31: common.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	20, // 32: common.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	17, // 10: common.conf.Bootstrap.email:type_name -> common.conf.Email
+	18, // 11: common.conf.Bootstrap.password:type_name -> common.conf.Password
+	16, // 12: common.conf.Bootstrap.oauth:type_name -> common.conf.OAuth
+	19, // 13: common.conf.Bootstrap.tracing:type_name -> common.conf.Tracing
+	20, // 14: common.conf.Bootstrap.storage:type_name -> common.conf.Storage
+	21, // 15: common.conf.Server.http:type_name -> common.conf.Server.HTTP
+	22, // 16: common.conf.Server.grpc:type_name -> common.conf.Server.GRPC
+	30, // 17: common.conf.Server.shutdown_delay:type_name -> google.protobuf.Duration
+	23, // 18: common.conf.Data.database:type_name -> common.conf.Data.Database
+	5,  // 19: common.conf.Data.redis:type_name -> common.conf.Redis
+	6,  // 20: common.conf.Data.mongo:type_name -> common.conf.Mongo
+	10, // 21: common.conf.Data.geoip:type_name -> common.conf.Geoip
+	5,  // 22: common.conf.Data.job_redis:type_name -> common.conf.Redis
+	23, // 23: common.conf.Data.event_database:type_name -> common.conf.Data.Database
+	30, // 24: common.conf.Redis.read_timeout:type_name -> google.protobuf.Duration
+	30, // 25: common.conf.Redis.write_timeout:type_name -> google.protobuf.Duration
+	30, // 26: common.conf.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	30, // 27: common.conf.Redis.min_retry_backoff:type_name -> google.protobuf.Duration
+	30, // 28: common.conf.Redis.max_retry_backoff:type_name -> google.protobuf.Duration
+	30, // 29: common.conf.Mongo.read_timeout:type_name -> google.protobuf.Duration
+	30, // 30: common.conf.Mongo.write_timeout:type_name -> google.protobuf.Duration
+	30, // 31: common.conf.Mongo.dial_timeout:type_name -> google.protobuf.Duration
+	30, // 32: common.conf.Asynq.leader_ttl:type_name -> google.protobuf.Duration
+	30, // 33: common.conf.Asynq.retry_base_delay:type_name -> google.protobuf.Duration
+	30, // 34: common.conf.Asynq.retry_max_delay:type_name -> google.protobuf.Duration
+	30, // 35: common.conf.Asynq.alert_window:type_name -> google.protobuf.Duration
+	24, // 36: common.conf.OAuth.providers:type_name -> common.conf.OAuth.Provider
+	30, // 37: common.conf.OAuth.state_ttl:type_name -> google.protobuf.Duration
+	25, // 38: common.conf.Tracing.headers:type_name -> common.conf.Tracing.HeadersEntry
+	26, // 39: common.conf.Storage.local:type_name -> common.conf.Storage.Local
+	27, // 40: common.conf.Storage.s3:type_name -> common.conf.Storage.S3
+	28, // 41: common.conf.Storage.upload:type_name -> common.conf.Storage.Upload
+	29, // 42: common.conf.Storage.scanner:type_name -> common.conf.Storage.Scanner
+	30, // 43: common.conf.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	30, // 44: common.conf.Server.HTTP.jwt_expiry:type_name -> google.protobuf.Duration
+	2,  // 45: common.conf.Server.HTTP.auth:type_name -> common.conf.Auth
+	3,  // 46: common.conf.Server.HTTP.security:type_name -> common.conf.Security
+	30, // 47: common.conf.Server.HTTP.jwt_refresh_expiry:type_name -> google.protobuf.Duration
+	30, // 48: common.conf.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	30, // 49: common.conf.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	30, // 50: common.conf.Storage.Upload.session_ttl:type_name -> google.protobuf.Duration
+	30, // 51: common.conf.Storage.Scanner.timeout:type_name -> google.protobuf.Duration
+	52, // [52:52] is the sub-list for method output_type
+	52, // [52:52] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_common_conf_conf_proto_init() }
@@ -1806,13 +2782,14 @@ func file_common_conf_conf_proto_init() {
 	if File_common_conf_conf_proto != nil {
 		return
 	}
+	file_common_conf_conf_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_conf_conf_proto_rawDesc), len(file_common_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -503,6 +503,122 @@ func (m *Bootstrap) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPassword()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Password",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Password",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPassword()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Password",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOauth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Oauth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Oauth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOauth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Oauth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTracing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTracing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Tracing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Storage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Storage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Storage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BootstrapMultiError(errors)
 	}
@@ -661,6 +777,35 @@ func (m *Server) validate(all bool) error {
 
 	// no validation rules for EnablePprof
 
+	if all {
+		switch v := interface{}(m.GetShutdownDelay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "ShutdownDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "ShutdownDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShutdownDelay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "ShutdownDelay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ServerMultiError(errors)
 	}
@@ -1808,6 +1953,150 @@ func (m *Asynq) validate(all bool) error {
 
 	// no validation rules for StrictPriority
 
+	if all {
+		switch v := interface{}(m.GetLeaderTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "LeaderTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "LeaderTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaderTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AsynqValidationError{
+				field:  "LeaderTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryBaseDelay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "RetryBaseDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "RetryBaseDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryBaseDelay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AsynqValidationError{
+				field:  "RetryBaseDelay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryMaxDelay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "RetryMaxDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "RetryMaxDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryMaxDelay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AsynqValidationError{
+				field:  "RetryMaxDelay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetAlertFailureRate(); val < 0 || val > 1 {
+		err := AsynqValidationError{
+			field:  "AlertFailureRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AlertMinProcessed
+
+	if all {
+		switch v := interface{}(m.GetAlertWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "AlertWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AsynqValidationError{
+					field:  "AlertWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAlertWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AsynqValidationError{
+				field:  "AlertWindow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.RetryJitter != nil {
+
+		if val := m.GetRetryJitter(); val < 0 || val > 1 {
+			err := AsynqValidationError{
+				field:  "RetryJitter",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AsynqMultiError(errors)
 	}
@@ -2610,55 +2899,218 @@ var _ interface {
 	ErrorName() string
 } = GoogleValidationError{}
 
-// Validate checks the field values on Email with the rules defined in the
+// Validate checks the field values on OAuth with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Email) Validate() error {
+func (m *OAuth) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Email with the rules defined in the
+// ValidateAll checks the field values on OAuth with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in EmailMultiError, or nil if none found.
-func (m *Email) ValidateAll() error {
+// a list of violation errors wrapped in OAuthMultiError, or nil if none found.
+func (m *OAuth) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Email) validate(all bool) error {
+func (m *OAuth) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Host
-
-	// no validation rules for Port
-
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for From
+	for idx, item := range m.GetProviders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OAuthValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OAuthValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OAuthValidationError{
+					field:  fmt.Sprintf("Providers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if len(errors) > 0 {
-		return EmailMultiError(errors)
 	}
 
-	return nil
-}
-
-// EmailMultiError is an error wrapping multiple validation errors returned by
-// Email.ValidateAll() if the designated constraints aren't met.
-type EmailMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m EmailMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
+	if all {
+		switch v := interface{}(m.GetStateTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthValidationError{
+					field:  "StateTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthValidationError{
+					field:  "StateTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStateTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthValidationError{
+				field:  "StateTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OAuthMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthMultiError is an error wrapping multiple validation errors returned by
+// OAuth.ValidateAll() if the designated constraints aren't met.
+type OAuthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthMultiError) AllErrors() []error { return m }
+
+// OAuthValidationError is the validation error returned by OAuth.Validate if
+// the designated constraints aren't met.
+type OAuthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthValidationError) ErrorName() string { return "OAuthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthValidationError{}
+
+// Validate checks the field values on Email with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Email) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Email with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EmailMultiError, or nil if none found.
+func (m *Email) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Email) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for From
+
+	// no validation rules for ResetPasswordUrl
+
+	if len(errors) > 0 {
+		return EmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmailMultiError is an error wrapping multiple validation errors returned by
+// Email.ValidateAll() if the designated constraints aren't met.
+type EmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
@@ -2718,167 +3170,1290 @@ var _ interface {
 	ErrorName() string
 } = EmailValidationError{}
 
-// Validate checks the field values on Server_HTTP with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Password with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_HTTP) Validate() error {
+func (m *Password) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// ValidateAll checks the field values on Password with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_HTTPMultiError, or
-// nil if none found.
-func (m *Server_HTTP) ValidateAll() error {
+// result is a list of violation errors wrapped in PasswordMultiError, or nil
+// if none found.
+func (m *Password) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_HTTP) validate(all bool) error {
+func (m *Password) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
+	// no validation rules for Algorithm
 
-	// no validation rules for Addr
+	// no validation rules for Argon2Memory
 
-	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for Argon2Iterations
+
+	// no validation rules for Argon2Parallelism
+
+	// no validation rules for BcryptCost
+
+	if len(errors) > 0 {
+		return PasswordMultiError(errors)
+	}
+
+	return nil
+}
+
+// PasswordMultiError is an error wrapping multiple validation errors returned
+// by Password.ValidateAll() if the designated constraints aren't met.
+type PasswordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PasswordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PasswordMultiError) AllErrors() []error { return m }
+
+// PasswordValidationError is the validation error returned by
+// Password.Validate if the designated constraints aren't met.
+type PasswordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PasswordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PasswordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PasswordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PasswordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PasswordValidationError) ErrorName() string { return "PasswordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PasswordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassword.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PasswordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PasswordValidationError{}
+
+// Validate checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tracing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TracingMultiError, or nil if none found.
+func (m *Tracing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tracing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	if _, ok := _Tracing_Exporter_InLookup[m.GetExporter()]; !ok {
+		err := TracingValidationError{
+			field:  "Exporter",
+			reason: "value must be in list [ otlp stdout file]",
 		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Timeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for JwtSecret
+	// no validation rules for Endpoint
+
+	// no validation rules for Insecure
+
+	// no validation rules for Headers
+
+	if val := m.GetSampleRatio(); val < 0 || val > 1 {
+		err := TracingValidationError{
+			field:  "SampleRatio",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FilePath
+
+	if len(errors) > 0 {
+		return TracingMultiError(errors)
+	}
+
+	return nil
+}
+
+// TracingMultiError is an error wrapping multiple validation errors returned
+// by Tracing.ValidateAll() if the designated constraints aren't met.
+type TracingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TracingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TracingMultiError) AllErrors() []error { return m }
+
+// TracingValidationError is the validation error returned by Tracing.Validate
+// if the designated constraints aren't met.
+type TracingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TracingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TracingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TracingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TracingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TracingValidationError) ErrorName() string { return "TracingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TracingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TracingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TracingValidationError{}
+
+var _Tracing_Exporter_InLookup = map[string]struct{}{
+	"":       {},
+	"otlp":   {},
+	"stdout": {},
+	"file":   {},
+}
+
+// Validate checks the field values on Storage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Storage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Storage with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StorageMultiError, or nil if none found.
+func (m *Storage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Storage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Storage_Driver_InLookup[m.GetDriver()]; !ok {
+		err := StorageValidationError{
+			field:  "Driver",
+			reason: "value must be in list [ gcs local s3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageValidationError{
+				field:  "Local",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetS3()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "S3",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "S3",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetS3()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageValidationError{
+				field:  "S3",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageValidationError{
+				field:  "Upload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetScanner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Scanner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageValidationError{
+					field:  "Scanner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScanner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageValidationError{
+				field:  "Scanner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageMultiError is an error wrapping multiple validation errors returned
+// by Storage.ValidateAll() if the designated constraints aren't met.
+type StorageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageMultiError) AllErrors() []error { return m }
+
+// StorageValidationError is the validation error returned by Storage.Validate
+// if the designated constraints aren't met.
+type StorageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageValidationError) ErrorName() string { return "StorageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageValidationError{}
+
+var _Storage_Driver_InLookup = map[string]struct{}{
+	"":      {},
+	"gcs":   {},
+	"local": {},
+	"s3":    {},
+}
+
+// Validate checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_HTTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_HTTPMultiError, or
+// nil if none found.
+func (m *Server_HTTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_HTTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for JwtSecret
+
+	if all {
+		switch v := interface{}(m.GetJwtExpiry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "JwtExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "JwtExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJwtExpiry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "JwtExpiry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RateLimit
+
+	// no validation rules for RateBurst
+
+	if all {
+		switch v := interface{}(m.GetAuth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Auth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetJwtRefreshExpiry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "JwtRefreshExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "JwtRefreshExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJwtRefreshExpiry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "JwtRefreshExpiry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_HTTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_HTTPMultiError is an error wrapping multiple validation errors
+// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
+type Server_HTTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_HTTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_HTTPMultiError) AllErrors() []error { return m }
+
+// Server_HTTPValidationError is the validation error returned by
+// Server_HTTP.Validate if the designated constraints aren't met.
+type Server_HTTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_HTTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_HTTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_HTTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_HTTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_HTTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_HTTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_HTTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_HTTPValidationError{}
+
+// Validate checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// nil if none found.
+func (m *Server_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_GRPCValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
+type Server_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_GRPCMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_GRPCMultiError) AllErrors() []error { return m }
+
+// Server_GRPCValidationError is the validation error returned by
+// Server_GRPC.Validate if the designated constraints aren't met.
+type Server_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_GRPCValidationError{}
+
+// Validate checks the field values on Data_Database with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Database) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Database with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
+// nil if none found.
+func (m *Data_Database) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Database) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Driver
+
+	// no validation rules for MaxOpenConns
+
+	// no validation rules for MaxIdleConns
+
+	if all {
+		switch v := interface{}(m.GetConnMaxLifetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "ConnMaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "ConnMaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnMaxLifetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_DatabaseValidationError{
+				field:  "ConnMaxLifetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EnableSsl
+
+	// no validation rules for SslCa
+
+	// no validation rules for SslCert
+
+	// no validation rules for SslKey
+
+	if len(errors) > 0 {
+		return Data_DatabaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_DatabaseMultiError is an error wrapping multiple validation errors
+// returned by Data_Database.ValidateAll() if the designated constraints
+// aren't met.
+type Data_DatabaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_DatabaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+
+// Data_DatabaseValidationError is the validation error returned by
+// Data_Database.Validate if the designated constraints aren't met.
+type Data_DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Database.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_DatabaseValidationError{}
+
+// Validate checks the field values on OAuth_Provider with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OAuth_Provider) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuth_Provider with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OAuth_ProviderMultiError,
+// or nil if none found.
+func (m *OAuth_Provider) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuth_Provider) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for RedirectUrl
+
+	// no validation rules for Issuer
+
+	// no validation rules for TeamId
+
+	// no validation rules for KeyId
+
+	// no validation rules for PrivateKey
+
+	if len(errors) > 0 {
+		return OAuth_ProviderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuth_ProviderMultiError is an error wrapping multiple validation errors
+// returned by OAuth_Provider.ValidateAll() if the designated constraints
+// aren't met.
+type OAuth_ProviderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuth_ProviderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuth_ProviderMultiError) AllErrors() []error { return m }
+
+// OAuth_ProviderValidationError is the validation error returned by
+// OAuth_Provider.Validate if the designated constraints aren't met.
+type OAuth_ProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuth_ProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuth_ProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuth_ProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuth_ProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuth_ProviderValidationError) ErrorName() string { return "OAuth_ProviderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuth_ProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuth_Provider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuth_ProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuth_ProviderValidationError{}
+
+// Validate checks the field values on Storage_Local with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Storage_Local) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Storage_Local with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Storage_LocalMultiError, or
+// nil if none found.
+func (m *Storage_Local) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Storage_Local) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Root
+
+	// no validation rules for BaseUrl
+
+	// no validation rules for RoutePrefix
+
+	// no validation rules for SignKey
+
+	if len(errors) > 0 {
+		return Storage_LocalMultiError(errors)
+	}
+
+	return nil
+}
+
+// Storage_LocalMultiError is an error wrapping multiple validation errors
+// returned by Storage_Local.ValidateAll() if the designated constraints
+// aren't met.
+type Storage_LocalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Storage_LocalMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Storage_LocalMultiError) AllErrors() []error { return m }
+
+// Storage_LocalValidationError is the validation error returned by
+// Storage_Local.Validate if the designated constraints aren't met.
+type Storage_LocalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Storage_LocalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Storage_LocalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Storage_LocalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Storage_LocalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Storage_LocalValidationError) ErrorName() string { return "Storage_LocalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Storage_LocalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorage_Local.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Storage_LocalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Storage_LocalValidationError{}
+
+// Validate checks the field values on Storage_S3 with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Storage_S3) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Storage_S3 with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Storage_S3MultiError, or
+// nil if none found.
+func (m *Storage_S3) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if all {
-		switch v := interface{}(m.GetJwtExpiry()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "JwtExpiry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "JwtExpiry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJwtExpiry()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "JwtExpiry",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *Storage_S3) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for RateLimit
+	var errors []error
 
-	// no validation rules for RateBurst
+	// no validation rules for Endpoint
 
-	if all {
-		switch v := interface{}(m.GetAuth()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Auth",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Auth",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Auth",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Region
 
-	if all {
-		switch v := interface{}(m.GetSecurity()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Security",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Security",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Security",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Bucket
+
+	// no validation rules for AccessKey
+
+	// no validation rules for SecretKey
+
+	// no validation rules for UseSsl
+
+	// no validation rules for PathStyle
+
+	// no validation rules for PublicUrl
 
 	if len(errors) > 0 {
-		return Server_HTTPMultiError(errors)
+		return Storage_S3MultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_HTTPMultiError is an error wrapping multiple validation errors
-// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
-type Server_HTTPMultiError []error
+// Storage_S3MultiError is an error wrapping multiple validation errors
+// returned by Storage_S3.ValidateAll() if the designated constraints aren't met.
+type Storage_S3MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_HTTPMultiError) Error() string {
+func (m Storage_S3MultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2887,11 +4462,11 @@ func (m Server_HTTPMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_HTTPMultiError) AllErrors() []error { return m }
+func (m Storage_S3MultiError) AllErrors() []error { return m }
 
-// Server_HTTPValidationError is the validation error returned by
-// Server_HTTP.Validate if the designated constraints aren't met.
-type Server_HTTPValidationError struct {
+// Storage_S3ValidationError is the validation error returned by
+// Storage_S3.Validate if the designated constraints aren't met.
+type Storage_S3ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2899,22 +4474,22 @@ type Server_HTTPValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_HTTPValidationError) Field() string { return e.field }
+func (e Storage_S3ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_HTTPValidationError) Reason() string { return e.reason }
+func (e Storage_S3ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_HTTPValidationError) Cause() error { return e.cause }
+func (e Storage_S3ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_HTTPValidationError) Key() bool { return e.key }
+func (e Storage_S3ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+func (e Storage_S3ValidationError) ErrorName() string { return "Storage_S3ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_HTTPValidationError) Error() string {
+func (e Storage_S3ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2926,14 +4501,14 @@ func (e Server_HTTPValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_HTTP.%s: %s%s",
+		"invalid %sStorage_S3.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_HTTPValidationError{}
+var _ error = Storage_S3ValidationError{}
 
 var _ interface {
 	Field() string
@@ -2941,76 +4516,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_HTTPValidationError{}
+} = Storage_S3ValidationError{}
 
-// Validate checks the field values on Server_GRPC with the rules defined in
+// Validate checks the field values on Storage_Upload with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_GRPC) Validate() error {
+func (m *Storage_Upload) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_GRPC with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_GRPCMultiError, or
-// nil if none found.
-func (m *Server_GRPC) ValidateAll() error {
+// ValidateAll checks the field values on Storage_Upload with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Storage_UploadMultiError,
+// or nil if none found.
+func (m *Storage_Upload) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_GRPC) validate(all bool) error {
+func (m *Storage_Upload) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
-
-	// no validation rules for Addr
+	if m.GetPartSize() < 0 {
+		err := Storage_UploadValidationError{
+			field:  "PartSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
+		switch v := interface{}(m.GetSessionTtl()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
+				errors = append(errors, Storage_UploadValidationError{
+					field:  "SessionTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
+				errors = append(errors, Storage_UploadValidationError{
+					field:  "SessionTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSessionTtl()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Server_GRPCValidationError{
-				field:  "Timeout",
+			return Storage_UploadValidationError{
+				field:  "SessionTtl",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetMaxSize() < 0 {
+		err := Storage_UploadValidationError{
+			field:  "MaxSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserQuota() < 0 {
+		err := Storage_UploadValidationError{
+			field:  "UserQuota",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxImagePixels() < 0 {
+		err := Storage_UploadValidationError{
+			field:  "MaxImagePixels",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return Server_GRPCMultiError(errors)
+		return Storage_UploadMultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_GRPCMultiError is an error wrapping multiple validation errors
-// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
-type Server_GRPCMultiError []error
+// Storage_UploadMultiError is an error wrapping multiple validation errors
+// returned by Storage_Upload.ValidateAll() if the designated constraints
+// aren't met.
+type Storage_UploadMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_GRPCMultiError) Error() string {
+func (m Storage_UploadMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3019,11 +4635,11 @@ func (m Server_GRPCMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_GRPCMultiError) AllErrors() []error { return m }
+func (m Storage_UploadMultiError) AllErrors() []error { return m }
 
-// Server_GRPCValidationError is the validation error returned by
-// Server_GRPC.Validate if the designated constraints aren't met.
-type Server_GRPCValidationError struct {
+// Storage_UploadValidationError is the validation error returned by
+// Storage_Upload.Validate if the designated constraints aren't met.
+type Storage_UploadValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3031,22 +4647,22 @@ type Server_GRPCValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_GRPCValidationError) Field() string { return e.field }
+func (e Storage_UploadValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_GRPCValidationError) Reason() string { return e.reason }
+func (e Storage_UploadValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_GRPCValidationError) Cause() error { return e.cause }
+func (e Storage_UploadValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_GRPCValidationError) Key() bool { return e.key }
+func (e Storage_UploadValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+func (e Storage_UploadValidationError) ErrorName() string { return "Storage_UploadValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_GRPCValidationError) Error() string {
+func (e Storage_UploadValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3058,14 +4674,14 @@ func (e Server_GRPCValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_GRPC.%s: %s%s",
+		"invalid %sStorage_Upload.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_GRPCValidationError{}
+var _ error = Storage_UploadValidationError{}
 
 var _ interface {
 	Field() string
@@ -3073,87 +4689,99 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_GRPCValidationError{}
+} = Storage_UploadValidationError{}
 
-// Validate checks the field values on Data_Database with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Data_Database) Validate() error {
+// Validate checks the field values on Storage_Scanner with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Storage_Scanner) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Database with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
-// nil if none found.
-func (m *Data_Database) ValidateAll() error {
+// ValidateAll checks the field values on Storage_Scanner with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Storage_ScannerMultiError, or nil if none found.
+func (m *Storage_Scanner) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Database) validate(all bool) error {
+func (m *Storage_Scanner) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Driver
+	if _, ok := _Storage_Scanner_Driver_InLookup[m.GetDriver()]; !ok {
+		err := Storage_ScannerValidationError{
+			field:  "Driver",
+			reason: "value must be in list [ clamav]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for MaxOpenConns
+	if _, ok := _Storage_Scanner_Network_InLookup[m.GetNetwork()]; !ok {
+		err := Storage_ScannerValidationError{
+			field:  "Network",
+			reason: "value must be in list [ unix tcp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for MaxIdleConns
+	// no validation rules for Address
 
 	if all {
-		switch v := interface{}(m.GetConnMaxLifetime()).(type) {
+		switch v := interface{}(m.GetTimeout()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_DatabaseValidationError{
-					field:  "ConnMaxLifetime",
+				errors = append(errors, Storage_ScannerValidationError{
+					field:  "Timeout",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_DatabaseValidationError{
-					field:  "ConnMaxLifetime",
+				errors = append(errors, Storage_ScannerValidationError{
+					field:  "Timeout",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConnMaxLifetime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Data_DatabaseValidationError{
-				field:  "ConnMaxLifetime",
+			return Storage_ScannerValidationError{
+				field:  "Timeout",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for EnableSsl
-
-	// no validation rules for SslCa
-
-	// no validation rules for SslCert
-
-	// no validation rules for SslKey
+	// no validation rules for QuarantinePrefix
 
 	if len(errors) > 0 {
-		return Data_DatabaseMultiError(errors)
+		return Storage_ScannerMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_DatabaseMultiError is an error wrapping multiple validation errors
-// returned by Data_Database.ValidateAll() if the designated constraints
+// Storage_ScannerMultiError is an error wrapping multiple validation errors
+// returned by Storage_Scanner.ValidateAll() if the designated constraints
 // aren't met.
-type Data_DatabaseMultiError []error
+type Storage_ScannerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_DatabaseMultiError) Error() string {
+func (m Storage_ScannerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3162,11 +4790,11 @@ func (m Data_DatabaseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+func (m Storage_ScannerMultiError) AllErrors() []error { return m }
 
-// Data_DatabaseValidationError is the validation error returned by
-// Data_Database.Validate if the designated constraints aren't met.
-type Data_DatabaseValidationError struct {
+// Storage_ScannerValidationError is the validation error returned by
+// Storage_Scanner.Validate if the designated constraints aren't met.
+type Storage_ScannerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3174,22 +4802,22 @@ type Data_DatabaseValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_DatabaseValidationError) Field() string { return e.field }
+func (e Storage_ScannerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+func (e Storage_ScannerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+func (e Storage_ScannerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_DatabaseValidationError) Key() bool { return e.key }
+func (e Storage_ScannerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+func (e Storage_ScannerValidationError) ErrorName() string { return "Storage_ScannerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Data_DatabaseValidationError) Error() string {
+func (e Storage_ScannerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3201,14 +4829,14 @@ func (e Data_DatabaseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Database.%s: %s%s",
+		"invalid %sStorage_Scanner.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_DatabaseValidationError{}
+var _ error = Storage_ScannerValidationError{}
 
 var _ interface {
 	Field() string
@@ -3216,4 +4844,15 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_DatabaseValidationError{}
+} = Storage_ScannerValidationError{}
+
+var _Storage_Scanner_Driver_InLookup = map[string]struct{}{
+	"":       {},
+	"clamav": {},
+}
+
+var _Storage_Scanner_Network_InLookup = map[string]struct{}{
+	"":     {},
+	"unix": {},
+	"tcp":  {},
+}
@@ -2,8 +2,10 @@ package common
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/ydssx/kratos-kit/common/conf"
 	"github.com/ydssx/kratos-kit/constants"
@@ -11,20 +13,26 @@ import (
 	"github.com/ydssx/kratos-kit/pkg/client/mysql"
 	"github.com/ydssx/kratos-kit/pkg/client/redis"
 	"github.com/ydssx/kratos-kit/pkg/email"
+	"github.com/ydssx/kratos-kit/pkg/filescan"
+	"github.com/ydssx/kratos-kit/pkg/health"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/limit"
 	"github.com/ydssx/kratos-kit/pkg/lock"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/oauth"
+	"github.com/ydssx/kratos-kit/pkg/password"
 	"github.com/ydssx/kratos-kit/pkg/queue"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/storage"
+	"github.com/ydssx/kratos-kit/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
 	"github.com/oschwald/geoip2-golang"
 	goredis "github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	mongooptions "go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
 )
 
@@ -82,6 +90,32 @@ func SetupLogger(c *conf.Bootstrap) {
 	log.SetLogger(klogger)
 }
 
+// SetupTracing 初始化链路追踪,返回的函数在退出前调用以导出剩余的span
+func SetupTracing(ctx context.Context, c *conf.Bootstrap) (func(), error) {
+	tc := c.GetTracing()
+	shutdown, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: c.GetName(),
+		Environment: c.GetEnv(),
+		Enable:      tc.GetEnable(),
+		Exporter:    tc.GetExporter(),
+		Endpoint:    tc.GetEndpoint(),
+		Insecure:    tc.GetInsecure(),
+		Headers:     tc.GetHeaders(),
+		SampleRatio: tc.GetSampleRatio(),
+		FilePath:    tc.GetFilePath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			log.Errorf("failed to shutdown tracing: %v", err)
+		}
+	}, nil
+}
+
 var (
 	rdbClientOpt asynq.RedisClientOpt
 	once         sync.Once
@@ -109,20 +143,67 @@ func InitJobRedisOpt(c *conf.Bootstrap) asynq.RedisClientOpt {
 	}
 }
 
-func NewQueueClient(c *conf.Bootstrap) *queue.Client {
-	return queue.NewClient(&queue.ConnConfig{
+func NewQueueClient(c *conf.Bootstrap) (*queue.Client, func()) {
+	client := queue.NewClient(&queue.ConnConfig{
 		RedisAddr:     c.Data.GetRedis().GetAddr(),
 		RedisPassword: c.Data.GetRedis().GetPassword(),
 		RedisDB:       int(c.Data.GetRedis().GetDb()),
 		ReadTimeout:   c.Data.GetRedis().GetReadTimeout().AsDuration(),
 		WriteTimeout:  c.Data.GetRedis().GetWriteTimeout().AsDuration(),
 	})
+	cleanup := func() {
+		if err := client.Close(); err != nil {
+			log.Errorf("关闭队列客户端失败: %v", err)
+		}
+	}
+	return client, cleanup
 }
 
-func NewGoogleCloudStorage(c *conf.Bootstrap) (*storage.GoogleCloudStorage, func()) {
+func NewGoogleCloudStorage(c *conf.Bootstrap) (*storage.GoogleCloudStorage, func(), error) {
 	return storage.NewGoogleCloudStorage(c.Gcs.GetBucketName(), c.Gcs.GetProjectId(), c.Gcs.GetCredentialsFile())
 }
 
+// NewFileScanner 根据 storage.scanner.driver 创建恶意文件扫描器,未配置时返回 nil
+func NewFileScanner(c *conf.Bootstrap) filescan.Scanner {
+	sc := c.GetStorage().GetScanner()
+	switch sc.GetDriver() {
+	case "clamav":
+		return filescan.NewClamAV(sc.GetNetwork(), sc.GetAddress(), sc.GetTimeout().AsDuration())
+	default:
+		return nil
+	}
+}
+
+// NewStorage 根据 storage.driver 创建文件存储,未配置时使用谷歌云存储
+func NewStorage(c *conf.Bootstrap) (storage.Storage, func(), error) {
+	sc := c.GetStorage()
+	switch sc.GetDriver() {
+	case "local":
+		lc := sc.GetLocal()
+		return storage.NewLocalStorage(storage.LocalConfig{
+			Root:        lc.GetRoot(),
+			BaseURL:     lc.GetBaseUrl(),
+			RoutePrefix: lc.GetRoutePrefix(),
+			SignKey:     lc.GetSignKey(),
+		})
+	case "s3":
+		s3c := sc.GetS3()
+		s, err := storage.NewS3Storage(storage.S3Config{
+			Endpoint:  s3c.GetEndpoint(),
+			Region:    s3c.GetRegion(),
+			Bucket:    s3c.GetBucket(),
+			AccessKey: s3c.GetAccessKey(),
+			SecretKey: s3c.GetSecretKey(),
+			UseSSL:    s3c.GetUseSsl(),
+			PathStyle: s3c.GetPathStyle(),
+			PublicURL: s3c.GetPublicUrl(),
+		})
+		return s, func() {}, err
+	default:
+		return NewGoogleCloudStorage(c)
+	}
+}
+
 // NewGeoipDB returns a new GeoipDB.
 func NewGeoipDB(ctx context.Context, c *conf.Bootstrap) *geoip2.Reader {
 	db, err := geoip2.Open(c.Data.Geoip.Path)
@@ -148,17 +229,116 @@ func NewRedisLocker(rdb *goredis.Client) *lock.RedisLocker {
 	return lock.NewLocker(rdb)
 }
 
-// 初始化Google OAuth配置
-func InitGoogleOAuth(c *conf.Bootstrap) *oauth2.Config {
-	return &oauth2.Config{
-		ClientID:     c.Google.GetClientId(),
-		ClientSecret: c.Google.GetClientSecret(),
-		RedirectURL:  c.Google.GetRedirectUrl(),
-		Scopes: []string{
-			"https://www.googleapis.com/auth/userinfo.email",
-			"https://www.googleapis.com/auth/userinfo.profile",
-		},
-		Endpoint: google.Endpoint,
+// NewPasswordHasher 创建密码哈希器,未配置时使用 argon2id 默认参数
+func NewPasswordHasher(c *conf.Bootstrap) password.Hasher {
+	pc := c.GetPassword()
+	return password.New(pc.GetAlgorithm(), password.Argon2Params{
+		Memory:      pc.GetArgon2Memory(),
+		Iterations:  pc.GetArgon2Iterations(),
+		Parallelism: uint8(pc.GetArgon2Parallelism()),
+	}, int(pc.GetBcryptCost()))
+}
+
+func NewSessionStore(rdb *goredis.Client) *session.RedisStore {
+	return session.NewRedisStore(rdb)
+}
+
+// NewOAuthRegistry 根据配置创建第三方登录提供方注册表,
+// 兼容旧的 google 配置块
+func NewOAuthRegistry(c *conf.Bootstrap) (*oauth.Registry, error) {
+	registry := oauth.NewRegistry()
+	for _, p := range c.GetOauth().GetProviders() {
+		provider, err := oauth.New(oauth.Config{
+			Name:         p.GetName(),
+			Type:         p.GetType(),
+			ClientID:     p.GetClientId(),
+			ClientSecret: p.GetClientSecret(),
+			RedirectURL:  p.GetRedirectUrl(),
+			Issuer:       p.GetIssuer(),
+			Scopes:       p.GetScopes(),
+			TeamID:       p.GetTeamId(),
+			KeyID:        p.GetKeyId(),
+			PrivateKey:   p.GetPrivateKey(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		registry.Register(provider)
+	}
+
+	if _, err := registry.Get(oauth.TypeGoogle); err != nil && c.Google.GetClientId() != "" {
+		registry.Register(oauth.NewGoogleProvider(oauth.Config{
+			Name:         oauth.TypeGoogle,
+			ClientID:     c.Google.GetClientId(),
+			ClientSecret: c.Google.GetClientSecret(),
+			RedirectURL:  c.Google.GetRedirectUrl(),
+		}))
+	}
+	return registry, nil
+}
+
+// NewJWTManager 根据HTTP配置创建JWT管理器
+func NewJWTManager(c *conf.Bootstrap) *jwt.Manager {
+	httpConf := c.Server.GetHttp()
+	accessExpiry := httpConf.GetJwtExpiry().AsDuration()
+	if accessExpiry <= 0 {
+		accessExpiry = 24 * time.Hour
+	}
+	refreshExpiry := httpConf.GetJwtRefreshExpiry().AsDuration()
+	if refreshExpiry <= 0 {
+		refreshExpiry = 30 * 24 * time.Hour
+	}
+	return jwt.NewManager(jwt.Config{
+		AccessSecret:         httpConf.GetJwtSecret(),
+		RefreshSecret:        httpConf.GetJwtSecret(),
+		AccessTokenDuration:  accessExpiry,
+		RefreshTokenDuration: refreshExpiry,
+	})
+}
+
+// NewHealthService 创建健康检查服务,job Redis 和 MongoDB 只在配置了地址时检查
+func NewHealthService(c *conf.Bootstrap, db *gorm.DB, rdb *goredis.Client, geoip *geoip2.Reader, store storage.Storage) (*health.HealthService, func()) {
+	checkers := []health.HealthChecker{
+		health.NewChecker("mysql", health.NewDatabaseHealthChecker(db).Check),
+		health.NewChecker("redis", health.NewRedisHealthChecker(rdb).Check),
+		health.NewGeoIPHealthChecker(geoip),
+	}
+	if p, ok := store.(health.Pinger); ok {
+		checkers = append(checkers, health.NewStorageHealthChecker(p))
+	}
+
+	var closers []func()
+	if jc := c.Data.GetJobRedis(); jc.GetAddr() != "" {
+		jobRdb := goredis.NewClient(&goredis.Options{
+			Addr:     jc.GetAddr(),
+			Username: jc.GetUsername(),
+			Password: jc.GetPassword(),
+			DB:       int(jc.GetDb()),
+		})
+		closers = append(closers, func() { _ = jobRdb.Close() })
+		checkers = append(checkers, health.NewChecker("job_redis", health.NewRedisHealthChecker(jobRdb).Check))
+	}
+	if mc := c.Data.GetMongo(); mc.GetAddr() != "" {
+		opts := mongooptions.Client().ApplyURI(mc.GetAddr())
+		if mc.GetUsername() != "" {
+			opts.SetAuth(mongooptions.Credential{Username: mc.GetUsername(), Password: mc.GetPassword()})
+		}
+		// Connect 不会建立连接,MongoDB 不可用时只影响就绪检查;创建客户端失败时就绪检查始终失败
+		if cli, err := mongo.Connect(context.Background(), opts); err != nil {
+			log.Errorf("failed to create mongo client for health check: %v", err)
+			checkers = append(checkers, health.NewChecker("mongo", func(context.Context) error {
+				return fmt.Errorf("mongo client: %w", err)
+			}))
+		} else {
+			closers = append(closers, func() { _ = cli.Disconnect(context.Background()) })
+			checkers = append(checkers, health.NewMongoHealthChecker(cli))
+		}
+	}
+
+	return health.NewHealthService(logger.DefaultLogger, checkers...), func() {
+		for _, closeFn := range closers {
+			closeFn()
+		}
 	}
 }
 
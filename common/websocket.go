@@ -8,7 +8,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
+	"github.com/ydssx/kratos-kit/pkg/metrics"
+	"github.com/ydssx/kratos-kit/pkg/session"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/gorilla/websocket"
@@ -26,15 +29,19 @@ var newline = []byte{'\n'}
 
 type WsService struct {
 	logger   *log.Helper
+	jm       *jwt.Manager
+	sessions session.Store
 	upgrader websocket.Upgrader
 	conns    sync.Map
 	send     chan []byte
 	stop     chan struct{}
 }
 
-func NewWsService(ctx context.Context, logger log.Logger) *WsService {
+func NewWsService(ctx context.Context, logger log.Logger, jm *jwt.Manager, sessions session.Store) *WsService {
 	ws := &WsService{
-		logger: log.NewHelper(logger),
+		logger:   log.NewHelper(logger),
+		jm:       jm,
+		sessions: sessions,
 		upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
 				return true // 注意：在生产环境中应该进行适当的源检查
@@ -50,11 +57,14 @@ func NewWsService(ctx context.Context, logger log.Logger) *WsService {
 }
 
 func (s *WsService) AddConn(id string, conn *websocket.Conn) {
-	s.conns.Store(id, conn)
+	if _, loaded := s.conns.Swap(id, conn); !loaded {
+		metrics.WebSocketConnections.Inc()
+	}
 }
 
 func (s *WsService) RemoveConn(id string) {
 	if conn, ok := s.conns.LoadAndDelete(id); ok {
+		metrics.WebSocketConnections.Dec()
 		conn.(*websocket.Conn).Close()
 	}
 }
@@ -99,7 +109,7 @@ func (s *WsService) NotifyUser(userId string, messageType string, data interface
 }
 
 func (s *WsService) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
-	user, err := s.authenticateUser(r)
+	claims, err := s.authenticateUser(r)
 	if err != nil {
 		s.logger.Errorf("用户认证失败: %v", err)
 		http.Error(w, "未授权", http.StatusUnauthorized)
@@ -113,7 +123,7 @@ func (s *WsService) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 使用用户ID而不是UUID
-	userId := strconv.Itoa(int(user.ID))
+	userId := strconv.Itoa(int(claims.Uid))
 	defer s.RemoveConn(userId)
 
 	s.AddConn(userId, conn)
@@ -122,9 +132,10 @@ func (s *WsService) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	s.handleMessages(conn, userId)
 }
 
-func (s *WsService) authenticateUser(r *http.Request) (*models.User, error) {
+// authenticateUser 与 HTTP/gRPC 认证中间件一致,已退出或被撤销的会话不能建立连接
+func (s *WsService) authenticateUser(r *http.Request) (*jwt.Claims, error) {
 	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
-	return models.NewUserModel().SetUUIds(token).FirstOne()
+	return middleware.ValidateToken(r.Context(), s.jm, s.sessions, token)
 }
 
 func (s *WsService) configureConnection(conn *websocket.Conn) {
@@ -111,5 +111,62 @@ const (
     </div>
 </body>
 </html>
+`
+
+	EmailResetPasswordTemplate = `
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Reset Password</title>
+    <style>
+        body {
+            font-family: Arial, sans-serif;
+            line-height: 1.6;
+            color: #333;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            padding: 20px;
+            border: 1px solid #ddd;
+        }
+        .logo {
+            text-align: center;
+            margin-bottom: 20px;
+        }
+        .reset-button {
+            display: block;
+            width: 200px;
+            margin: 20px auto;
+            padding: 10px;
+            background-color: #333;
+            color: #fff;
+            text-align: center;
+            text-decoration: none;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="logo">
+            <img src="http://www.shortplay.fun/favicon.svg" alt="logo" style="max-width: 200px;">
+        </div>
+        
+        <h2>Reset your kiwishort.com password</h2>
+        
+        <p>We received a request to reset the password for your account. Click the button below to choose a new password:</p>
+        
+        <a class="reset-button" href="{{.ResetURL}}">Reset Password</a>
+        
+        <p>This link can only be used once and will expire in {{.ExpireMinutes}} minutes.</p>
+        
+        <p>If you did not initiate this request: Please ignore this email, your password will not be changed. Or contact our customer service team for assistance.</p>
+        
+        <p>Thank you for your trust in our services.</p>
+    </div>
+</body>
+</html>
 `
 )
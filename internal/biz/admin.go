@@ -6,6 +6,7 @@ import (
 
 	"github.com/ydssx/kratos-kit/models"
 	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/session"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +15,9 @@ type AdminUseCase struct {
 	tx       Transaction
 	commonUc *CommonUseCase
 	userRepo UserRepo
+	userUc   *UserUseCase
+	rbacUc   *RBACUseCase
+	sessions session.Store
 }
 
 type adminUseCaseKey struct{}
@@ -26,8 +30,8 @@ func WithAdminUseCase(ctx context.Context, uc *AdminUseCase) context.Context {
 	return context.WithValue(ctx, adminUseCaseKey{}, uc)
 }
 
-func NewAdminUseCase(commonUc *CommonUseCase, userRepo UserRepo, tx Transaction) *AdminUseCase {
-	return &AdminUseCase{commonUc: commonUc, userRepo: userRepo, tx: tx}
+func NewAdminUseCase(commonUc *CommonUseCase, userRepo UserRepo, tx Transaction, userUc *UserUseCase, rbacUc *RBACUseCase, sessions session.Store) *AdminUseCase {
+	return &AdminUseCase{commonUc: commonUc, userRepo: userRepo, tx: tx, userUc: userUc, rbacUc: rbacUc, sessions: sessions}
 }
 
 func (uc *AdminUseCase) UploadFile(ctx *gin.Context, userID int, file *multipart.FileHeader) (result *UploadResult, err error) {
@@ -41,6 +45,7 @@ func (uc *AdminUseCase) UploadFile(ctx *gin.Context, userID int, file *multipart
 	result.FileId = int(fileInfo.ID)
 	result.FileUrl = fileInfo.FileUrl
 	result.ThumbnailURL = fileInfo.CoverUrl
+	result.Status = string(fileInfo.Status)
 	result.FileName = file.Filename
 
 	return
@@ -0,0 +1,291 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var errAdminUserNotFound = errors.NewUserError("user not found")
+
+// pageParams 规范化分页参数
+func pageParams(page, pageSize int32) (int, int) {
+	p, size := int(page), int(pageSize)
+	if p <= 0 {
+		p = 1
+	}
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	return p, min(size, maxPageSize)
+}
+
+// getUser 获取用户,不存在时返回用户错误
+func (uc *AdminUseCase) getUser(ctx context.Context, id uint64) (*models.User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errAdminUserNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	return user, nil
+}
+
+// ensureNotSelf 禁止管理员对自己执行操作
+func ensureNotSelf(ctx context.Context, id uint64) error {
+	if uint64(middleware.GetClaims(ctx).Uid) == id {
+		return errors.NewUserError("this operation cannot be performed on your own account")
+	}
+	return nil
+}
+
+// ListUsers 分页获取用户列表
+func (uc *AdminUseCase) ListUsers(ctx context.Context, req *adminv1.ListUsersRequest) (*adminv1.ListUsersResponse, error) {
+	page, pageSize := pageParams(req.Page, req.PageSize)
+	cond := &PageListUserCond{
+		EmailPrefix: req.Email,
+		CountryCode: req.CountryCode,
+		Page:        page,
+		PageSize:    pageSize,
+	}
+	if req.Type != nil {
+		t := models.UserType(*req.Type)
+		cond.Type = &t
+	}
+	if req.CreatedFrom != nil {
+		cond.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		cond.CreatedTo = req.CreatedTo.AsTime()
+	}
+
+	users, total, err := uc.userRepo.PageListUser(ctx, cond)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+
+	res := &adminv1.ListUsersResponse{Total: total}
+	for i := range users {
+		res.Users = append(res.Users, toAdminUser(&users[i]))
+	}
+	return res, nil
+}
+
+// GetUser 获取用户详情
+func (uc *AdminUseCase) GetUser(ctx context.Context, req *adminv1.GetUserRequest) (*adminv1.User, error) {
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toAdminUser(user), nil
+}
+
+// UpdateUser 更新用户信息,修改用户类型后该用户需重新登录
+func (uc *AdminUseCase) UpdateUser(ctx context.Context, req *adminv1.UpdateUserRequest) (*adminv1.User, error) {
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Username != nil {
+		updates["username"] = *req.Username
+	}
+	if req.Email != nil && *req.Email != user.Email {
+		exist, err := uc.userRepo.GetUserByEmail(ctx, *req.Email)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if exist != nil && exist.ID != user.ID {
+			return nil, errors.NewUserError("email is already in use")
+		}
+		updates["email"] = *req.Email
+	}
+	if req.FirstName != nil {
+		updates["first_name"] = *req.FirstName
+	}
+	if req.LastName != nil {
+		updates["last_name"] = *req.LastName
+	}
+	typeChanged := req.Type != nil && int(*req.Type) != user.Type
+	if typeChanged {
+		if err := ensureNotSelf(ctx, req.Id); err != nil {
+			return nil, err
+		}
+		updates["type"] = int(*req.Type)
+	}
+	if len(updates) == 0 {
+		return toAdminUser(user), nil
+	}
+
+	if err := uc.userRepo.UpdateUser(ctx, int(user.ID), updates); err != nil {
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+	middleware.RecordAuditChange(ctx, auditFields(userAuditSnapshot(user), updates), updates)
+	// 令牌中携带用户类型,类型变更后需下线所有设备使权限立即生效
+	if typeChanged {
+		uc.rbacUc.InvalidatePolicy(ctx, user.ID)
+		if err := uc.sessions.RevokeAll(ctx, int64(user.ID)); err != nil {
+			return nil, errors.Wrap(err, "failed to revoke sessions")
+		}
+	}
+
+	return uc.GetUser(ctx, &adminv1.GetUserRequest{Id: req.Id})
+}
+
+// BanUser 封禁用户并下线所有设备
+func (uc *AdminUseCase) BanUser(ctx context.Context, req *adminv1.BanUserRequest) (*emptypb.Empty, error) {
+	if err := ensureNotSelf(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"banned_at":  time.Now(),
+		"ban_reason": req.Reason,
+	}
+	if err := uc.userRepo.UpdateUser(ctx, int(user.ID), updates); err != nil {
+		return nil, errors.Wrap(err, "failed to ban user")
+	}
+	middleware.RecordAuditChange(ctx, auditFields(userAuditSnapshot(user), updates), updates)
+	if err := uc.sessions.RevokeAll(ctx, int64(user.ID)); err != nil {
+		return nil, errors.Wrap(err, "failed to revoke sessions")
+	}
+	return new(emptypb.Empty), nil
+}
+
+// UnbanUser 解除封禁
+func (uc *AdminUseCase) UnbanUser(ctx context.Context, req *adminv1.UnbanUserRequest) (*emptypb.Empty, error) {
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsBanned() {
+		return new(emptypb.Empty), nil
+	}
+
+	updates := map[string]interface{}{
+		"banned_at":  nil,
+		"ban_reason": "",
+	}
+	if err := uc.userRepo.UpdateUser(ctx, int(user.ID), updates); err != nil {
+		return nil, errors.Wrap(err, "failed to unban user")
+	}
+	middleware.RecordAuditChange(ctx, auditFields(userAuditSnapshot(user), updates), updates)
+	return new(emptypb.Empty), nil
+}
+
+// DeleteUser 软删除用户并下线所有设备
+func (uc *AdminUseCase) DeleteUser(ctx context.Context, req *adminv1.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := ensureNotSelf(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.DeleteUser(ctx, user.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete user")
+	}
+	middleware.RecordAuditChange(ctx, userAuditSnapshot(user), nil)
+	uc.rbacUc.InvalidatePolicy(ctx, user.ID)
+	if err := uc.sessions.RevokeAll(ctx, int64(user.ID)); err != nil {
+		return nil, errors.Wrap(err, "failed to revoke sessions")
+	}
+	return new(emptypb.Empty), nil
+}
+
+// ImpersonateUser 以用户身份登录,不能模拟管理员和已封禁的用户
+func (uc *AdminUseCase) ImpersonateUser(ctx context.Context, req *adminv1.ImpersonateUserRequest) (*adminv1.ImpersonateUserResponse, error) {
+	if err := ensureNotSelf(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if models.UserType(user.Type) == models.UserTypeAdmin {
+		return nil, errors.NewUserError("cannot impersonate an admin account")
+	}
+	if user.IsBanned() {
+		return nil, errors.ErrUserBanned
+	}
+
+	adminID := uint(middleware.GetClaims(ctx).Uid)
+	token, err := uc.userUc.impersonate(ctx, user, adminID)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "admin %d impersonated user %d, reason: %s", adminID, user.ID, req.Reason)
+
+	return &adminv1.ImpersonateUserResponse{
+		AccessToken: token.AccessToken,
+		ExpiresIn:   token.ExpiresIn,
+	}, nil
+}
+
+func toAdminUser(u *models.User) *adminv1.User {
+	res := &adminv1.User{
+		Id:          uint64(u.ID),
+		Uuid:        u.UUID,
+		Username:    u.Username,
+		Email:       u.Email,
+		Type:        int32(u.Type),
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		AvatarPath:  u.AvatarPath,
+		Platform:    int32(u.Platform),
+		IpAddress:   u.IPAddress,
+		CountryCode: u.CountryCode,
+		CountryName: u.CountryName,
+		Banned:      u.IsBanned(),
+		BanReason:   u.BanReason,
+		CreatedAt:   timestamppb.New(u.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(u.UpdatedAt.Time),
+	}
+	if u.BannedAt != nil {
+		res.BannedAt = timestamppb.New(*u.BannedAt)
+	}
+	return res
+}
+
+// userAuditSnapshot 审计日志中记录的用户字段
+func userAuditSnapshot(u *models.User) map[string]interface{} {
+	return map[string]interface{}{
+		"username":   u.Username,
+		"email":      u.Email,
+		"first_name": u.FirstName,
+		"last_name":  u.LastName,
+		"type":       u.Type,
+		"banned_at":  u.BannedAt,
+		"ban_reason": u.BanReason,
+	}
+}
+
+// auditFields 从快照中取出本次修改的字段,作为修改前的数据
+func auditFields(snapshot, updates map[string]interface{}) map[string]interface{} {
+	before := make(map[string]interface{}, len(updates))
+	for k := range updates {
+		before[k] = snapshot[k]
+	}
+	return before
+}
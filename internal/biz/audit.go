@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditQueueSize     = 1024
+	auditBatchSize     = 100
+	auditFlushInterval = time.Second
+	auditWriteTimeout  = 5 * time.Second
+
+	// auditExportBatch 导出时每批读取的条数
+	auditExportBatch = 1000
+	// auditExportLimit 单次导出的最大条数
+	auditExportLimit = 100000
+)
+
+var _ middleware.AuditRecorder = (*AuditUseCase)(nil)
+
+// AuditUseCase 管理员操作审计日志,审计日志异步批量写入
+type AuditUseCase struct {
+	repo   AuditRepo
+	rbacUc *RBACUseCase
+	log    *log.Helper
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan *models.AdminAuditLog
+	done   chan struct{}
+}
+
+// NewAuditUseCase 创建审计用例并启动后台写入协程,cleanup 会写入队列中剩余的日志
+func NewAuditUseCase(repo AuditRepo, rbacUc *RBACUseCase, logger log.Logger) (*AuditUseCase, func()) {
+	uc := &AuditUseCase{
+		repo:   repo,
+		rbacUc: rbacUc,
+		log:    log.NewHelper(logger),
+		queue:  make(chan *models.AdminAuditLog, auditQueueSize),
+		done:   make(chan struct{}),
+	}
+	go uc.run()
+	return uc, uc.close
+}
+
+// Record 将审计日志放入写入队列,队列已满时丢弃并记录错误日志,实现 middleware.AuditRecorder
+func (uc *AuditUseCase) Record(ctx context.Context, e *middleware.AuditEntry) {
+	entry := &models.AdminAuditLog{
+		ActorId:   e.ActorID,
+		Operation: e.Operation,
+		Target:    e.Target,
+		Request:   e.Request,
+		Before:    e.Before,
+		After:     e.After,
+		ClientIp:  e.ClientIP,
+		TraceId:   e.TraceID,
+		Result:    e.Result,
+		Reason:    e.Reason,
+		LatencyMs: e.Latency.Milliseconds(),
+		CreatedAt: e.CreatedAt,
+	}
+
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+	if uc.closed {
+		uc.log.WithContext(ctx).Errorf("audit log dropped after shutdown: %+v", entry)
+		return
+	}
+	select {
+	case uc.queue <- entry:
+	default:
+		uc.log.WithContext(ctx).Errorf("audit queue is full, audit log dropped: %+v", entry)
+	}
+}
+
+// run 批量写入审计日志,达到批量大小或定时刷新
+func (uc *AuditUseCase) run() {
+	defer close(uc.done)
+
+	ticker := time.NewTicker(auditFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*models.AdminAuditLog, 0, auditBatchSize)
+	for {
+		select {
+		case e, ok := <-uc.queue:
+			if !ok {
+				uc.flush(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= auditBatchSize {
+				uc.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			uc.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (uc *AuditUseCase) flush(batch []*models.AdminAuditLog) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+	defer cancel()
+	if err := uc.repo.BatchCreateAuditLogs(ctx, batch); err != nil {
+		uc.log.Errorf("write %d audit logs failed: %v", len(batch), err)
+	}
+}
+
+// close 停止接收新日志并等待队列写完
+func (uc *AuditUseCase) close() {
+	uc.mu.Lock()
+	if uc.closed {
+		uc.mu.Unlock()
+		return
+	}
+	uc.closed = true
+	close(uc.queue)
+	uc.mu.Unlock()
+	<-uc.done
+}
+
+func auditLogCond(req *adminv1.ListAuditLogsRequest) *AuditLogCond {
+	page, pageSize := pageParams(req.Page, req.PageSize)
+	cond := &AuditLogCond{
+		ActorID:   uint(req.ActorId),
+		Operation: req.Operation,
+		Target:    req.Target,
+		Result:    req.Result,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	if req.CreatedFrom != nil {
+		cond.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		cond.CreatedTo = req.CreatedTo.AsTime()
+	}
+	return cond
+}
+
+// ListAuditLogs 分页获取审计日志
+func (uc *AuditUseCase) ListAuditLogs(ctx context.Context, req *adminv1.ListAuditLogsRequest) (*adminv1.ListAuditLogsResponse, error) {
+	logs, total, err := uc.repo.PageListAuditLogs(ctx, auditLogCond(req))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list audit logs")
+	}
+
+	res := &adminv1.ListAuditLogsResponse{Total: total}
+	for i := range logs {
+		l := &logs[i]
+		res.Logs = append(res.Logs, &adminv1.AuditLog{
+			Id:        uint64(l.ID),
+			ActorId:   uint64(l.ActorId),
+			Operation: l.Operation,
+			Target:    l.Target,
+			Request:   l.Request,
+			ClientIp:  l.ClientIp,
+			TraceId:   l.TraceId,
+			Result:    l.Result,
+			Reason:    l.Reason,
+			LatencyMs: l.LatencyMs,
+			CreatedAt: timestamppb.New(l.CreatedAt),
+			Before:    l.Before,
+			After:     l.After,
+		})
+	}
+	return res, nil
+}
+
+// ExportAuditLogs 按条件导出审计日志为CSV,忽略分页参数,最多导出 auditExportLimit 条
+func (uc *AuditUseCase) ExportAuditLogs(ctx context.Context, req *adminv1.ListAuditLogsRequest, out io.Writer) error {
+	if err := uc.rbacUc.CheckPermission(ctx, PermAuditLogExport); err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(out)
+	header := []string{"id", "created_at", "actor_id", "operation", "target", "result", "reason", "client_ip", "trace_id", "latency_ms", "request", "before", "after"}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	cond := auditLogCond(req)
+	var beforeID uint
+	for exported := 0; exported < auditExportLimit; {
+		logs, err := uc.repo.ListAuditLogsBefore(ctx, cond, beforeID, min(auditExportBatch, auditExportLimit-exported))
+		if err != nil {
+			return errors.Wrap(err, "failed to list audit logs")
+		}
+		for i := range logs {
+			l := &logs[i]
+			err := w.Write([]string{
+				strconv.FormatUint(uint64(l.ID), 10),
+				l.CreatedAt.UTC().Format(time.RFC3339),
+				strconv.FormatUint(uint64(l.ActorId), 10),
+				l.Operation,
+				csvSafe(l.Target),
+				l.Result,
+				csvSafe(l.Reason),
+				l.ClientIp,
+				l.TraceId,
+				strconv.FormatInt(l.LatencyMs, 10),
+				csvSafe(l.Request),
+				csvSafe(l.Before),
+				csvSafe(l.After),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+		if len(logs) < auditExportBatch {
+			break
+		}
+		exported += len(logs)
+		beforeID = logs[len(logs)-1].ID
+	}
+	return nil
+}
+
+// csvSafe 防止单元格内容被表格软件当作公式执行
+func csvSafe(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -2,44 +2,68 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydssx/kratos-kit/common"
 	"github.com/ydssx/kratos-kit/models"
 	"github.com/ydssx/kratos-kit/pkg/lock"
-	"github.com/ydssx/kratos-kit/pkg/storage"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+	"github.com/ydssx/kratos-kit/pkg/session"
 
 	"github.com/google/wire"
 )
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
-	common.NewGoogleCloudStorage,
-	common.InitGoogleOAuth,
+	common.NewStorage,
+	common.NewQueueClient,
+	common.NewFileScanner,
+	common.NewOAuthRegistry,
 	common.NewEmail,
 	common.NewWsService,
-	wire.Bind(new(storage.Storage), new(*storage.GoogleCloudStorage)),
 	common.NewRedisLocker,
 	wire.Bind(new(lock.Locker), new(*lock.RedisLocker)),
 	common.NewGeoipDB,
+	common.NewJWTManager,
+	common.NewSessionStore,
+	common.NewPasswordHasher,
+	wire.Bind(new(session.Store), new(*session.RedisStore)),
 	NewUsecaseSet,
 	NewUserUseCase,
 	NewUploadUseCase,
 	NewCommonUseCase,
 	NewAdminUseCase,
+	NewLoginGuard,
+	NewRBACUseCase,
+	NewAuditUseCase,
+	NewFileUseCase,
+	NewMediaUseCase,
+	NewFileInspector,
+	NewJobUseCase,
+	NewCronUseCase,
 )
 
 type UsecaseSet struct {
 	UserBiz   *UserUseCase
 	UploadBiz *UploadUseCase
+	FileBiz   *FileUseCase
+	MediaBiz  *MediaUseCase
+	CronBiz   *CronUseCase
 }
 
 func NewUsecaseSet(
 	userBiz *UserUseCase,
 	uploadBiz *UploadUseCase,
+	fileBiz *FileUseCase,
+	mediaBiz *MediaUseCase,
+	cronBiz *CronUseCase,
 ) *UsecaseSet {
 	return &UsecaseSet{
 		UserBiz:   userBiz,
 		UploadBiz: uploadBiz,
+		FileBiz:   fileBiz,
+		MediaBiz:  mediaBiz,
+		CronBiz:   cronBiz,
 	}
 }
 
@@ -92,8 +116,162 @@ type (
 		GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 		// GetUserByBrowserFingerprint 根据指纹获取用户
 		GetUserByBrowserFingerprint(ctx context.Context, fingerprint string) (*models.User, error)
-		// GetUserByGoogleID 根据Google ID获取用户
-		GetUserByGoogleID(ctx context.Context, googleID string) (*models.User, error)
+		// PageListUser 分页获取用户列表
+		PageListUser(ctx context.Context, cond *PageListUserCond) ([]models.User, int64, error)
+		// DeleteUser 删除用户(软删除)
+		DeleteUser(ctx context.Context, id uint) error
+		// RecordLogin 记录用户登录日志,同一用户每天只记录一条
+		RecordLogin(ctx context.Context, loginLog *models.UserLoginLog) error
+	}
+	// PasswordResetRepo 重置密码令牌存储,只保存令牌哈希
+	PasswordResetRepo interface {
+		// SaveResetToken 保存令牌哈希,同一用户之前申请的令牌随之失效
+		SaveResetToken(ctx context.Context, userID uint, tokenHash string, ttl time.Duration) error
+		// ConsumeResetToken 取出并删除令牌,令牌只能使用一次
+		ConsumeResetToken(ctx context.Context, tokenHash string) (userID uint, err error)
+	}
+	// TOTPRepo 两步验证配置及恢复码存储
+	TOTPRepo interface {
+		// GetTOTP 获取用户的TOTP配置,不存在时返回 gorm.ErrRecordNotFound
+		GetTOTP(ctx context.Context, userID uint) (*models.UserTotp, error)
+		// SaveTOTPSecret 保存待确认的加密密钥,已启用的配置不会被覆盖
+		SaveTOTPSecret(ctx context.Context, userID uint, encryptedSecret string) error
+		// EnableTOTP 启用两步验证并替换全部恢复码
+		EnableTOTP(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string) error
+		// UseTOTPStep 记录已使用的时间步,时间步不大于上次使用的时间步时返回false
+		UseTOTPStep(ctx context.Context, userID uint, step int64) (bool, error)
+		// UseRecoveryCode 使用恢复码,恢复码不存在或已使用时返回false
+		UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error)
+	}
+	// OAuthState 第三方登录授权请求上下文,以state为键保存,回调时取出校验
+	OAuthState struct {
+		Provider     string `json:"provider"`
+		CodeVerifier string `json:"code_verifier"` // PKCE code_verifier
+		Nonce        string `json:"nonce"`
+		LinkUserID   uint   `json:"link_user_id"` // 不为0时表示为该用户关联第三方账号
+	}
+	// OAuthStateRepo 第三方登录state存储
+	OAuthStateRepo interface {
+		// SaveState 保存state
+		SaveState(ctx context.Context, state string, s *OAuthState, ttl time.Duration) error
+		// ConsumeState 取出并删除state,state只能使用一次
+		ConsumeState(ctx context.Context, state string) (*OAuthState, error)
+	}
+	// IdentityRepo 第三方账号关联存储
+	IdentityRepo interface {
+		// GetIdentity 获取第三方账号关联,不存在时返回 gorm.ErrRecordNotFound
+		GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+		// ListIdentities 获取用户关联的第三方账号
+		ListIdentities(ctx context.Context, userID uint) ([]models.UserIdentity, error)
+		// CreateIdentity 创建第三方账号关联
+		CreateIdentity(ctx context.Context, identity *models.UserIdentity) error
+		// DeleteIdentity 解除用户与提供方的关联,未关联时返回false
+		DeleteIdentity(ctx context.Context, userID uint, provider string) (bool, error)
+	}
+	// RBACRepo 角色及权限存储
+	RBACRepo interface {
+		// ListUserRoles 获取用户被分配的角色
+		ListUserRoles(ctx context.Context, userID uint) ([]models.Role, error)
+		// ListRoleGrants 获取角色授予的权限
+		ListRoleGrants(ctx context.Context, roles []string) ([]models.RoleGrant, error)
+		// ListRoleUserIDs 获取被分配了角色的用户
+		ListRoleUserIDs(ctx context.Context, role string) ([]uint, error)
+		// AssignRole 为用户分配角色,角色不存在时返回 gorm.ErrRecordNotFound
+		AssignRole(ctx context.Context, userID uint, role string) error
+		// RevokeRole 撤销用户的角色,未分配时返回false
+		RevokeRole(ctx context.Context, userID uint, role string) (bool, error)
+		// GrantPermission 为角色授予权限,权限不存在时自动创建
+		GrantPermission(ctx context.Context, role string, p rbac.Permission) error
+		// RevokePermission 撤销角色的权限,未授予时返回false
+		RevokePermission(ctx context.Context, role string, p rbac.Permission) (bool, error)
+	}
+	// AuditRepo 管理员操作审计日志存储,只追加不修改
+	AuditRepo interface {
+		// BatchCreateAuditLogs 批量写入审计日志
+		BatchCreateAuditLogs(ctx context.Context, logs []*models.AdminAuditLog) error
+		// PageListAuditLogs 分页获取审计日志,按时间倒序
+		PageListAuditLogs(ctx context.Context, cond *AuditLogCond) ([]models.AdminAuditLog, int64, error)
+		// ListAuditLogsBefore 获取ID小于beforeID的审计日志,按ID倒序,beforeID为0时不限制,用于分批导出
+		ListAuditLogsBefore(ctx context.Context, cond *AuditLogCond, beforeID uint, limit int) ([]models.AdminAuditLog, error)
+	}
+	// FileRepo 用户上传文件记录存储
+	FileRepo interface {
+		// GetUserFileByMd5 获取用户上传过的相同文件,不存在时返回 gorm.ErrRecordNotFound
+		GetUserFileByMd5(ctx context.Context, userID int, md5 string) (*models.FileMetadata, error)
+		// CreateFile 创建文件记录
+		CreateFile(ctx context.Context, file *models.FileMetadata) error
+		// GetFile 获取文件记录,不存在时返回 gorm.ErrRecordNotFound
+		GetFile(ctx context.Context, id int64) (*models.FileMetadata, error)
+		// UpdateFile 按 fields 更新文件记录的指定字段
+		UpdateFile(ctx context.Context, file *models.FileMetadata, fields ...string) error
+		// PageListFiles 分页获取文件记录,按ID倒序
+		PageListFiles(ctx context.Context, cond *FileCond) ([]models.FileMetadata, int64, error)
+		// DeleteFile 删除文件记录
+		DeleteFile(ctx context.Context, id int64) error
+		// CountFilesByFilename 获取引用同一对象的文件记录数
+		CountFilesByFilename(ctx context.Context, filename string) (int64, error)
+		// SumUserFileSize 获取用户已上传文件的总大小
+		SumUserFileSize(ctx context.Context, userID int) (int64, error)
+		// LockUserQuota 在事务中锁定用户记录,同一用户的配额校验和文件创建串行执行
+		LockUserQuota(ctx context.Context, userID int) error
+	}
+	// UploadSessionRepo 分片直传会话存储
+	UploadSessionRepo interface {
+		// CreateUploadSession 创建上传会话
+		CreateUploadSession(ctx context.Context, s *models.UploadSession) error
+		// GetUploadSession 获取上传会话,不存在时返回 gorm.ErrRecordNotFound
+		GetUploadSession(ctx context.Context, uploadID string) (*models.UploadSession, error)
+		// FindPendingUploadSession 获取用户上传相同文件且未过期的会话,用于断点续传,不存在时返回 gorm.ErrRecordNotFound
+		FindPendingUploadSession(ctx context.Context, userID int, md5 string, size int64) (*models.UploadSession, error)
+		// UpdateUploadSessionStatus 将状态为 from 的会话更新为 to,会话已不是 from 状态时返回false
+		UpdateUploadSessionStatus(ctx context.Context, id uint, from, to string) (bool, error)
+		// DeleteUploadSession 删除上传会话
+		DeleteUploadSession(ctx context.Context, id uint) error
+		// ListExpiredUploadSessions 获取过期时间不晚于 now 的会话
+		ListExpiredUploadSessions(ctx context.Context, now time.Time, limit int) ([]models.UploadSession, error)
+	}
+	// CronJobRepo 定时任务及其执行记录存储
+	CronJobRepo interface {
+		// ListEnabledCronJobs 获取所有启用的定时任务
+		ListEnabledCronJobs(ctx context.Context) ([]models.CronJob, error)
+		// PageListCronJobs 分页获取定时任务,按ID正序
+		PageListCronJobs(ctx context.Context, page, pageSize int) ([]models.CronJob, int64, error)
+		// GetCronJob 获取定时任务,不存在时返回 gorm.ErrRecordNotFound
+		GetCronJob(ctx context.Context, id uint) (*models.CronJob, error)
+		// CreateCronJob 创建定时任务
+		CreateCronJob(ctx context.Context, job *models.CronJob) error
+		// UpdateCronJob 按 fields 更新定时任务的指定字段
+		UpdateCronJob(ctx context.Context, job *models.CronJob, fields ...string) error
+		// CountCronJobsByType 获取任务类型的定时任务数
+		CountCronJobsByType(ctx context.Context, jobType string) (int64, error)
+		// CreateCronJobRun 创建执行记录
+		CreateCronJobRun(ctx context.Context, run *models.CronJobRun) error
+		// UpdateCronJobRun 按 fields 更新执行记录的指定字段
+		UpdateCronJobRun(ctx context.Context, run *models.CronJobRun, fields ...string) error
+		// ListLatestCronJobRuns 获取每个定时任务最近一次的执行记录
+		ListLatestCronJobRuns(ctx context.Context, cronJobIDs ...uint) ([]models.CronJobRun, error)
+		// NotifyCronJobsChanged 通知所有任务服务器定时任务已变更
+		NotifyCronJobsChanged(ctx context.Context) error
+		// WatchCronJobsChanged 订阅定时任务变更通知,ctx 结束后关闭返回的 channel
+		WatchCronJobsChanged(ctx context.Context) <-chan struct{}
+	}
+	// AuditLogCond 审计日志查询条件
+	AuditLogCond struct {
+		ActorID     uint
+		Operation   string
+		Target      string
+		Result      string
+		CreatedFrom time.Time // 为零值时不限制
+		CreatedTo   time.Time // 为零值时不限制
+		Page        int
+		PageSize    int
+	}
+	// FileCond 文件查询条件
+	FileCond struct {
+		UserID   int
+		FileType models.FileType // 为空时不限制
+		Page     int
+		PageSize int
 	}
 	// ListUserCond 获取用户列表条件
 	ListUserCond struct {
@@ -101,4 +279,14 @@ type (
 		// 积分数大于
 		PointsGt int64
 	}
+	// PageListUserCond 分页获取用户列表条件
+	PageListUserCond struct {
+		Type        *models.UserType
+		EmailPrefix string
+		CountryCode string
+		CreatedFrom time.Time // 为零值时不限制
+		CreatedTo   time.Time // 为零值时不限制
+		Page        int
+		PageSize    int
+	}
 )
@@ -4,15 +4,15 @@ package biz
 import (
 	"context"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"os"
+	"path"
 	"path/filepath"
-	"runtime"
-	"time"
+	"strings"
 
 	"github.com/ydssx/kratos-kit/models"
 	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/filescan"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/storage"
 	"github.com/ydssx/kratos-kit/pkg/util"
@@ -20,150 +20,184 @@ import (
 
 // CommonUseCase 通用业务逻辑, 用于业务上的公共方法
 type CommonUseCase struct {
-	tx       Transaction
-	store    storage.Storage
-	userRepo UserRepo
+	tx        Transaction
+	store     storage.Storage
+	userRepo  UserRepo
+	media     *MediaUseCase
+	fileUc    *FileUseCase
+	inspector *FileInspector
 }
 
 func NewCommonUseCase(
 	tx Transaction,
 	store storage.Storage,
 	userRepo UserRepo,
+	media *MediaUseCase,
+	fileUc *FileUseCase,
+	inspector *FileInspector,
 ) *CommonUseCase {
 	return &CommonUseCase{
-		tx:       tx,
-		store:    store,
-		userRepo: userRepo,
+		tx:        tx,
+		store:     store,
+		userRepo:  userRepo,
+		media:     media,
+		fileUc:    fileUc,
+		inspector: inspector,
 	}
 }
 
-// UploadFile 上传文件
+// UploadFile 上传文件,文件先保存到本地临时目录,计算MD5和上传到存储都从磁盘流式读取。
+// 保存原文件后立即返回,文件处于处理中状态,由 MediaUseCase 异步转码和生成缩略图
 func (uc *CommonUseCase) UploadFile(ctx context.Context, userID, userType int, file *multipart.FileHeader) (fileMetadata *models.FileMetadata, err error) {
-	// 打开上传的文件
-	src, err := file.Open()
+	uploadDir, err := os.MkdirTemp("", "uploads-")
 	if err != nil {
-		return nil, errors.Errorf("Failed to open file: %v", err)
+		return nil, errors.Wrap(err, "Failed to create upload directory")
 	}
-	defer src.Close()
+	defer os.RemoveAll(uploadDir) // 删除本地存储路径
 
-	contentType := file.Header.Get("Content-Type")
+	originalName := filepath.Base(file.Filename)
+	ext := filepath.Ext(originalName)
+	filePath := filepath.Join(uploadDir, "source"+ext)
+	if err := util.SaveUploadedFile(file, filePath); err != nil {
+		logger.Errorf(ctx, "Failed to save uploaded file: %v", err)
+		return nil, err
+	}
 
-	// 读取文件内容
-	fileBytes, err := io.ReadAll(src)
+	file_md5, err := md5File(filePath)
 	if err != nil {
 		return nil, errors.Errorf("Failed to read file: %v", err)
 	}
-
-	file_md5 := util.MD5Bytes(fileBytes)
 	fileInfo, err := models.NewFileMetadataModel().SetMd5(file_md5).SetUserId(int64(userID)).FirstOne()
 	if err == nil {
 		return &fileInfo, nil
 	}
+	if err := uc.fileUc.CheckQuota(ctx, userID, userType, file.Size); err != nil {
+		return nil, err
+	}
+
+	// 按文件内容校验类型并扫描,图片去除元数据,不使用客户端提供的 Content-Type
+	fileType := fileTypeOf(originalName)
+	contentType, err := uc.inspector.CheckFile(ctx, userID, fileType, filePath)
+	if err != nil {
+		return nil, err
+	}
 
+	// 对象名为 目录/MD5+扩展名,扩展名由识别出的内容类型决定,缩略图等衍生文件使用相同的前缀,便于一起清理。
+	// FileMd5 为上传内容的MD5,用于秒传,图片去除元数据后存储的内容会与之不同
+	key := path.Join(uploadFolder(userID, userType), file_md5+filescan.Extension(contentType))
 	fileMetadata = &models.FileMetadata{
 		UserId:   userID,
-		FileSize: len(fileBytes),
+		Filename: key,
+		Name:     originalName,
 		FileMd5:  file_md5,
-		FileType: models.FileTypeImage,
+		FileType: fileType,
 	}
 
-	if util.IsAudioFile(file.Filename) {
-		fileMetadata.FileType = models.FileTypeAudio
+	// 保存原文件到存储服务,不受请求取消影响,转码和缩略图由异步任务处理
+	fileURL, size, err := putLocalFile(context.WithoutCancel(ctx), uc.store, key, filePath, contentType)
+	if err != nil {
+		logger.Error(ctx, "Failed to save file to storage:", err)
+		return nil, err
 	}
+	fileMetadata.FileUrl = fileURL
+	fileMetadata.FileSize = int(size)
+	prepareMedia(fileMetadata)
 
-	// 构建文件名
-	originalName := filepath.Base(file.Filename)
-	ext := filepath.Ext(originalName)
-	storedName := time.Now().Format("20060102150405") + util.GenerateCode(2) + ext
-	var folder string
-	if userType == int(models.UserTypeNormal) {
-		folder = fmt.Sprintf("user_uploads/%d", userID)
-	} else {
-		folder = "system_uploads"
+	// 保存文件元数据到数据库,加锁后再次校验配额
+	err = uc.fileUc.InQuota(ctx, userID, userType, size, func(ctx context.Context) error {
+		return uc.fileUc.files.CreateFile(ctx, fileMetadata)
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to save file metadata to database:", err)
+		return nil, err
 	}
-	objectPath := fmt.Sprintf("%s/%s", folder, storedName)
-	fileMetadata.Filename = file_md5 + ext
 
-	// 创建本地存储路径
-	uploadDir := "D:\\uploads\\"
-	if runtime.GOOS == "linux" {
-		uploadDir = "/tmp/uploads/"
+	if err := uc.media.Submit(ctx, fileMetadata); err != nil {
+		logger.Errorf(ctx, "Failed to submit media processing: %v", err)
 	}
-	filePath := filepath.Join(uploadDir, storedName)
-	if err := util.SaveUploadedFile(file, filePath); err != nil {
-		logger.Errorf(ctx, "Failed to save uploaded file: %v", err)
-		return nil, err
+	return
+}
+
+// putLocalFile 将本地文件流式上传到存储,返回访问地址和文件大小
+func putLocalFile(ctx context.Context, store storage.Storage, key, filePath, contentType string) (string, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, err
 	}
-	defer os.RemoveAll(uploadDir) // 删除本地存储路径
+	defer f.Close()
 
-	if util.IsVideoFile(originalName) {
-		fileMetadata.FileType = models.FileTypeVideo
+	info, err := f.Stat()
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := store.Put(ctx, key, f, info.Size(), contentType); err != nil {
+		return "", 0, err
+	}
+	return store.URL(key), info.Size(), nil
+}
 
-		// 获取视频元数据
-		videoMetadata, err := util.GetVideoMetadata(filePath)
-		if err != nil {
-			logger.Error(ctx, "Failed to get video metadata:", err)
-			return nil, err
-		}
-		fileMetadata.Width = videoMetadata.Width
-		fileMetadata.Height = videoMetadata.Height
-		fileMetadata.Fps = videoMetadata.FPS
-		fileMetadata.VideoDuration = videoMetadata.Duration
-		fileMetadata.Encoding = videoMetadata.CodecName
-
-		// 如果不是H264视频，转码成H264
-		if !videoMetadata.IsH264 {
-			outputPath := filepath.Join(uploadDir, filepath.Base(filePath)+"_h264.mp4")
-			err := util.ConvertToH264(filePath, outputPath)
-			if err != nil {
-				logger.Error(ctx, "Failed to convert video to h264:", err)
-				return nil, err
-			}
-
-			fileBytes, err = os.ReadFile(outputPath)
-			if err != nil {
-				logger.Error(ctx, "Failed to read converted video:", err)
-				return nil, err
-			}
-			fileMetadata.FileSize = len(fileBytes)
-		}
+// downloadFile 将对象下载到本地文件
+func downloadFile(ctx context.Context, store storage.Storage, key, dst string) error {
+	r, err := store.Open(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to open source file")
+	}
+	defer r.Close()
 
-		// 生成视频缩略图
-		thumbnailPath, err := util.GenerateThumbnail(filePath)
-		if err != nil {
-			logger.Error(ctx, "Failed to generate thumbnail:", err)
-			return nil, err
-		}
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.ReadFrom(r); err != nil {
+		return errors.Wrap(err, "failed to download source file")
+	}
+	return nil
+}
 
-		// 保存缩略图到存储服务
-		thumbnailBytes, err := os.ReadFile(thumbnailPath)
-		if err != nil {
-			logger.Error(ctx, "Failed to read thumbnail:", err)
-			return nil, err
-		}
+// uploadFolder 上传文件在存储中的目录
+func uploadFolder(userID, userType int) string {
+	if userType == int(models.UserTypeNormal) {
+		return fmt.Sprintf("user_uploads/%d", userID)
+	}
+	return "system_uploads"
+}
 
-		thumbnailSavePath := fmt.Sprintf("%s/%s", folder, filepath.Base(thumbnailPath))
-		thumbnailURL, err := uc.store.SaveFile(context.Background(), folder, thumbnailSavePath, "", thumbnailBytes)
-		if err != nil {
-			logger.Error(ctx, "Failed to save thumbnail to storage:", err)
-			return nil, err
-		}
-		fileMetadata.CoverUrl = thumbnailURL
+// fileObjectKey 文件在存储中的对象名
+func fileObjectKey(file *models.FileMetadata) string {
+	// 早期记录只保存了文件名
+	if !strings.Contains(file.Filename, "/") {
+		return path.Join(uploadFolder(file.UserId, int(models.UserTypeNormal)), file.Filename)
 	}
-	// 保存文件到存储服务
-	fileURL, err := uc.store.SaveFile(context.Background(), folder, objectPath, contentType, fileBytes)
+	return file.Filename
+}
+
+// deleteFileObjects 删除对象及其封面、缩略图等衍生文件,衍生文件的对象名为 原对象名去掉扩展名+"_"+名称
+func deleteFileObjects(ctx context.Context, store storage.Storage, key string) bool {
+	base := strings.TrimSuffix(key, path.Ext(key))
+	objects, err := store.List(ctx, base)
 	if err != nil {
-		logger.Error(ctx, "Failed to save file to storage:", err)
-		return nil, err
+		logger.Errorf(ctx, "Failed to list files of %s: %s", key, err.Error())
+		return false
 	}
-	fileMetadata.FileUrl = fileURL
+	deleted := true
+	for _, obj := range objects {
+		if obj.Key != key && !strings.HasPrefix(obj.Key, base+"_") {
+			continue
+		}
+		if err := store.DeleteFile(ctx, obj.Key); err != nil && !errors.Is(err, storage.ErrNotExist) {
+			logger.Errorf(ctx, "Failed to delete file %s: %s", obj.Key, err.Error())
+			deleted = false
+		}
+	}
+	return deleted
+}
 
-	// 保存文件元数据到数据库
-	_, err = models.NewFileMetadataModel().Create(fileMetadata)
+func md5File(name string) (string, error) {
+	f, err := os.Open(name)
 	if err != nil {
-		logger.Error(ctx, "Failed to save file metadata to database:", err)
-		return nil, err
+		return "", err
 	}
-
-	return
+	defer f.Close()
+	return util.MD5Reader(f)
 }
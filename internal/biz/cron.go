@@ -0,0 +1,315 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/queue"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// cronRunErrorMaxLen 执行记录中失败原因的最大长度
+const cronRunErrorMaxLen = 1024
+
+var errCronJobNotFound = errors.NewUserError("cron job not found")
+
+// CronUseCase 定时任务管理,定时任务保存在数据库中,修改后通知任务服务器重新加载
+type CronUseCase struct {
+	repo  CronJobRepo
+	queue *queue.Client
+}
+
+func NewCronUseCase(repo CronJobRepo, queue *queue.Client) *CronUseCase {
+	return &CronUseCase{repo: repo, queue: queue}
+}
+
+// ListCronJobs 分页获取定时任务及其最近一次执行记录
+func (uc *CronUseCase) ListCronJobs(ctx context.Context, req *adminv1.ListCronJobsRequest) (*adminv1.ListCronJobsResponse, error) {
+	page, pageSize := pageParams(req.Page, req.PageSize)
+	jobs, total, err := uc.repo.PageListCronJobs(ctx, page, pageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cron jobs")
+	}
+
+	ids := make([]uint, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	lastRuns := map[uint]*models.CronJobRun{}
+	if len(ids) > 0 {
+		runs, err := uc.repo.ListLatestCronJobRuns(ctx, ids...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list cron job runs")
+		}
+		for i := range runs {
+			lastRuns[runs[i].CronJobId] = &runs[i]
+		}
+	}
+
+	res := &adminv1.ListCronJobsResponse{Total: total}
+	for i := range jobs {
+		res.CronJobs = append(res.CronJobs, toCronJobProto(&jobs[i], lastRuns[jobs[i].ID]))
+	}
+	return res, nil
+}
+
+// UpsertCronJob 创建或更新定时任务
+func (uc *CronUseCase) UpsertCronJob(ctx context.Context, req *adminv1.UpsertCronJobRequest) (*adminv1.CronJob, error) {
+	if err := queue.ParseCronSpec(req.Spec); err != nil {
+		return nil, errors.NewUserError("invalid cron spec: " + err.Error())
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, errors.NewUserError("invalid timezone: " + req.Timezone)
+	}
+	job := &models.CronJob{
+		JobType:     req.JobType.String(),
+		Spec:        req.Spec,
+		Payload:     req.Payload,
+		Timezone:    req.Timezone,
+		Enabled:     req.Enabled,
+		Description: req.Description,
+	}
+	if _, _, err := cronJobTask(job); err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		if err := uc.repo.CreateCronJob(ctx, job); err != nil {
+			return nil, errors.Wrap(err, "failed to create cron job")
+		}
+	} else {
+		old, err := uc.getCronJob(ctx, uint(req.Id))
+		if err != nil {
+			return nil, err
+		}
+		job.ID, job.CreatedAt, job.UpdatedAt = old.ID, old.CreatedAt, time.Now()
+		if err := uc.repo.UpdateCronJob(ctx, job, "job_type", "spec", "payload", "timezone", "enabled", "description", "updated_at"); err != nil {
+			return nil, errors.Wrap(err, "failed to update cron job")
+		}
+		middleware.RecordAuditChange(ctx, cronJobAuditSnapshot(old), cronJobAuditSnapshot(job))
+	}
+	uc.notify(ctx)
+	return toCronJobProto(job, nil), nil
+}
+
+// PauseCronJob 暂停或恢复定时任务
+func (uc *CronUseCase) PauseCronJob(ctx context.Context, req *adminv1.PauseCronJobRequest) (*emptypb.Empty, error) {
+	job, err := uc.getCronJob(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	before := job.Enabled
+	job.Enabled, job.UpdatedAt = !req.Paused, time.Now()
+	if err := uc.repo.UpdateCronJob(ctx, job, "enabled", "updated_at"); err != nil {
+		return nil, errors.Wrap(err, "failed to update cron job")
+	}
+	middleware.RecordAuditChange(ctx, map[string]interface{}{"enabled": before}, map[string]interface{}{"enabled": job.Enabled})
+	uc.notify(ctx)
+	return &emptypb.Empty{}, nil
+}
+
+// TriggerNow 立即投递一次定时任务,暂停的定时任务也可以执行
+func (uc *CronUseCase) TriggerNow(ctx context.Context, req *adminv1.TriggerNowRequest) (*adminv1.TriggerNowResponse, error) {
+	job, err := uc.getCronJob(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	def, payload, err := cronJobTask(job)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uc.queue.EnqueueTask(ctx, &queue.Task{
+		TypeName: def.Name(),
+		Payload:  payload,
+		Headers:  map[string]string{queue.HeaderCronID: strconv.FormatUint(uint64(job.ID), 10)},
+	}, def.Options()...)
+	if errors.Is(err, queue.ErrDuplicateTask) {
+		return nil, errors.NewUserError("the task is already queued")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &adminv1.TriggerNowResponse{TaskId: id}, nil
+}
+
+// CronEntries 加载启用的定时任务,无效的定时任务记录日志后跳过
+func (uc *CronUseCase) CronEntries(ctx context.Context) ([]queue.CronEntry, error) {
+	jobs, err := uc.repo.ListEnabledCronJobs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cron jobs")
+	}
+	entries := make([]queue.CronEntry, 0, len(jobs))
+	for i := range jobs {
+		job := &jobs[i]
+		def, payload, err := cronJobTask(job)
+		if err != nil {
+			logger.Errorf(ctx, "skip invalid cron job %d: %v", job.ID, err)
+			continue
+		}
+		loc, err := time.LoadLocation(job.Timezone)
+		if err != nil {
+			logger.Errorf(ctx, "skip cron job %d with invalid timezone %q", job.ID, job.Timezone)
+			continue
+		}
+		if job.Timezone == "" {
+			loc = nil
+		}
+		entries = append(entries, queue.CronEntry{
+			ID:       strconv.FormatUint(uint64(job.ID), 10),
+			Spec:     job.Spec,
+			Location: loc,
+			Def:      def,
+			Payload:  payload,
+		})
+	}
+	return entries, nil
+}
+
+// SeedCronJobs 为还没有定时任务的任务类型创建默认的定时任务
+func (uc *CronUseCase) SeedCronJobs(ctx context.Context, defaults []queue.CronEntry) error {
+	for _, e := range defaults {
+		n, err := uc.repo.CountCronJobsByType(ctx, e.Def.Name())
+		if err != nil {
+			return errors.Wrap(err, "failed to count cron jobs")
+		}
+		if n > 0 {
+			continue
+		}
+		job := &models.CronJob{JobType: e.Def.Name(), Spec: e.Spec, Enabled: true}
+		if e.Payload != nil {
+			data, err := protojson.Marshal(e.Payload)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal cron job payload")
+			}
+			job.Payload = string(data)
+		}
+		if err := uc.repo.CreateCronJob(ctx, job); err != nil {
+			return errors.Wrap(err, "failed to create cron job")
+		}
+	}
+	return nil
+}
+
+// WatchCronJobs 订阅定时任务变更通知
+func (uc *CronUseCase) WatchCronJobs(ctx context.Context) <-chan struct{} {
+	return uc.repo.WatchCronJobsChanged(ctx)
+}
+
+// StartCronRun 记录定时任务开始执行,记录失败时返回 nil
+func (uc *CronUseCase) StartCronRun(ctx context.Context, cronJobID uint, jobType, taskID string, attempt int) *models.CronJobRun {
+	run := &models.CronJobRun{
+		CronJobId: cronJobID,
+		JobType:   jobType,
+		TaskId:    taskID,
+		Attempt:   attempt,
+		Status:    models.CronRunRunning,
+		StartedAt: time.Now(),
+	}
+	if err := uc.repo.CreateCronJobRun(ctx, run); err != nil {
+		logger.Errorf(ctx, "failed to create cron job run: %v", err)
+		return nil
+	}
+	return run
+}
+
+// FinishCronRun 记录定时任务执行结果
+func (uc *CronUseCase) FinishCronRun(ctx context.Context, run *models.CronJobRun, runErr error) {
+	if run == nil {
+		return
+	}
+	now := time.Now()
+	run.FinishedAt = &now
+	run.Status = models.CronRunSuccess
+	if runErr != nil {
+		run.Status = models.CronRunFailure
+		run.Error = truncate(runErr.Error(), cronRunErrorMaxLen)
+	}
+	if err := uc.repo.UpdateCronJobRun(ctx, run, "status", "error", "finished_at"); err != nil {
+		logger.Errorf(ctx, "failed to update cron job run %d: %v", run.ID, err)
+	}
+}
+
+func (uc *CronUseCase) getCronJob(ctx context.Context, id uint) (*models.CronJob, error) {
+	job, err := uc.repo.GetCronJob(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errCronJobNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cron job")
+	}
+	return job, nil
+}
+
+// notify 通知任务服务器重新加载,失败时任务服务器会在下次定时加载时生效
+func (uc *CronUseCase) notify(ctx context.Context) {
+	if err := uc.repo.NotifyCronJobsChanged(ctx); err != nil {
+		logger.Warnf(ctx, "failed to notify cron job changes: %v", err)
+	}
+}
+
+// cronJobTask 获取定时任务的任务类型并解码任务参数
+func cronJobTask(job *models.CronJob) (queue.TaskDef, proto.Message, error) {
+	def, ok := taskTypes[job.JobType]
+	if !ok {
+		return nil, nil, errors.NewUserError("job type cannot be scheduled: " + job.JobType)
+	}
+	payload := def.NewPayload()
+	if job.Payload != "" {
+		if err := protojson.Unmarshal([]byte(job.Payload), payload); err != nil {
+			return nil, nil, errors.NewUserError("invalid payload: " + err.Error())
+		}
+	}
+	return def, payload, nil
+}
+
+// cronJobAuditSnapshot 审计日志中记录的定时任务字段
+func cronJobAuditSnapshot(job *models.CronJob) map[string]interface{} {
+	return map[string]interface{}{
+		"job_type":    job.JobType,
+		"spec":        job.Spec,
+		"payload":     job.Payload,
+		"timezone":    job.Timezone,
+		"enabled":     job.Enabled,
+		"description": job.Description,
+	}
+}
+
+func toCronJobProto(job *models.CronJob, lastRun *models.CronJobRun) *adminv1.CronJob {
+	res := &adminv1.CronJob{
+		Id:          uint64(job.ID),
+		JobType:     jobv1.JobType(jobv1.JobType_value[job.JobType]),
+		Spec:        job.Spec,
+		Payload:     job.Payload,
+		Timezone:    job.Timezone,
+		Enabled:     job.Enabled,
+		Description: job.Description,
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		UpdatedAt:   timestamppb.New(job.UpdatedAt),
+	}
+	if lastRun != nil {
+		res.LastRun = &adminv1.CronJobRun{
+			Id:        uint64(lastRun.ID),
+			TaskId:    lastRun.TaskId,
+			Attempt:   int32(lastRun.Attempt),
+			Status:    lastRun.Status,
+			Error:     lastRun.Error,
+			StartedAt: timestamppb.New(lastRun.StartedAt),
+		}
+		if lastRun.FinishedAt != nil {
+			res.LastRun.FinishedAt = timestamppb.New(*lastRun.FinishedAt)
+		}
+	}
+	return res
+}
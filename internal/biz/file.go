@@ -0,0 +1,556 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	filev1 "github.com/ydssx/kratos-kit/api/file/v1"
+	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/filescan"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/storage"
+	"github.com/ydssx/kratos-kit/pkg/util"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// minUploadPartSize 除最后一个分片外的最小分片大小,S3合并对象要求不小于5MB
+	minUploadPartSize = 5 << 20
+	// defaultUploadPartSize 默认分片大小
+	defaultUploadPartSize = 8 << 20
+	// defaultUploadMaxSize 默认单个文件最大字节数
+	defaultUploadMaxSize = 5 << 30
+	// defaultUploadSessionTTL 默认上传会话有效期
+	defaultUploadSessionTTL = 24 * time.Hour
+	// maxUploadParts 最大分片数,超过时增大分片大小
+	maxUploadParts = 10000
+	// uploadPartPrefix 分片在存储中的目录
+	uploadPartPrefix = "uploads"
+	// cleanUploadSessionBatch 每次清理的过期会话数
+	cleanUploadSessionBatch = 500
+)
+
+// FileUseCase 文件上传及管理
+type FileUseCase struct {
+	tx        Transaction
+	store     storage.Storage
+	files     FileRepo
+	sessions  UploadSessionRepo
+	media     *MediaUseCase
+	inspector *FileInspector
+
+	partSize   int64
+	maxSize    int64
+	sessionTTL time.Duration
+	userQuota  int64
+}
+
+func NewFileUseCase(c *conf.Bootstrap, tx Transaction, store storage.Storage, files FileRepo, sessions UploadSessionRepo, media *MediaUseCase, inspector *FileInspector) *FileUseCase {
+	uc := &FileUseCase{
+		tx:         tx,
+		store:      store,
+		files:      files,
+		sessions:   sessions,
+		media:      media,
+		inspector:  inspector,
+		partSize:   defaultUploadPartSize,
+		maxSize:    defaultUploadMaxSize,
+		sessionTTL: defaultUploadSessionTTL,
+	}
+	upload := c.GetStorage().GetUpload()
+	if upload.GetPartSize() > 0 {
+		uc.partSize = max(upload.GetPartSize(), minUploadPartSize)
+	}
+	if upload.GetMaxSize() > 0 {
+		uc.maxSize = upload.GetMaxSize()
+	}
+	if upload.GetSessionTtl().AsDuration() > 0 {
+		uc.sessionTTL = upload.GetSessionTtl().AsDuration()
+	}
+	uc.userQuota = upload.GetUserQuota()
+	return uc
+}
+
+// CreateUpload 创建分片上传会话,相同文件已上传过时直接返回文件,存在未过期的会话时复用以便断点续传
+func (uc *FileUseCase) CreateUpload(ctx context.Context, req *filev1.CreateUploadRequest) (*filev1.CreateUploadResponse, error) {
+	claims := middleware.GetClaims(ctx)
+	userID := int(claims.Uid)
+	if req.Size > uc.maxSize {
+		return nil, errors.NewUserError(fmt.Sprintf("file size exceeds %d bytes", uc.maxSize))
+	}
+
+	file, err := uc.files.GetUserFileByMd5(ctx, userID, req.Md5)
+	if err == nil {
+		return &filev1.CreateUploadResponse{File: toFileProto(file)}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(err, "failed to get file")
+	}
+	if err := uc.CheckQuota(ctx, userID, claims.Type, req.Size); err != nil {
+		return nil, err
+	}
+
+	s, err := uc.sessions.FindPendingUploadSession(ctx, userID, req.Md5, req.Size)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s, err = uc.newUploadSession(ctx, userID, claims.Type, req)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get upload session")
+	}
+
+	uploaded, err := uc.uploadedParts(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &filev1.CreateUploadResponse{
+		UploadId:  s.UploadId,
+		PartSize:  s.PartSize,
+		ExpiresAt: timestamppb.New(s.ExpiresAt),
+	}
+	for n := 1; n <= s.PartCount; n++ {
+		part := &filev1.UploadPart{PartNumber: int32(n), Size: partSize(s, n), Uploaded: uploaded[n]}
+		if !part.Uploaded {
+			part.Url, err = uc.store.SignedURL(ctx, http.MethodPut, partKey(s.UploadId, n), time.Until(s.ExpiresAt))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to sign upload url")
+			}
+		}
+		res.Parts = append(res.Parts, part)
+	}
+	return res, nil
+}
+
+func (uc *FileUseCase) newUploadSession(ctx context.Context, userID, userType int, req *filev1.CreateUploadRequest) (*models.UploadSession, error) {
+	size := max(uc.partSize, ceilDiv(req.Size, maxUploadParts))
+	s := &models.UploadSession{
+		UploadId:    uuid.NewString(),
+		UserId:      userID,
+		Filename:    filepath.Base(req.Filename),
+		ContentType: req.ContentType,
+		FileSize:    req.Size,
+		FileMd5:     req.Md5,
+		PartSize:    size,
+		PartCount:   int(ceilDiv(req.Size, size)),
+		ObjectKey:   path.Join(uploadFolder(userID, userType), req.Md5), // 扩展名在合并时按识别出的内容类型确定
+		Status:      models.UploadStatusPending,
+		ExpiresAt:   time.Now().Add(uc.sessionTTL),
+	}
+	if err := uc.sessions.CreateUploadSession(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CompleteUpload 校验分片后在存储中合并到临时对象,合并后的文件大小和MD5与创建会话时一致,
+// 且通过内容类型校验和扫描后才复制到最终对象并创建文件记录
+func (uc *FileUseCase) CompleteUpload(ctx context.Context, req *filev1.CompleteUploadRequest) (res *filev1.CompleteUploadResponse, err error) {
+	s, err := uc.pendingSession(ctx, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 合并前预先校验配额,避免无效的合并,创建文件记录时在事务中加锁再次校验
+	userType := middleware.GetClaims(ctx).Type
+	if err := uc.CheckQuota(ctx, s.UserId, userType, s.FileSize); err != nil {
+		return nil, err
+	}
+
+	uploaded, err := uc.uploadedParts(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, s.PartCount)
+	for n := 1; n <= s.PartCount; n++ {
+		if !uploaded[n] {
+			return nil, errors.NewUserError(fmt.Sprintf("part %d is missing or incomplete", n))
+		}
+		keys = append(keys, partKey(s.UploadId, n))
+	}
+
+	// 按第一个分片的文件头识别内容类型,不使用客户端提供的 Content-Type 和扩展名
+	fileType := fileTypeOf(s.Filename)
+	contentType, err := uc.inspector.SniffObject(ctx, fileType, keys[0])
+	if err != nil {
+		return nil, err
+	}
+	objectKey := objectKeyOf(s, contentType)
+
+	// 占用会话,并发完成同一会话时只有一个请求合并分片,失败时恢复为待上传以便重试
+	ok, err := uc.sessions.UpdateUploadSessionStatus(ctx, s.ID, models.UploadStatusPending, models.UploadStatusCompleting)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update upload session")
+	}
+	if !ok {
+		return nil, errors.NewUserError("upload is being completed")
+	}
+	// 合并到会话目录下的临时对象,最终对象可能是相同内容的已有文件,失败时只删除临时对象
+	tmpKey := mergedKey(s.UploadId, objectKey)
+	defer func() {
+		if err == nil {
+			return
+		}
+		ctx := context.WithoutCancel(ctx)
+		if derr := uc.store.DeleteFile(ctx, tmpKey); derr != nil && !errors.Is(derr, storage.ErrNotExist) {
+			logger.Errorf(ctx, "failed to delete rejected object %s: %v", tmpKey, derr)
+		}
+		if _, rerr := uc.sessions.UpdateUploadSessionStatus(ctx, s.ID, models.UploadStatusCompleting, models.UploadStatusPending); rerr != nil {
+			logger.Errorf(ctx, "failed to release upload session %s: %v", s.UploadId, rerr)
+		}
+	}()
+
+	if err := storage.Compose(ctx, uc.store, tmpKey, keys, contentType); err != nil {
+		return nil, errors.Wrap(err, "failed to compose upload parts")
+	}
+	if err := uc.verifyObject(ctx, s, tmpKey); err != nil {
+		return nil, err
+	}
+	// 扫描文件,图片去除元数据后覆盖原对象,大小会变化
+	size, err := uc.inspector.CheckObject(ctx, s.UserId, fileType, tmpKey, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.store.Copy(ctx, tmpKey, objectKey); err != nil {
+		return nil, errors.Wrap(err, "failed to copy uploaded file")
+	}
+
+	file := &models.FileMetadata{
+		UserId:   s.UserId,
+		Filename: objectKey,
+		Name:     s.Filename,
+		FileUrl:  uc.store.URL(objectKey),
+		FileSize: int(size),
+		FileMd5:  s.FileMd5,
+		FileType: fileType,
+	}
+	prepareMedia(file)
+	err = uc.InQuota(ctx, s.UserId, userType, size, func(ctx context.Context) error {
+		ok, err := uc.sessions.UpdateUploadSessionStatus(ctx, s.ID, models.UploadStatusCompleting, models.UploadStatusCompleted)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.NewUserError("upload already completed")
+		}
+		return uc.files.CreateFile(ctx, file)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.deleteParts(ctx, s.UploadId)
+	if err := uc.media.Submit(ctx, file); err != nil {
+		logger.Errorf(ctx, "failed to submit media processing of file %d: %v", file.ID, err)
+	}
+	return &filev1.CompleteUploadResponse{File: toFileProto(file)}, nil
+}
+
+// AbortUpload 取消上传,删除已上传的分片和会话
+func (uc *FileUseCase) AbortUpload(ctx context.Context, req *filev1.AbortUploadRequest) (*emptypb.Empty, error) {
+	s, err := uc.pendingSession(ctx, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.deleteParts(ctx, s.UploadId) {
+		return nil, errors.New("failed to delete upload parts")
+	}
+	if err := uc.sessions.DeleteUploadSession(ctx, s.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete upload session")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetFile 获取当前用户的文件,用于轮询处理状态
+func (uc *FileUseCase) GetFile(ctx context.Context, req *filev1.GetFileRequest) (*filev1.File, error) {
+	file, err := uc.userFile(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toFileProto(file), nil
+}
+
+// ListFiles 分页获取当前用户的文件及存储配额使用情况
+func (uc *FileUseCase) ListFiles(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+	claims := middleware.GetClaims(ctx)
+	page, pageSize := pageParams(req.Page, req.PageSize)
+	files, total, err := uc.files.PageListFiles(ctx, &FileCond{
+		UserID:   int(claims.Uid),
+		FileType: models.FileType(req.FileType),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list files")
+	}
+	used, err := uc.files.SumUserFileSize(ctx, int(claims.Uid))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get storage usage")
+	}
+
+	res := &filev1.ListFilesResponse{Total: total, UsedBytes: used}
+	if claims.Type == int(models.UserTypeNormal) {
+		res.QuotaBytes = uc.userQuota
+	}
+	for i := range files {
+		res.Files = append(res.Files, toFileProto(&files[i]))
+	}
+	return res, nil
+}
+
+// DeleteFile 删除文件记录,没有其他记录引用同一对象时删除存储中的原文件和衍生文件
+func (uc *FileUseCase) DeleteFile(ctx context.Context, req *filev1.DeleteFileRequest) (*emptypb.Empty, error) {
+	file, err := uc.userFile(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.files.DeleteFile(ctx, req.Id); err != nil {
+		return nil, errors.Wrap(err, "failed to delete file")
+	}
+
+	// 系统上传目录中相同内容的文件共用一个对象
+	refs, err := uc.files.CountFilesByFilename(ctx, file.Filename)
+	if err != nil {
+		logger.Errorf(ctx, "failed to count references of %s: %v", file.Filename, err)
+		return &emptypb.Empty{}, nil
+	}
+	if refs == 0 {
+		deleteFileObjects(ctx, uc.store, fileObjectKey(file))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RenameFile 修改文件显示名称,存储中的对象名不变
+func (uc *FileUseCase) RenameFile(ctx context.Context, req *filev1.RenameFileRequest) (*filev1.File, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.NewUserError("name is required")
+	}
+	file, err := uc.userFile(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	file.Name = name
+	if err := uc.files.UpdateFile(ctx, file, "name"); err != nil {
+		return nil, errors.Wrap(err, "failed to rename file")
+	}
+	return toFileProto(file), nil
+}
+
+// InQuota 在事务中锁定用户记录并校验配额后执行 fn,并发上传的文件不会超出配额,fn 应在事务中创建文件记录
+func (uc *FileUseCase) InQuota(ctx context.Context, userID, userType int, size int64, fn func(ctx context.Context) error) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if uc.userQuota > 0 && userType == int(models.UserTypeNormal) {
+			if err := uc.files.LockUserQuota(ctx, userID); err != nil {
+				return errors.Wrap(err, "failed to lock user quota")
+			}
+		}
+		if err := uc.CheckQuota(ctx, userID, userType, size); err != nil {
+			return err
+		}
+		return fn(ctx)
+	})
+}
+
+// CheckQuota 校验普通用户再上传 size 字节后是否超出存储配额,不加锁,用于提前拒绝,创建文件记录应使用 InQuota
+func (uc *FileUseCase) CheckQuota(ctx context.Context, userID, userType int, size int64) error {
+	if uc.userQuota <= 0 || userType != int(models.UserTypeNormal) {
+		return nil
+	}
+	used, err := uc.files.SumUserFileSize(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get storage usage")
+	}
+	if used+size > uc.userQuota {
+		return errors.NewUserError("storage quota exceeded")
+	}
+	return nil
+}
+
+// userFile 获取当前用户的文件,不存在或不属于当前用户时返回用户错误
+func (uc *FileUseCase) userFile(ctx context.Context, id int64) (*models.FileMetadata, error) {
+	file, err := uc.files.GetFile(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && file.UserId != int(middleware.GetClaims(ctx).Uid)) {
+		return nil, errors.NewUserError("file not found")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get file")
+	}
+	return file, nil
+}
+
+// CleanExpiredUploads 清理过期的上传会话及其分片,分片删除失败的会话留到下次清理
+func (uc *FileUseCase) CleanExpiredUploads(ctx context.Context) error {
+	sessions, err := uc.sessions.ListExpiredUploadSessions(ctx, time.Now(), cleanUploadSessionBatch)
+	if err != nil {
+		return errors.Wrap(err, "failed to list expired upload sessions")
+	}
+	for _, s := range sessions {
+		if !uc.deleteParts(ctx, s.UploadId) {
+			continue
+		}
+		if err := uc.sessions.DeleteUploadSession(ctx, s.ID); err != nil {
+			logger.Errorf(ctx, "failed to delete upload session %s: %v", s.UploadId, err)
+		}
+	}
+	return nil
+}
+
+// pendingSession 获取当前用户待上传的会话
+func (uc *FileUseCase) pendingSession(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	s, err := uc.sessions.GetUploadSession(ctx, uploadID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && s.UserId != int(middleware.GetClaims(ctx).Uid)) {
+		return nil, errors.NewUserError("upload not found")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get upload session")
+	}
+	switch s.Status {
+	case models.UploadStatusPending:
+	case models.UploadStatusCompleting:
+		return nil, errors.NewUserError("upload is being completed")
+	default:
+		return nil, errors.NewUserError("upload already completed")
+	}
+	if time.Now().After(s.ExpiresAt) {
+		return nil, errors.NewUserError("upload expired")
+	}
+	return s, nil
+}
+
+// uploadedParts 返回已上传且大小正确的分片序号
+func (uc *FileUseCase) uploadedParts(ctx context.Context, s *models.UploadSession) (map[int]bool, error) {
+	objects, err := uc.store.List(ctx, partPrefix(s.UploadId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list upload parts")
+	}
+	uploaded := make(map[int]bool, len(objects))
+	for _, obj := range objects {
+		var n int
+		if _, err := fmt.Sscanf(path.Base(obj.Key), "%d", &n); err != nil || n < 1 || n > s.PartCount {
+			continue
+		}
+		uploaded[n] = obj.Size == partSize(s, n)
+	}
+	return uploaded, nil
+}
+
+// verifyObject 校验合并后文件的大小和MD5
+func (uc *FileUseCase) verifyObject(ctx context.Context, s *models.UploadSession, key string) error {
+	info, err := uc.store.Stat(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to stat uploaded file")
+	}
+	if info.Size != s.FileSize {
+		return errors.NewUserError("file size mismatch")
+	}
+
+	r, err := uc.store.Open(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to open uploaded file")
+	}
+	defer r.Close()
+	sum, err := util.MD5Reader(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to read uploaded file")
+	}
+	if sum != s.FileMd5 {
+		return errors.NewUserError("file md5 mismatch")
+	}
+	return nil
+}
+
+// deleteParts 删除会话的全部分片,返回是否全部删除成功
+func (uc *FileUseCase) deleteParts(ctx context.Context, uploadID string) bool {
+	objects, err := uc.store.List(ctx, partPrefix(uploadID))
+	if err != nil {
+		logger.Errorf(ctx, "failed to list upload parts of %s: %v", uploadID, err)
+		return false
+	}
+	ok := true
+	for _, obj := range objects {
+		if err := uc.store.DeleteFile(ctx, obj.Key); err != nil && !errors.Is(err, storage.ErrNotExist) {
+			logger.Errorf(ctx, "failed to delete upload part %s: %v", obj.Key, err)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func partPrefix(uploadID string) string {
+	return uploadPartPrefix + "/" + uploadID + "/"
+}
+
+func partKey(uploadID string, n int) string {
+	return fmt.Sprintf("%s%05d", partPrefix(uploadID), n)
+}
+
+// mergedKey 分片合并后的临时对象,位于会话的分片目录下,与最终对象使用相同的文件名
+func mergedKey(uploadID, objectKey string) string {
+	return partPrefix(uploadID) + "merged_" + path.Base(objectKey)
+}
+
+// objectKeyOf 合并后的对象名,扩展名由识别出的内容类型决定,替换旧会话中按文件名生成的扩展名
+func objectKeyOf(s *models.UploadSession, contentType string) string {
+	return strings.TrimSuffix(s.ObjectKey, path.Ext(s.ObjectKey)) + filescan.Extension(contentType)
+}
+
+// partSize 第n个分片的大小,最后一个分片为剩余部分
+func partSize(s *models.UploadSession, n int) int64 {
+	if n < s.PartCount {
+		return s.PartSize
+	}
+	return s.FileSize - s.PartSize*int64(s.PartCount-1)
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// fileTypeOf 根据文件名判断文件类型
+func fileTypeOf(filename string) models.FileType {
+	switch {
+	case util.IsVideoFile(filename):
+		return models.FileTypeVideo
+	case util.IsAudioFile(filename):
+		return models.FileTypeAudio
+	default:
+		return models.FileTypeImage
+	}
+}
+
+func toFileProto(f *models.FileMetadata) *filev1.File {
+	name := f.Name
+	if name == "" {
+		name = path.Base(f.Filename)
+	}
+	return &filev1.File{
+		Name:         name,
+		Id:           int64(f.ID),
+		Filename:     f.Filename,
+		FileUrl:      f.FileUrl,
+		CoverUrl:     f.CoverUrl,
+		FileType:     string(f.FileType),
+		FileSize:     int64(f.FileSize),
+		FileMd5:      f.FileMd5,
+		CreatedAt:    timestamppb.New(f.CreatedAt.Time),
+		Status:       string(f.Status),
+		Steps:        f.Steps,
+		Variants:     f.Variants,
+		Width:        int32(f.Width),
+		Height:       int32(f.Height),
+		Duration:     f.VideoDuration,
+		ProcessError: f.ProcessError,
+	}
+}
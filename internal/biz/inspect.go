@@ -0,0 +1,214 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/filescan"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/storage"
+)
+
+const (
+	// defaultMaxImagePixels 默认图片最大像素数
+	defaultMaxImagePixels = 40_000_000
+	// defaultQuarantinePrefix 默认隔离目录
+	defaultQuarantinePrefix = "quarantine"
+)
+
+// fileTypeAllowList 各文件类型允许的内容类型,按文件头识别,子类型也允许
+var fileTypeAllowList = map[models.FileType][]string{
+	models.FileTypeImage: {"image/jpeg", "image/png", "image/gif", "image/webp", "image/bmp"},
+	models.FileTypeVideo: {
+		"video/mp4", "video/quicktime", "video/x-m4v", "video/x-msvideo", "video/x-ms-asf", "video/x-flv",
+		"video/x-matroska", "video/webm", "video/mpeg", "video/3gpp", "video/ogg",
+	},
+	models.FileTypeAudio: {
+		"audio/mpeg", "audio/wav", "audio/ogg", "audio/flac", "audio/aac", "audio/mp4", "audio/x-m4a", "video/x-ms-asf",
+	},
+}
+
+var (
+	errFileTypeMismatch = errors.NewUserError("file content does not match its type")
+	errInvalidImage     = errors.NewUserError("file is not a valid image")
+	errImageTooLarge    = errors.NewUserError("image dimensions are too large")
+	errFileRejected     = errors.NewUserError("file was rejected by security scan")
+)
+
+// FileInspector 上传文件校验: 按文件头识别类型、解码图片并去除元数据、扫描恶意文件并隔离
+type FileInspector struct {
+	store            storage.Storage
+	scanner          filescan.Scanner // 为 nil 时不扫描
+	maxPixels        int64
+	quarantinePrefix string
+}
+
+func NewFileInspector(c *conf.Bootstrap, store storage.Storage, scanner filescan.Scanner) *FileInspector {
+	i := &FileInspector{
+		store:            store,
+		scanner:          scanner,
+		maxPixels:        c.GetStorage().GetUpload().GetMaxImagePixels(),
+		quarantinePrefix: c.GetStorage().GetScanner().GetQuarantinePrefix(),
+	}
+	if i.maxPixels <= 0 {
+		i.maxPixels = defaultMaxImagePixels
+	}
+	if i.quarantinePrefix == "" {
+		i.quarantinePrefix = defaultQuarantinePrefix
+	}
+	return i
+}
+
+// CheckFile 校验本地文件,图片会被替换为去除元数据后的内容,命中扫描的文件上传到隔离目录。返回按文件头识别的内容类型
+func (i *FileInspector) CheckFile(ctx context.Context, userID int, fileType models.FileType, name string) (string, error) {
+	contentType, err := i.sniff(fileType, func() (io.ReadCloser, error) { return os.Open(name) })
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	err = i.scan(ctx, f)
+	f.Close()
+	var infected *filescan.InfectedError
+	if errors.As(err, &infected) {
+		key := i.quarantineKey(userID, name)
+		if _, _, qerr := putLocalFile(ctx, i.store, key, name, contentType); qerr != nil {
+			logger.Errorf(ctx, "failed to quarantine file %s: %v", key, qerr)
+		}
+		logger.Warnf(ctx, "upload of user %d rejected: %s, quarantined to %s", userID, infected.Signature, key)
+		return "", errFileRejected
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if fileType == models.FileTypeImage {
+		if err := i.sanitizeFile(name); err != nil {
+			return "", err
+		}
+	}
+	return contentType, nil
+}
+
+// SniffObject 按文件头识别存储中对象的内容类型,类型不在 fileType 的允许列表中时返回用户错误
+func (i *FileInspector) SniffObject(ctx context.Context, fileType models.FileType, key string) (string, error) {
+	return i.sniff(fileType, func() (io.ReadCloser, error) { return i.store.Open(ctx, key) })
+}
+
+// CheckObject 校验存储中的对象,命中扫描的对象移动到隔离目录,图片去除元数据后覆盖原对象。返回对象处理后的大小
+func (i *FileInspector) CheckObject(ctx context.Context, userID int, fileType models.FileType, key, contentType string) (int64, error) {
+	r, err := i.store.Open(ctx, key)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open file")
+	}
+	err = i.scan(ctx, r)
+	r.Close()
+	var infected *filescan.InfectedError
+	if errors.As(err, &infected) {
+		qkey := i.quarantineKey(userID, key)
+		if qerr := i.store.Copy(ctx, key, qkey); qerr != nil {
+			logger.Errorf(ctx, "failed to quarantine file %s: %v", qkey, qerr)
+		}
+		logger.Warnf(ctx, "upload of user %d rejected: %s, quarantined to %s", userID, infected.Signature, qkey)
+		return 0, errFileRejected
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if fileType != models.FileTypeImage {
+		info, err := i.store.Stat(ctx, key)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to stat file")
+		}
+		return info.Size, nil
+	}
+
+	dir, err := os.MkdirTemp("", "inspect-")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create temp directory")
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "source"+path.Ext(key))
+	if err := downloadFile(ctx, i.store, key, name); err != nil {
+		return 0, err
+	}
+	if err := i.sanitizeFile(name); err != nil {
+		return 0, err
+	}
+	_, size, err := putLocalFile(ctx, i.store, key, name, contentType)
+	return size, err
+}
+
+func (i *FileInspector) sniff(fileType models.FileType, open func() (io.ReadCloser, error)) (string, error) {
+	r, err := open()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open file")
+	}
+	defer r.Close()
+
+	contentType, ok, err := filescan.Sniff(r, fileTypeAllowList[fileType])
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errFileTypeMismatch
+	}
+	return contentType, nil
+}
+
+func (i *FileInspector) scan(ctx context.Context, r io.Reader) error {
+	if i.scanner == nil {
+		return nil
+	}
+	if err := i.scanner.Scan(ctx, r); err != nil {
+		return errors.Wrap(err, "failed to scan file")
+	}
+	return nil
+}
+
+// sanitizeFile 解码图片并去除元数据,用处理后的内容替换原文件
+func (i *FileInspector) sanitizeFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp := name + ".clean"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	_, err = filescan.SanitizeImage(src, dst, i.maxPixels)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	switch {
+	case errors.Is(err, filescan.ErrImageTooLarge):
+		return errImageTooLarge
+	case errors.Is(err, filescan.ErrInvalidImage):
+		return errInvalidImage
+	case err != nil:
+		return errors.Wrap(err, "failed to sanitize image")
+	}
+	return os.Rename(tmp, name)
+}
+
+// quarantineKey 隔离文件的对象名 隔离目录/日期/用户ID/文件名
+func (i *FileInspector) quarantineKey(userID int, name string) string {
+	return path.Join(i.quarantinePrefix, time.Now().Format("20060102"), strconv.Itoa(userID), strconv.FormatInt(time.Now().UnixNano(), 36)+"_"+path.Base(filepath.ToSlash(name)))
+}
@@ -0,0 +1,288 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/queue"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// taskStatusNotFound 查询的任务不存在时返回的状态
+const taskStatusNotFound = "not_found"
+
+var errTaskNotFound = errors.NewUserError("task not found")
+
+// JobUseCase 队列任务管理,任务由 api 服务的任务服务器执行
+type JobUseCase struct {
+	queue *queue.Client
+}
+
+func NewJobUseCase(queue *queue.Client) *JobUseCase {
+	return &JobUseCase{queue: queue}
+}
+
+// Enqueue 提交任务,payload 为任务参数消息的 JSON 编码,未指定的选项使用任务类型的默认值
+func (uc *JobUseCase) Enqueue(ctx context.Context, req *jobv1.EnqueueRequest) (*jobv1.EnqueueResponse, error) {
+	def, ok := taskTypes[req.JobType.String()]
+	if !ok {
+		return nil, errors.NewUserError("job type cannot be enqueued")
+	}
+	payload := def.NewPayload()
+	if len(req.Payload) > 0 {
+		if err := protojson.Unmarshal(req.Payload, payload); err != nil {
+			return nil, errors.NewUserError("invalid payload: " + err.Error())
+		}
+	}
+
+	opts := def.Options()
+	if req.Queue != "" {
+		if !queue.IsServerQueue(req.Queue) {
+			return nil, errors.NewUserError("unknown queue: " + req.Queue)
+		}
+		opts = append(opts, asynq.Queue(req.Queue))
+	}
+	if req.RetryTime > 0 {
+		opts = append(opts, asynq.MaxRetry(int(req.RetryTime)))
+	}
+	if req.ProcessAt != nil {
+		opts = append(opts, asynq.ProcessAt(req.ProcessAt.AsTime()))
+	}
+	if req.ProcessIn != nil {
+		opts = append(opts, asynq.ProcessIn(req.ProcessIn.AsDuration()))
+	}
+	if req.Deadline != nil {
+		opts = append(opts, asynq.Deadline(req.Deadline.AsTime()))
+	}
+	if req.Retention != nil {
+		opts = append(opts, asynq.Retention(req.Retention.AsDuration()))
+	}
+
+	id, err := uc.queue.EnqueueTask(ctx, &queue.Task{TypeName: def.Name(), Payload: payload}, opts...)
+	if errors.Is(err, queue.ErrDuplicateTask) {
+		return nil, errors.NewUserError("an identical task is already queued")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &jobv1.EnqueueResponse{TaskId: id}, nil
+}
+
+// QueryTasks 批量查询任务,不存在的任务状态为 not_found
+func (uc *JobUseCase) QueryTasks(ctx context.Context, req *jobv1.QueryTasksRequest) (*jobv1.QueryTasksResponse, error) {
+	res := &jobv1.QueryTasksResponse{}
+	for _, id := range req.TaskIds {
+		info, err := uc.queue.GetTaskInfo(queueName(req.Queue), id)
+		if errors.Is(err, queue.ErrTaskNotFound) {
+			res.Tasks = append(res.Tasks, &jobv1.QueryTasksResponse_TaskInfo{TaskId: id, Status: taskStatusNotFound})
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get task info")
+		}
+		res.Tasks = append(res.Tasks, toTaskInfoProto(info))
+	}
+	return res, nil
+}
+
+// CancelTask 取消任务,已完成的任务不能取消
+func (uc *JobUseCase) CancelTask(ctx context.Context, req *jobv1.CancelTaskRequest) (*emptypb.Empty, error) {
+	info, err := uc.taskInfo(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if info.State == asynq.TaskStateCompleted {
+		return nil, errors.NewUserError("task already completed")
+	}
+	if err := uc.queue.CancelTask(info.Queue, info.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to cancel task")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RetryTask 立即执行等待重试、已归档或定时的任务
+func (uc *JobUseCase) RetryTask(ctx context.Context, req *jobv1.RetryTaskRequest) (*emptypb.Empty, error) {
+	info, err := uc.taskInfo(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	switch info.State {
+	case asynq.TaskStateRetry, asynq.TaskStateArchived, asynq.TaskStateScheduled:
+	default:
+		return nil, errors.NewUserError("task in " + info.State.String() + " state cannot be retried")
+	}
+	if err := uc.queue.RunTask(info.Queue, info.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to retry task")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// QueuingTime 查询任务的排队时间,定时和等待重试的任务为距离下次执行的时间,待执行的任务为队列的等待时间
+func (uc *JobUseCase) QueuingTime(ctx context.Context, req *jobv1.QueuingTimeRequest) (*jobv1.QueuingTimeResponse, error) {
+	info, err := uc.taskInfo(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var wait time.Duration
+	switch info.State {
+	case asynq.TaskStateScheduled, asynq.TaskStateRetry:
+		wait = max(time.Until(info.NextProcessAt), 0)
+	case asynq.TaskStatePending:
+		if wait, err = uc.queue.QueueLatency(info.Queue); err != nil {
+			return nil, errors.Wrap(err, "failed to get queue info")
+		}
+	}
+	return &jobv1.QueuingTimeResponse{
+		TaskId:  info.ID,
+		Seconds: int64(wait.Seconds()),
+		Status:  info.State.String(),
+	}, nil
+}
+
+// ListArchivedTasks 分页查询已归档的任务
+func (uc *JobUseCase) ListArchivedTasks(ctx context.Context, req *jobv1.ListArchivedTasksRequest) (*jobv1.ListArchivedTasksResponse, error) {
+	page, pageSize := pageParams(req.Page, req.PageSize)
+	tasks, total, err := uc.queue.ListArchivedTasks(queueName(req.Queue), page, pageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list archived tasks")
+	}
+	res := &jobv1.ListArchivedTasksResponse{Total: int64(total)}
+	for _, info := range tasks {
+		res.Tasks = append(res.Tasks, toArchivedTaskProto(info))
+	}
+	return res, nil
+}
+
+// GetArchivedTask 查看已归档的任务,任务参数按任务类型解码为 JSON
+func (uc *JobUseCase) GetArchivedTask(ctx context.Context, req *jobv1.GetArchivedTaskRequest) (*jobv1.ArchivedTask, error) {
+	info, err := uc.archivedTask(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	return toArchivedTaskProto(info), nil
+}
+
+// RequeueArchivedTask 将已归档的任务作为新任务重新投递,投递成功后删除原任务
+func (uc *JobUseCase) RequeueArchivedTask(ctx context.Context, req *jobv1.RequeueArchivedTaskRequest) (*jobv1.RequeueArchivedTaskResponse, error) {
+	info, err := uc.archivedTask(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uc.queue.RequeueTask(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.queue.DeleteTask(info.Queue, info.ID); err != nil {
+		logger.Errorf(ctx, "failed to delete requeued archived task %s: %v", info.ID, err)
+	}
+	return &jobv1.RequeueArchivedTaskResponse{TaskId: id}, nil
+}
+
+// DeleteArchivedTask 删除已归档的任务
+func (uc *JobUseCase) DeleteArchivedTask(ctx context.Context, req *jobv1.DeleteArchivedTaskRequest) (*emptypb.Empty, error) {
+	info, err := uc.archivedTask(req.Queue, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.queue.DeleteTask(info.Queue, info.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete task")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// archivedTask 获取已归档的任务,任务不存在或未归档时返回用户错误
+func (uc *JobUseCase) archivedTask(qname, id string) (*asynq.TaskInfo, error) {
+	info, err := uc.taskInfo(qname, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.State != asynq.TaskStateArchived {
+		return nil, errors.NewUserError("task in " + info.State.String() + " state is not archived")
+	}
+	return info, nil
+}
+
+func (uc *JobUseCase) taskInfo(qname, id string) (*asynq.TaskInfo, error) {
+	info, err := uc.queue.GetTaskInfo(queueName(qname), id)
+	if errors.Is(err, queue.ErrTaskNotFound) {
+		return nil, errTaskNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get task info")
+	}
+	return info, nil
+}
+
+// queueName 未指定队列时使用默认队列
+func queueName(name string) string {
+	if name == "" {
+		return queue.QueueDefault
+	}
+	return name
+}
+
+func toTaskInfoProto(info *asynq.TaskInfo) *jobv1.QueryTasksResponse_TaskInfo {
+	t := &jobv1.QueryTasksResponse_TaskInfo{
+		TaskId:   info.ID,
+		Result:   info.Result,
+		Status:   info.State.String(),
+		Type:     info.Type,
+		Retried:  int32(info.Retried),
+		MaxRetry: int32(info.MaxRetry),
+		LastErr:  info.LastErr,
+	}
+	if !info.NextProcessAt.IsZero() {
+		t.NextProcessAt = timestamppb.New(info.NextProcessAt)
+	}
+	if !info.CompletedAt.IsZero() {
+		t.CompletedAt = timestamppb.New(info.CompletedAt)
+	}
+	return t
+}
+
+func toArchivedTaskProto(info *asynq.TaskInfo) *jobv1.ArchivedTask {
+	t := &jobv1.ArchivedTask{
+		TaskId:   info.ID,
+		Queue:    info.Queue,
+		Type:     info.Type,
+		Retried:  int32(info.Retried),
+		MaxRetry: int32(info.MaxRetry),
+		LastErr:  info.LastErr,
+		Headers:  info.Headers,
+	}
+	if !info.LastFailedAt.IsZero() {
+		t.LastFailedAt = timestamppb.New(info.LastFailedAt)
+	}
+	if payload, ok := decodePayload(info.Type, info.Payload); ok {
+		t.Payload = payload
+	} else {
+		t.RawPayload = info.Payload
+	}
+	return t
+}
+
+// decodePayload 按任务类型将 protobuf 编码的任务参数转换为 JSON
+func decodePayload(taskType string, data []byte) (string, bool) {
+	def, ok := taskTypes[taskType]
+	if !ok {
+		return "", false
+	}
+	payload := def.NewPayload()
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return "", false
+	}
+	data, err := protojson.Marshal(payload)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
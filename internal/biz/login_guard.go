@@ -0,0 +1,273 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/cache"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/limit"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	verificationCodeTTL         = 5 * time.Minute // 验证码有效期
+	verificationCodeMaxAttempts = 5               // 单个验证码最多可尝试次数
+	verificationCodeCooldown    = time.Minute     // 同一邮箱发送间隔
+	verificationCodeDailyEmail  = 10              // 同一邮箱每日发送上限
+	verificationCodeDailyIP     = 50              // 同一IP每日发送上限
+)
+
+// sendPolicy 邮件发送频率限制: 同一邮箱冷却时间、同一邮箱及同一IP每日上限
+type sendPolicy struct {
+	prefix      string
+	cooldown    time.Duration
+	dailyEmail  int
+	dailyIP     int
+	cooldownErr error // 冷却期内返回的错误
+	dailyErr    error // 超过每日上限返回的错误
+}
+
+var (
+	verificationCodePolicy = sendPolicy{
+		prefix:      "verification_code",
+		cooldown:    verificationCodeCooldown,
+		dailyEmail:  verificationCodeDailyEmail,
+		dailyIP:     verificationCodeDailyIP,
+		cooldownErr: errors.ErrVerificationCodeSent,
+		dailyErr:    errors.ErrVerificationCodeDailyLimit,
+	}
+	// 重置密码邮件不区分邮箱是否注册,同样限制发送频率
+	passwordResetPolicy = sendPolicy{
+		prefix:      "password_reset",
+		cooldown:    time.Minute,
+		dailyEmail:  5,
+		dailyIP:     20,
+		cooldownErr: errors.ErrPasswordResetLimit,
+		dailyErr:    errors.ErrPasswordResetLimit,
+	}
+)
+
+var (
+	// 账号维度: 15分钟内失败5次锁定,锁定时长从1分钟开始翻倍,最长1小时
+	accountAttemptPolicy = limit.AttemptPolicy{
+		MaxFailures: 5,
+		Window:      15 * time.Minute,
+		BaseLockout: time.Minute,
+		MaxLockout:  time.Hour,
+	}
+	// IP维度: 阈值更高,防止同一IP对多个账号撞库
+	ipAttemptPolicy = limit.AttemptPolicy{
+		MaxFailures: 30,
+		Window:      15 * time.Minute,
+		BaseLockout: 5 * time.Minute,
+		MaxLockout:  24 * time.Hour,
+	}
+)
+
+// verificationCode 缓存中的验证码,错误次数单独计数
+type verificationCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// LoginGuard 登录防暴力破解: 账号/IP失败计数与渐进式锁定、验证码一次性校验、验证码和重置密码邮件发送频率限制
+type LoginGuard struct {
+	account limit.AttemptLimiter
+	ip      limit.AttemptLimiter
+	limiter limit.Limiter
+	cache   cache.Cache
+	rdb     *redis.Client
+}
+
+func NewLoginGuard(rdb *redis.Client, limiter limit.Limiter, cache cache.Cache) *LoginGuard {
+	return &LoginGuard{
+		account: limit.NewRedisAttemptLimiter(rdb, accountAttemptPolicy),
+		ip:      limit.NewRedisAttemptLimiter(rdb, ipAttemptPolicy),
+		limiter: limiter,
+		cache:   cache,
+		rdb:     rdb,
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// CheckLogin 检查账号或IP是否处于锁定状态
+func (g *LoginGuard) CheckLogin(ctx context.Context, email, ip string) error {
+	lockout, err := g.account.Locked(ctx, "login:account:"+normalizeEmail(email))
+	if err != nil {
+		return errors.Wrap(err, "failed to check account lockout")
+	}
+	if ip != "" {
+		ipLockout, err := g.ip.Locked(ctx, "login:ip:"+ip)
+		if err != nil {
+			return errors.Wrap(err, "failed to check ip lockout")
+		}
+		lockout = max(lockout, ipLockout)
+	}
+	if lockout > 0 {
+		return errors.TooManyAttempts(lockout)
+	}
+	return nil
+}
+
+// LoginFailed 记录一次登录失败,触发锁定时返回 ErrTooManyAttempts,否则返回nil
+func (g *LoginGuard) LoginFailed(ctx context.Context, email, ip string) error {
+	lockout, err := g.account.Fail(ctx, "login:account:"+normalizeEmail(email))
+	if err != nil {
+		return errors.Wrap(err, "failed to record login failure")
+	}
+	if ip != "" {
+		ipLockout, err := g.ip.Fail(ctx, "login:ip:"+ip)
+		if err != nil {
+			return errors.Wrap(err, "failed to record login failure")
+		}
+		lockout = max(lockout, ipLockout)
+	}
+	if lockout > 0 {
+		return errors.TooManyAttempts(lockout)
+	}
+	return nil
+}
+
+// LoginSucceeded 登录成功后清除账号的失败计数,IP计数保留以防止撞库
+func (g *LoginGuard) LoginSucceeded(ctx context.Context, email string) error {
+	return g.account.Reset(ctx, "login:account:"+normalizeEmail(email))
+}
+
+// AllowSendCode 检查验证码发送频率
+func (g *LoginGuard) AllowSendCode(ctx context.Context, email, ip string) error {
+	return g.allowSend(ctx, verificationCodePolicy, email, ip)
+}
+
+// AllowPasswordReset 检查重置密码邮件发送频率
+func (g *LoginGuard) AllowPasswordReset(ctx context.Context, email, ip string) error {
+	return g.allowSend(ctx, passwordResetPolicy, email, ip)
+}
+
+// allowSend 检查邮件发送频率,冷却期内的请求不计入每日次数,超过每日上限的请求不开始冷却
+func (g *LoginGuard) allowSend(ctx context.Context, p sendPolicy, email, ip string) error {
+	email = normalizeEmail(email)
+	cooldownKey := p.prefix + ":cooldown:" + email
+	n, err := g.rdb.Exists(ctx, cooldownKey).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to check send cooldown")
+	}
+	if n > 0 {
+		return p.cooldownErr
+	}
+
+	if !g.limiter.Allow(p.prefix+":daily:email:"+email, limit.WithRatePerSecond(p.dailyEmail),
+		limit.WithBurst(p.dailyEmail), limit.WithPeriod(24*time.Hour)) {
+		return p.dailyErr
+	}
+	if ip != "" && !g.limiter.Allow(p.prefix+":daily:ip:"+ip, limit.WithRatePerSecond(p.dailyIP),
+		limit.WithBurst(p.dailyIP), limit.WithPeriod(24*time.Hour)) {
+		return p.dailyErr
+	}
+
+	// 并发请求只有一个能开始冷却
+	ok, err := g.rdb.SetNX(ctx, cooldownKey, 1, p.cooldown).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to start send cooldown")
+	}
+	if !ok {
+		return p.cooldownErr
+	}
+	return nil
+}
+
+func verificationCodeKey(email string) string {
+	return "verification:" + normalizeEmail(email)
+}
+
+func verificationAttemptsKey(email string) string {
+	return "verification:attempts:" + normalizeEmail(email)
+}
+
+// SaveCode 保存验证码,覆盖该邮箱之前未使用的验证码并清零错误次数
+func (g *LoginGuard) SaveCode(ctx context.Context, email, code string) error {
+	if err := g.rdb.Del(ctx, verificationAttemptsKey(email)).Err(); err != nil {
+		return errors.Wrap(err, "failed to reset verification attempts")
+	}
+	return g.cache.Set(ctx, verificationCodeKey(email), verificationCode{
+		Code:      code,
+		ExpiresAt: time.Now().Add(verificationCodeTTL),
+	}, verificationCodeTTL)
+}
+
+// VerifyCode 校验验证码,校验成功后立即作废;错误次数达到上限后验证码同样作废。
+// 每次校验前先原子递增尝试次数,并发请求合计最多比较 verificationCodeMaxAttempts 次
+func (g *LoginGuard) VerifyCode(ctx context.Context, email, code string) error {
+	attemptsKey := verificationAttemptsKey(email)
+
+	var stored verificationCode
+	if err := g.cache.Get(ctx, verificationCodeKey(email), &stored); err != nil || code == "" {
+		return errors.NewUserError("verification code is incorrect or expired")
+	}
+	ttl := time.Until(stored.ExpiresAt)
+	if ttl < time.Second {
+		_ = g.discardCode(ctx, email)
+		return errors.NewUserError("verification code is incorrect or expired")
+	}
+
+	// 尝试次数与验证码同时过期,错误尝试不延长验证码有效期
+	var attempts *redis.IntCmd
+	_, err := g.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		attempts = pipe.Incr(ctx, attemptsKey)
+		pipe.PExpire(ctx, attemptsKey, ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to count verification attempts")
+	}
+	if attempts.Val() > verificationCodeMaxAttempts {
+		_ = g.discardCode(ctx, email)
+		return errors.ErrVerificationCodeAttemptsExceeded
+	}
+
+	if subtle.ConstantTimeCompare([]byte(stored.Code), []byte(code)) == 1 {
+		if err := g.discardCode(ctx, email); err != nil {
+			return errors.Wrap(err, "failed to consume verification code")
+		}
+		return nil
+	}
+
+	if attempts.Val() >= verificationCodeMaxAttempts {
+		_ = g.discardCode(ctx, email)
+		return errors.ErrVerificationCodeAttemptsExceeded
+	}
+	return errors.NewUserError("verification code is incorrect or expired")
+}
+
+// discardCode 作废验证码并删除错误次数
+func (g *LoginGuard) discardCode(ctx context.Context, email string) error {
+	if err := g.cache.Delete(ctx, verificationCodeKey(email)); err != nil {
+		return err
+	}
+	return g.rdb.Del(ctx, verificationAttemptsKey(email)).Err()
+}
+
+// CountAttempt 原子递增 key 的尝试次数并返回递增后的值,计数在 ttl 后过期
+func (g *LoginGuard) CountAttempt(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var attempts *redis.IntCmd
+	_, err := g.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		attempts = pipe.Incr(ctx, key)
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return attempts.Val(), nil
+}
+
+// ClearAttempts 删除 key 的尝试次数
+func (g *LoginGuard) ClearAttempts(ctx context.Context, key string) error {
+	return g.rdb.Del(ctx, key).Err()
+}
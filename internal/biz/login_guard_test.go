@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/ydssx/kratos-kit/pkg/cache"
+	kerrors "github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/limit"
+)
+
+func TestAllowSendCodeDailyLimit(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	g := NewLoginGuard(rdb, limit.NewRedisLimiter(rdb), cache.NewRedisCache(rdb))
+	ctx := context.Background()
+
+	now := time.Now()
+	mr.SetTime(now)
+	next := func() {
+		now = now.Add(verificationCodeCooldown + time.Second)
+		mr.SetTime(now)
+		mr.FastForward(verificationCodeCooldown + time.Second)
+	}
+
+	for i := 1; i <= verificationCodeDailyEmail; i++ {
+		if err := g.AllowSendCode(ctx, "a@example.com", "1.2.3.4"); err != nil {
+			t.Fatalf("send %d: %v", i, err)
+		}
+		// 冷却期内的请求被拒绝,且不占用每日次数
+		if err := g.AllowSendCode(ctx, "a@example.com", "1.2.3.4"); !errors.Is(err, kerrors.ErrVerificationCodeSent) {
+			t.Fatalf("send %d during cooldown: %v", i, err)
+		}
+		next()
+	}
+
+	if err := g.AllowSendCode(ctx, "a@example.com", "1.2.3.4"); !errors.Is(err, kerrors.ErrVerificationCodeDailyLimit) {
+		t.Fatalf("send %d: want daily limit, got %v", verificationCodeDailyEmail+1, err)
+	}
+	// 超过每日上限的请求不开始冷却,其他邮箱不受影响
+	if n, _ := rdb.Exists(ctx, "verification_code:cooldown:a@example.com").Result(); n != 0 {
+		t.Fatal("rejected send should not start cooldown")
+	}
+	if err := g.AllowSendCode(ctx, "b@example.com", "1.2.3.4"); err != nil {
+		t.Fatalf("other email: %v", err)
+	}
+}
+
+func TestVerifyCodeAttempts(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	g := NewLoginGuard(rdb, limit.NewRedisLimiter(rdb), cache.NewRedisCache(rdb))
+	ctx := context.Background()
+
+	if err := g.SaveCode(ctx, "a@example.com", "123456"); err != nil {
+		t.Fatal(err)
+	}
+	// 并发的错误尝试同样计数,达到上限后正确的验证码也不再可用
+	var wg sync.WaitGroup
+	for i := 0; i < verificationCodeMaxAttempts*2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = g.VerifyCode(ctx, "a@example.com", "000000")
+		}()
+	}
+	wg.Wait()
+	if err := g.VerifyCode(ctx, "a@example.com", "123456"); err == nil {
+		t.Fatal("code should be discarded after too many attempts")
+	}
+	if mr.Exists(verificationAttemptsKey("a@example.com")) {
+		t.Fatal("attempts key should be deleted with the code")
+	}
+
+	// 新验证码重新计数,校验成功后验证码和错误次数一起删除
+	if err := g.SaveCode(ctx, "a@example.com", "654321"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.VerifyCode(ctx, "a@example.com", "000000"); err == nil {
+		t.Fatal("wrong code should fail")
+	}
+	if err := g.VerifyCode(ctx, "a@example.com", "654321"); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if mr.Exists(verificationAttemptsKey("a@example.com")) {
+		t.Fatal("attempts key should be deleted on success")
+	}
+	if err := g.VerifyCode(ctx, "a@example.com", "654321"); err == nil {
+		t.Fatal("code should be single use")
+	}
+}
+
+func TestCountAttempt(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	g := NewLoginGuard(rdb, limit.NewRedisLimiter(rdb), cache.NewRedisCache(rdb))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := g.CountAttempt(ctx, "mfa:attempts:x", time.Minute); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got, _ := rdb.Get(ctx, "mfa:attempts:x").Int(); got != 10 {
+		t.Fatalf("attempts = %d, want 10", got)
+	}
+	if ttl := mr.TTL("mfa:attempts:x"); ttl <= 0 || ttl > time.Minute {
+		t.Fatalf("ttl = %v", ttl)
+	}
+
+	if err := g.ClearAttempts(ctx, "mfa:attempts:x"); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := g.CountAttempt(ctx, "mfa:attempts:x", time.Minute); n != 1 {
+		t.Fatalf("attempts after clear = %d, want 1", n)
+	}
+}
@@ -0,0 +1,297 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/common"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/queue"
+	"github.com/ydssx/kratos-kit/pkg/storage"
+	"github.com/ydssx/kratos-kit/pkg/util"
+	"gorm.io/gorm"
+)
+
+const (
+	// mediaMaxRetry 处理失败后的最大重试次数,已完成的步骤不会重复执行
+	mediaMaxRetry = 3
+	// mediaProcessTimeout 单次处理超时时间,主要耗时在视频转码
+	mediaProcessTimeout = time.Hour
+	// maxProcessErrorLen 保存的失败原因最大长度
+	maxProcessErrorLen = 512
+	// mediaProcessedMessage 处理完成后推送给用户的消息类型
+	mediaProcessedMessage = "file_processed"
+)
+
+// 处理步骤
+const (
+	stepProbe     = "probe"     // 读取宽高、时长、编码
+	stepTranscode = "transcode" // 视频转码为H264
+	stepPoster    = "poster"    // 视频封面
+	stepThumbnail = "thumbnail" // 缩略图
+	stepMedium    = "medium"    // 图片中等尺寸
+)
+
+// mediaSteps 各文件类型按顺序执行的处理步骤,音频不需要处理
+var mediaSteps = map[models.FileType][]string{
+	models.FileTypeVideo: {stepProbe, stepTranscode, stepPoster, stepThumbnail},
+	models.FileTypeImage: {stepProbe, stepThumbnail, stepMedium},
+}
+
+// imageVariants 图片衍生文件的最大边长和压缩质量
+var imageVariants = map[string]struct{ size, quality int }{
+	stepThumbnail: {320, 80},
+	stepMedium:    {1280, 85},
+}
+
+// mediaFields 处理过程中会更新的字段
+var mediaFields = []string{
+	"status", "steps", "variants", "process_error", "file_url", "cover_url",
+	"width", "height", "fps", "video_duration", "encoding",
+}
+
+// MediaUseCase 上传文件的异步处理,包括视频转码、生成封面和图片缩略图
+type MediaUseCase struct {
+	store storage.Storage
+	files FileRepo
+	queue *queue.Client
+	ws    *common.WsService
+}
+
+func NewMediaUseCase(store storage.Storage, files FileRepo, queue *queue.Client, ws *common.WsService) *MediaUseCase {
+	return &MediaUseCase{store: store, files: files, queue: queue, ws: ws}
+}
+
+// prepareMedia 设置文件的初始处理状态,没有处理步骤的文件直接可用
+func prepareMedia(file *models.FileMetadata) {
+	steps := mediaSteps[file.FileType]
+	if len(steps) == 0 {
+		file.Status = models.FileStatusReady
+		return
+	}
+	file.Status = models.FileStatusProcessing
+	file.Steps = make(models.MediaSteps, len(steps))
+	for _, step := range steps {
+		file.Steps[step] = models.StepPending
+	}
+}
+
+// Submit 提交文件处理任务,文件已保存后调用,提交失败时将文件标记为处理失败
+func (uc *MediaUseCase) Submit(ctx context.Context, file *models.FileMetadata) error {
+	if file.Status != models.FileStatusProcessing {
+		return nil
+	}
+	_, err := TaskProcessMedia.Enqueue(ctx, uc.queue, &jobv1.PayLoadProcessMedia{FileId: int64(file.ID)})
+	if err != nil && !errors.Is(err, queue.ErrDuplicateTask) {
+		file.Status = models.FileStatusFailed
+		file.ProcessError = "failed to submit processing task"
+		if uerr := uc.files.UpdateFile(ctx, file, mediaFields...); uerr != nil {
+			logger.Errorf(ctx, "failed to update file %d: %v", file.ID, uerr)
+		}
+		return errors.Wrap(err, "failed to submit media processing task")
+	}
+	return nil
+}
+
+// Process 依次执行文件的处理步骤,每完成一步保存一次进度,重试时跳过已完成的步骤。
+// 全部完成、最后一次重试失败或遇到不可重试的错误后通过 websocket 通知用户
+func (uc *MediaUseCase) Process(ctx context.Context, fileID int64) error {
+	file, err := uc.files.GetFile(ctx, fileID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Warnf(ctx, "file %d not found, skip processing", fileID)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get file")
+	}
+	if file.Status != models.FileStatusProcessing {
+		return nil
+	}
+	if file.Steps == nil {
+		file.Steps = make(models.MediaSteps)
+	}
+	if file.Variants == nil {
+		file.Variants = make(models.MediaVariants)
+	}
+
+	if err := uc.process(ctx, file); err != nil {
+		file.ProcessError = truncate(err.Error(), maxProcessErrorLen)
+		if isLastAttempt(ctx, err) {
+			file.Status = models.FileStatusFailed
+		}
+		if uerr := uc.files.UpdateFile(ctx, file, mediaFields...); uerr != nil {
+			logger.Errorf(ctx, "failed to update file %d: %v", file.ID, uerr)
+		}
+		if file.Status == models.FileStatusFailed {
+			uc.notify(file)
+		}
+		return err
+	}
+
+	file.Status = models.FileStatusReady
+	file.ProcessError = ""
+	if err := uc.files.UpdateFile(ctx, file, mediaFields...); err != nil {
+		return errors.Wrap(err, "failed to update file")
+	}
+	uc.notify(file)
+	return nil
+}
+
+// process 下载原文件到临时目录后执行未完成的步骤
+func (uc *MediaUseCase) process(ctx context.Context, file *models.FileMetadata) error {
+	dir, err := os.MkdirTemp("", "media-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temp directory")
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "source"+path.Ext(file.Filename))
+	downloaded := false
+	for _, step := range mediaSteps[file.FileType] {
+		if file.Steps[step] == models.StepDone {
+			continue
+		}
+		if !downloaded {
+			if err := downloadFile(ctx, uc.store, file.Filename, src); err != nil {
+				return err
+			}
+			downloaded = true
+		}
+		if err := uc.runStep(ctx, file, step, src); err != nil {
+			file.Steps[step] = models.StepFailed
+			return errors.Wrapf(err, "%s failed", step)
+		}
+		file.Steps[step] = models.StepDone
+		if err := uc.files.UpdateFile(ctx, file, mediaFields...); err != nil {
+			return errors.Wrap(err, "failed to save processing progress")
+		}
+	}
+	return nil
+}
+
+func (uc *MediaUseCase) runStep(ctx context.Context, file *models.FileMetadata, step, src string) error {
+	switch step {
+	case stepProbe:
+		return probeMedia(file, src)
+	case stepTranscode:
+		// 已经是H264的视频不需要转码
+		if file.Encoding == "h264" {
+			return nil
+		}
+		out := filepath.Join(filepath.Dir(src), "h264.mp4")
+		if err := util.ConvertToH264(src, out); err != nil {
+			return err
+		}
+		url, _, err := putLocalFile(ctx, uc.store, variantKey(file.Filename, "h264", ".mp4"), out, "video/mp4")
+		if err != nil {
+			return err
+		}
+		file.FileUrl = url
+		file.Variants["h264"] = url
+	case stepPoster:
+		poster, err := videoPoster(src)
+		if err != nil {
+			return err
+		}
+		url, _, err := putLocalFile(ctx, uc.store, variantKey(file.Filename, stepPoster, ".jpg"), poster, "image/jpeg")
+		if err != nil {
+			return err
+		}
+		file.CoverUrl = url
+		file.Variants[stepPoster] = url
+	case stepThumbnail, stepMedium:
+		input := src
+		if file.FileType == models.FileTypeVideo {
+			poster, err := videoPoster(src)
+			if err != nil {
+				return err
+			}
+			input = poster
+		}
+		v := imageVariants[step]
+		out := filepath.Join(filepath.Dir(src), step+".jpg")
+		if err := util.CompressImage(input, out, v.quality, v.size, v.size); err != nil {
+			return err
+		}
+		key := variantKey(file.Filename, step, ".jpg")
+		if file.FileType == models.FileTypeVideo {
+			key = variantKey(file.Filename, "poster_"+step, ".jpg")
+		}
+		url, _, err := putLocalFile(ctx, uc.store, key, out, "image/jpeg")
+		if err != nil {
+			return err
+		}
+		file.Variants[step] = url
+	}
+	return nil
+}
+
+func (uc *MediaUseCase) notify(file *models.FileMetadata) {
+	uc.ws.NotifyUser(strconv.Itoa(file.UserId), mediaProcessedMessage, toFileProto(file))
+}
+
+// probeMedia 读取视频或图片的元数据
+func probeMedia(file *models.FileMetadata, src string) error {
+	if file.FileType != models.FileTypeVideo {
+		width, height, err := util.GetMediaDimensions(src)
+		if err != nil {
+			return err
+		}
+		file.Width, file.Height = width, height
+		return nil
+	}
+
+	meta, err := util.GetVideoMetadata(src)
+	if err != nil {
+		return err
+	}
+	file.Width = meta.Width
+	file.Height = meta.Height
+	file.Fps = meta.FPS
+	file.VideoDuration = meta.Duration
+	file.Encoding = meta.CodecName
+	return nil
+}
+
+// videoPoster 截取视频封面,同一次处理中已截取过时直接复用
+func videoPoster(src string) (string, error) {
+	poster := strings.TrimSuffix(src, filepath.Ext(src)) + "_thumbnail.jpg"
+	if _, err := os.Stat(poster); err == nil {
+		return poster, nil
+	}
+	return util.GenerateThumbnail(src)
+}
+
+// variantKey 衍生文件的对象名,与原文件使用相同的前缀,便于一起清理
+func variantKey(key, name, ext string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "_" + name + ext
+}
+
+// isLastAttempt 任务失败后是否不会再执行: 错误不可重试(用户错误由队列转为不重试),或已是最后一次重试
+func isLastAttempt(ctx context.Context, err error) bool {
+	if errors.Is(err, asynq.SkipRetry) || errors.IsUserError(err) {
+		return true
+	}
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return true
+	}
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return retried >= maxRetry
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
@@ -0,0 +1,302 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/oauth"
+	"github.com/ydssx/kratos-kit/pkg/util"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// defaultOAuthStateTTL 授权请求默认有效期
+const defaultOAuthStateTTL = 10 * time.Minute
+
+var errOAuthStateInvalid = errors.NewUserError("login request is invalid or expired, please try again")
+
+func (uc *UserUseCase) oauthStateTTL() time.Duration {
+	if ttl := uc.c.GetOauth().GetStateTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultOAuthStateTTL
+}
+
+// authorizeURL 生成state、PKCE code_verifier和nonce并返回授权地址,linkUserID不为0时为关联账号流程
+func (uc *UserUseCase) authorizeURL(ctx context.Context, providerName string, linkUserID uint) (string, error) {
+	provider, err := uc.oauth.Get(providerName)
+	if err != nil {
+		return "", errors.NewUserError("unsupported login provider")
+	}
+
+	state, err := generateResetToken()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate oauth state")
+	}
+	nonce, err := generateResetToken()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate oauth nonce")
+	}
+	s := &OAuthState{
+		Provider:     providerName,
+		CodeVerifier: oauth.GenerateVerifier(),
+		Nonce:        nonce,
+		LinkUserID:   linkUserID,
+	}
+	if err = uc.stateRepo.SaveState(ctx, state, s, uc.oauthStateTTL()); err != nil {
+		return "", errors.Wrap(err, "failed to save oauth state")
+	}
+
+	url, err := provider.AuthCodeURL(ctx, state, s.CodeVerifier, s.Nonce)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build auth url")
+	}
+	return url, nil
+}
+
+// OAuthLogin 获取第三方登录授权地址
+func (uc *UserUseCase) OAuthLogin(ctx context.Context, req *userv1.OAuthLoginRequest) (res *userv1.OAuthLoginResponse, err error) {
+	url, err := uc.authorizeURL(ctx, req.Provider, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.OAuthLoginResponse{Url: url}, nil
+}
+
+// GoogleLogin Google登录
+func (uc *UserUseCase) GoogleLogin(ctx context.Context, req *emptypb.Empty) (res *userv1.GoogleLoginResponse, err error) {
+	url, err := uc.authorizeURL(ctx, oauth.TypeGoogle, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.GoogleLoginResponse{Url: url}, nil
+}
+
+// LinkIdentity 获取关联第三方账号的授权地址
+func (uc *UserUseCase) LinkIdentity(ctx context.Context, req *userv1.LinkIdentityRequest) (res *userv1.OAuthLoginResponse, err error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+	url, err := uc.authorizeURL(ctx, req.Provider, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &userv1.OAuthLoginResponse{Url: url}, nil
+}
+
+// OAuthCallback 处理第三方登录回调,校验state后用授权码换取第三方身份并登录或关联账号
+func (uc *UserUseCase) OAuthCallback(ctx context.Context, providerName, code, state string) (*userv1.LoginResponse, error) {
+	if state == "" {
+		return nil, errOAuthStateInvalid
+	}
+	s, err := uc.stateRepo.ConsumeState(ctx, state)
+	if err != nil || s.Provider != providerName {
+		return nil, errOAuthStateInvalid
+	}
+	provider, err := uc.oauth.Get(providerName)
+	if err != nil {
+		return nil, errors.NewUserError("unsupported login provider")
+	}
+
+	identity, err := provider.Exchange(ctx, code, s.CodeVerifier, s.Nonce)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("oauth exchange failed, provider: %s, err: %v", providerName, err)
+		return nil, errors.NewUserError("third-party login failed, please try again")
+	}
+
+	if s.LinkUserID != 0 {
+		if err := uc.linkIdentity(ctx, s.LinkUserID, identity); err != nil {
+			return nil, err
+		}
+		return new(userv1.LoginResponse), nil
+	}
+	return uc.loginWithIdentity(ctx, identity)
+}
+
+// GoogleCallback 处理Google回调,支持授权码回调和Google One Tap的ID Token
+func (uc *UserUseCase) GoogleCallback(ctx *gin.Context) (*userv1.LoginResponse, error) {
+	idToken := ctx.Query("token")
+	if idToken == "" {
+		return uc.OAuthCallback(ctx, oauth.TypeGoogle, ctx.Query("code"), ctx.Query("state"))
+	}
+
+	provider, err := uc.oauth.Get(oauth.TypeGoogle)
+	if err != nil {
+		return nil, errors.NewUserError("unsupported login provider")
+	}
+	verifier, ok := provider.(oauth.IDTokenVerifier)
+	if !ok {
+		return nil, errors.NewUserError("unsupported login provider")
+	}
+	identity, err := verifier.VerifyIDToken(ctx, idToken, "")
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("verify google id token failed: %v", err)
+		return nil, errors.NewUserError("third-party login failed, please try again")
+	}
+	return uc.loginWithIdentity(ctx, identity)
+}
+
+// loginWithIdentity 使用第三方身份登录。
+// 已关联的直接登录;未关联且提供方已验证邮箱时关联到同邮箱的已有账号,否则创建新用户。
+func (uc *UserUseCase) loginWithIdentity(ctx context.Context, identity *oauth.Identity) (*userv1.LoginResponse, error) {
+	linked, err := uc.identityRepo.GetIdentity(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		user, err := uc.repo.GetUserByID(ctx, linked.UserId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		return uc.loginToken(ctx, user)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(err, "failed to get identity")
+	}
+
+	var user *models.User
+	// 未验证的邮箱不能用于关联已有账号,否则可被用来接管他人账号
+	if identity.Email != "" && identity.EmailVerified {
+		user, err = uc.repo.GetUserByEmail(ctx, identity.Email)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+	}
+
+	err = uc.tm.InTx(ctx, func(ctx context.Context) error {
+		if user == nil {
+			user = newUserFromIdentity(ctx, identity)
+			userID, err := uc.repo.CreateUser(ctx, user)
+			if err != nil {
+				return errors.Wrap(err, "failed to create user")
+			}
+			user.ID = uint(userID)
+		}
+		return uc.identityRepo.CreateIdentity(ctx, newUserIdentity(user.ID, identity))
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to link identity")
+	}
+
+	return uc.loginToken(ctx, user)
+}
+
+// linkIdentity 为已登录用户关联第三方账号
+func (uc *UserUseCase) linkIdentity(ctx context.Context, userID uint, identity *oauth.Identity) error {
+	linked, err := uc.identityRepo.GetIdentity(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if linked.UserId == userID {
+			return nil
+		}
+		return errors.NewUserError("this account is already linked to another user")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrap(err, "failed to get identity")
+	}
+
+	identities, err := uc.identityRepo.ListIdentities(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list identities")
+	}
+	for _, i := range identities {
+		if i.Provider == identity.Provider {
+			return errors.NewUserError("an account of this provider is already linked, please unlink it first")
+		}
+	}
+
+	if err = uc.identityRepo.CreateIdentity(ctx, newUserIdentity(userID, identity)); err != nil {
+		return errors.Wrap(err, "failed to link identity")
+	}
+	return nil
+}
+
+// UnlinkIdentity 解除第三方账号关联
+func (uc *UserUseCase) UnlinkIdentity(ctx context.Context, req *userv1.UnlinkIdentityRequest) (res *emptypb.Empty, err error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	identities, err := uc.identityRepo.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list identities")
+	}
+
+	found := false
+	for _, i := range identities {
+		if i.Provider == req.Provider {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.NewUserError("no linked account for this provider")
+	}
+	// 没有密码时至少保留一个第三方账号,避免用户无法登录
+	if user.PasswordHash == "" && len(identities) <= 1 {
+		return nil, errors.NewUserError("cannot unlink the only login method, please set a password first")
+	}
+
+	if _, err = uc.identityRepo.DeleteIdentity(ctx, userID, req.Provider); err != nil {
+		return nil, errors.Wrap(err, "failed to unlink identity")
+	}
+	return new(emptypb.Empty), nil
+}
+
+// ListIdentities 获取已关联的第三方账号
+func (uc *UserUseCase) ListIdentities(ctx context.Context, req *emptypb.Empty) (res *userv1.ListIdentitiesResponse, err error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+
+	identities, err := uc.identityRepo.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list identities")
+	}
+
+	res = new(userv1.ListIdentitiesResponse)
+	for _, i := range identities {
+		res.Identities = append(res.Identities, &userv1.Identity{
+			Provider:  i.Provider,
+			Email:     i.Email,
+			Name:      i.Name,
+			CreatedAt: timestamppb.New(i.CreatedAt.Time),
+		})
+	}
+	return res, nil
+}
+
+func newUserFromIdentity(ctx context.Context, identity *oauth.Identity) *models.User {
+	header := middleware.GetHeaderInfo(ctx)
+	username := identity.Name
+	if username == "" && identity.Email != "" {
+		username = strings.Split(identity.Email, "@")[0]
+	}
+	// 只保存已验证的邮箱,避免占用他人邮箱
+	email := ""
+	if identity.EmailVerified {
+		email = identity.Email
+	}
+	return &models.User{
+		UUID:       util.GetUUID(),
+		Email:      email,
+		Username:   username,
+		FirstName:  identity.GivenName,
+		LastName:   identity.FamilyName,
+		AvatarPath: identity.Picture,
+		Platform:   header.Platform,
+		IPAddress:  header.ClientIP,
+	}
+}
+
+func newUserIdentity(userID uint, identity *oauth.Identity) *models.UserIdentity {
+	return &models.UserIdentity{
+		UserId:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+		Name:     identity.Name,
+	}
+}
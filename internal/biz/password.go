@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/constants"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/util"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// 重置密码令牌有效期
+const passwordResetTTL = 30 * time.Minute
+
+// RequestPasswordReset 申请重置密码,向邮箱发送一次性重置链接。
+// 为避免泄露账号是否存在,邮箱未注册时同样返回成功。
+func (uc *UserUseCase) RequestPasswordReset(ctx context.Context, req *userv1.RequestPasswordResetRequest) (res *emptypb.Empty, err error) {
+	res = new(emptypb.Empty)
+
+	// 无论邮箱是否注册都限制发送频率,防止利用接口向任意邮箱发送大量邮件
+	if err := uc.guard.AllowPasswordReset(ctx, req.Email, middleware.GetHeaderInfo(ctx).ClientIP); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.repo.GetUserByEmail(ctx, req.Email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		uc.log.WithContext(ctx).Infof("password reset requested for unknown email: %s", util.MaskEmail(req.Email))
+		return res, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	token, err := generateResetToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate reset token")
+	}
+
+	err = uc.resetRepo.SaveResetToken(ctx, user.ID, hashResetToken(token), passwordResetTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save reset token")
+	}
+
+	data := struct {
+		ResetURL      string
+		ExpireMinutes int
+	}{
+		ResetURL:      uc.resetPasswordURL(token),
+		ExpireMinutes: int(passwordResetTTL.Minutes()),
+	}
+	err = uc.email.SendTemplate(user.Email, "Reset Password", constants.EmailResetPasswordTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send reset password email")
+	}
+
+	return res, nil
+}
+
+// ResetPassword 使用重置令牌设置新密码,成功后撤销该用户的所有会话
+func (uc *UserUseCase) ResetPassword(ctx context.Context, req *userv1.ResetPasswordRequest) (res *emptypb.Empty, err error) {
+	userID, err := uc.resetRepo.ConsumeResetToken(ctx, hashResetToken(req.Token))
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("consume reset token failed: %v", err)
+		return nil, errors.NewUserError("reset link is invalid or expired")
+	}
+
+	err = uc.setPassword(ctx, userID, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.sessions.RevokeAll(ctx, int64(userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke sessions")
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// ChangePassword 校验旧密码后修改密码,并撤销除当前会话外的其他会话
+func (uc *UserUseCase) ChangePassword(ctx context.Context, req *userv1.ChangePasswordRequest) (res *emptypb.Empty, err error) {
+	claims := middleware.GetClaims(ctx)
+
+	user, err := uc.repo.GetUserByID(ctx, uint(claims.Uid))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	ok, err := uc.hasher.Verify(user.PasswordHash, req.OldPassword)
+	if err != nil || !ok {
+		return nil, errors.NewUserError("password is incorrect")
+	}
+
+	err = uc.setPassword(ctx, user.ID, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.sessions.RevokeAll(ctx, claims.Uid, claims.Sid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke sessions")
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// setPassword 计算并保存新密码哈希
+func (uc *UserUseCase) setPassword(ctx context.Context, userID uint, plain string) error {
+	passwordHash, err := uc.hasher.Hash(plain)
+	if err != nil {
+		return errors.Wrap(err, "failed to hash password")
+	}
+	err = uc.repo.UpdateUser(ctx, int(userID), map[string]interface{}{"password_hash": passwordHash})
+	if err != nil {
+		return errors.Wrap(err, "failed to update password")
+	}
+	return nil
+}
+
+// resetPasswordURL 拼接重置密码链接
+func (uc *UserUseCase) resetPasswordURL(token string) string {
+	u, err := url.Parse(uc.c.GetEmail().GetResetPasswordUrl())
+	if err != nil {
+		return uc.c.GetEmail().GetResetPasswordUrl() + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// generateResetToken 生成随机的重置令牌
+func generateResetToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashResetToken 令牌只以哈希形式存储
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
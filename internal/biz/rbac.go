@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/cache"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// RoleUser 所有登录用户默认拥有的角色
+	RoleUser = "user"
+	// RoleAdmin 管理员账号默认拥有的角色
+	RoleAdmin = "admin"
+
+	// policyCacheTTL 用户权限策略缓存时长。
+	// 分配、撤销角色时会立即清除缓存;修改默认角色的权限时等待缓存过期生效。
+	policyCacheTTL = 5 * time.Minute
+)
+
+// 权限定义
+var (
+	PermUserRead       = rbac.P("user", "read")
+	PermUserUpdate     = rbac.P("user", "update")
+	PermSessionRead    = rbac.P("session", "read")
+	PermSessionRevoke  = rbac.P("session", "revoke")
+	PermPermissionRead = rbac.P("permission", "read")
+
+	PermAdminUserRead        = rbac.P("admin_user", "read")
+	PermAdminUserUpdate      = rbac.P("admin_user", "update")
+	PermAdminUserBan         = rbac.P("admin_user", "ban")
+	PermAdminUserDelete      = rbac.P("admin_user", "delete")
+	PermAdminUserImpersonate = rbac.P("admin_user", "impersonate")
+	PermAuditLogRead         = rbac.P("audit_log", "read")
+	PermAuditLogExport       = rbac.P("audit_log", "export")
+	PermJobRead              = rbac.P("job", "read")
+	PermJobEnqueue           = rbac.P("job", "enqueue")
+	PermJobCancel            = rbac.P("job", "cancel")
+	PermJobRetry             = rbac.P("job", "retry")
+	PermJobDelete            = rbac.P("job", "delete")
+	PermCronJobRead          = rbac.P("cron_job", "read")
+	PermCronJobUpdate        = rbac.P("cron_job", "update")
+	PermCronJobTrigger       = rbac.P("cron_job", "trigger")
+)
+
+// builtinGrants 内置角色的权限,与数据库中为同名角色配置的权限合并生效
+var builtinGrants = map[string][]rbac.Permission{
+	RoleAdmin: {rbac.P(rbac.Wildcard, rbac.Wildcard)},
+	RoleUser:  {PermUserRead, PermUserUpdate, PermSessionRead, PermSessionRevoke},
+}
+
+// RBACUseCase 基于角色的权限控制
+type RBACUseCase struct {
+	repo     RBACRepo
+	userRepo UserRepo
+	cache    cache.Cache
+	log      *log.Helper
+}
+
+func NewRBACUseCase(repo RBACRepo, userRepo UserRepo, cache cache.Cache, logger log.Logger) *RBACUseCase {
+	return &RBACUseCase{repo: repo, userRepo: userRepo, cache: cache, log: log.NewHelper(logger)}
+}
+
+func policyCacheKey(userID uint) string {
+	return fmt.Sprintf("rbac:policy:%d", userID)
+}
+
+// Policy 获取用户的权限策略,结果会被缓存
+func (uc *RBACUseCase) Policy(ctx context.Context, userID uint) (*rbac.Policy, error) {
+	return cache.WithCache(uc.cache, ctx, policyCacheKey(userID), policyCacheTTL, func() (*rbac.Policy, error) {
+		return uc.loadPolicy(ctx, userID)
+	})
+}
+
+// loadPolicy 从数据库加载用户的角色及权限
+func (uc *RBACUseCase) loadPolicy(ctx context.Context, userID uint) (*rbac.Policy, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	roles := []string{RoleUser}
+	if models.UserType(user.Type) == models.UserTypeAdmin {
+		roles = append(roles, RoleAdmin)
+	}
+	assigned, err := uc.repo.ListUserRoles(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user roles")
+	}
+	for _, r := range assigned {
+		if r.Name != RoleUser && r.Name != RoleAdmin {
+			roles = append(roles, r.Name)
+		}
+	}
+
+	policy := &rbac.Policy{Roles: roles}
+	for _, role := range roles {
+		for _, p := range builtinGrants[role] {
+			policy.Grants = append(policy.Grants, rbac.Grant{Role: role, Permission: p})
+		}
+	}
+	grants, err := uc.repo.ListRoleGrants(ctx, roles)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list role grants")
+	}
+	for _, g := range grants {
+		policy.Grants = append(policy.Grants, rbac.Grant{Role: g.Role, Permission: rbac.P(g.Resource, g.Action)})
+	}
+	return policy, nil
+}
+
+// HasPermission 判断用户是否拥有权限,实现 middleware.PermissionChecker
+func (uc *RBACUseCase) HasPermission(ctx context.Context, userID uint, p rbac.Permission) (bool, error) {
+	policy, err := uc.Policy(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return policy.Allow(p), nil
+}
+
+// CheckPermission 校验当前登录用户的权限,无权限时返回 errors.ErrPermissionDenied
+func (uc *RBACUseCase) CheckPermission(ctx context.Context, p rbac.Permission) error {
+	ok, err := uc.HasPermission(ctx, uint(middleware.GetClaims(ctx).Uid), p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// InvalidatePolicy 清除用户的权限策略缓存
+func (uc *RBACUseCase) InvalidatePolicy(ctx context.Context, userIDs ...uint) {
+	for _, id := range userIDs {
+		if err := uc.cache.Delete(ctx, policyCacheKey(id)); err != nil {
+			uc.log.WithContext(ctx).Errorf("delete policy cache failed, user: %d, err: %v", id, err)
+		}
+	}
+}
+
+// AssignRole 为用户分配角色
+func (uc *RBACUseCase) AssignRole(ctx context.Context, userID uint, role string) error {
+	if err := uc.repo.AssignRole(ctx, userID, role); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NewUserError("role not found")
+		}
+		return errors.Wrap(err, "failed to assign role")
+	}
+	uc.InvalidatePolicy(ctx, userID)
+	return nil
+}
+
+// RevokeRole 撤销用户的角色
+func (uc *RBACUseCase) RevokeRole(ctx context.Context, userID uint, role string) error {
+	if _, err := uc.repo.RevokeRole(ctx, userID, role); err != nil {
+		return errors.Wrap(err, "failed to revoke role")
+	}
+	uc.InvalidatePolicy(ctx, userID)
+	return nil
+}
+
+// GrantPermission 为角色授予权限,角色不存在时自动创建
+func (uc *RBACUseCase) GrantPermission(ctx context.Context, role string, p rbac.Permission) error {
+	if err := uc.repo.GrantPermission(ctx, role, p); err != nil {
+		return errors.Wrap(err, "failed to grant permission")
+	}
+	uc.invalidateRole(ctx, role)
+	return nil
+}
+
+// RevokePermission 撤销角色的权限
+func (uc *RBACUseCase) RevokePermission(ctx context.Context, role string, p rbac.Permission) error {
+	if _, err := uc.repo.RevokePermission(ctx, role, p); err != nil {
+		return errors.Wrap(err, "failed to revoke permission")
+	}
+	uc.invalidateRole(ctx, role)
+	return nil
+}
+
+// invalidateRole 清除被分配了角色的用户的策略缓存
+func (uc *RBACUseCase) invalidateRole(ctx context.Context, role string) {
+	userIDs, err := uc.repo.ListRoleUserIDs(ctx, role)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("list role users failed, role: %s, err: %v", role, err)
+		return
+	}
+	uc.InvalidatePolicy(ctx, userIDs...)
+}
+
+// GetUserPermission 获取用户权限,user_id为空时获取当前用户,查看他人权限需要 permission:read 权限
+func (uc *RBACUseCase) GetUserPermission(ctx context.Context, req *userv1.GetUserPermissionRequest) (*userv1.UserPermissionListResponse, error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+	if req.UserId != 0 && uint(req.UserId) != userID {
+		if err := uc.CheckPermission(ctx, PermPermissionRead); err != nil {
+			return nil, err
+		}
+		userID = uint(req.UserId)
+	}
+
+	policy, err := uc.Policy(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NewUserError("user not found")
+		}
+		return nil, err
+	}
+
+	res := new(userv1.UserPermissionListResponse)
+	for _, r := range policy.Resources() {
+		res.Permission = append(res.Permission, &userv1.UserPermission{
+			Resource: r.Resource,
+			Actions:  r.Actions,
+			Roles:    r.Roles,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
+	"github.com/ydssx/kratos-kit/pkg/session"
+	"github.com/ydssx/kratos-kit/pkg/util"
+
+	"github.com/Gre-Z/common/jtime"
+	"github.com/oschwald/geoip2-golang"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// issueToken 为用户创建新会话并签发令牌对
+func (uc *UserUseCase) issueToken(ctx context.Context, user *models.User) (*userv1.LoginResponse, error) {
+	return uc.rotateToken(ctx, user, newSession(ctx, user))
+}
+
+// issueMFAToken 两步验证通过后创建会话并签发令牌对
+func (uc *UserUseCase) issueMFAToken(ctx context.Context, user *models.User) (*userv1.LoginResponse, error) {
+	sess := newSession(ctx, user)
+	sess.MFA = true
+	return uc.rotateToken(ctx, user, sess)
+}
+
+// impersonate 为管理员创建以目标用户身份登录的会话,只返回访问令牌
+func (uc *UserUseCase) impersonate(ctx context.Context, user *models.User, adminID uint) (*userv1.LoginResponse, error) {
+	sess := newSession(ctx, user)
+	sess.ImpersonatorID = int64(adminID)
+	res, err := uc.rotateToken(ctx, user, sess)
+	if err != nil {
+		return nil, err
+	}
+	res.RefreshToken = ""
+	return res, nil
+}
+
+// newSession 根据请求头信息创建会话
+func newSession(ctx context.Context, user *models.User) *session.Session {
+	header := middleware.GetHeaderInfo(ctx)
+	now := time.Now()
+	return &session.Session{
+		ID:         util.GetUUID(),
+		UserID:     int64(user.ID),
+		Device:     platformName(header.Platform),
+		IP:         header.ClientIP,
+		UserAgent:  header.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+}
+
+// rotateToken 在已有会话下签发新的令牌对,旧令牌随之失效。
+// 已签发过令牌的会话按原刷新令牌比较并交换,并发刷新同一会话时只有一个请求成功,其他请求返回 session.ErrRefreshTokenReused
+func (uc *UserUseCase) rotateToken(ctx context.Context, user *models.User, sess *session.Session) (*userv1.LoginResponse, error) {
+	pair, err := uc.jwtManager.IssueTokenPair(jwt.Claims{
+		Uid:      int64(user.ID),
+		Username: user.Username,
+		Type:     user.Type,
+		Uuid:     user.UUID,
+		ClientIP: middleware.GetHeaderInfo(ctx).ClientIP,
+		Sid:      sess.ID,
+		MFA:      sess.MFA,
+		Imp:      sess.ImpersonatorID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to issue token")
+	}
+
+	prevJti := sess.RefreshJti
+	sess.AccessJti = pair.AccessJti
+	sess.RefreshJti = pair.RefreshJti
+	sess.ExpiresAt = pair.RefreshExpiresAt
+	if prevJti == "" {
+		err = uc.sessions.Save(ctx, sess)
+	} else {
+		err = uc.sessions.Rotate(ctx, sess, prevJti)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save session")
+	}
+	// 登录日志和用户地区只在签发或刷新令牌时更新,不在每次请求时写库
+	if sess.ImpersonatorID == 0 {
+		uc.recordLogin(ctx, user, sess.IP)
+	}
+
+	return &userv1.LoginResponse{
+		Uuid:         user.UUID,
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    int64(uc.jwtManager.AccessTokenDuration().Seconds()),
+	}, nil
+}
+
+// recordLogin 记录用户当天的登录日志,用户尚无地区信息时按客户端IP补全。
+// 失败只记录日志,不影响令牌签发
+func (uc *UserUseCase) recordLogin(ctx context.Context, user *models.User, clientIP string) {
+	loginLog := &models.UserLoginLog{
+		UserId:      int(user.ID),
+		LoginDate:   jtime.JsonTime{Time: util.GetDate(time.Now())},
+		IpAddress:   clientIP,
+		CountryName: user.CountryName,
+		CountryCode: user.CountryCode,
+		CityName:    user.CityCode,
+	}
+
+	if user.CountryCode == "" || clientIP != user.IPAddress {
+		city, err := uc.lookupCity(clientIP)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("lookup geo info failed: %v", err)
+		} else if city != nil {
+			loginLog.CountryCode = city.Country.IsoCode
+			loginLog.CountryName = city.Country.Names["en"]
+			loginLog.CityName = city.City.Names["en"]
+			if user.CountryCode == "" {
+				err = uc.repo.UpdateUser(ctx, int(user.ID), models.User{
+					IPAddress:   clientIP,
+					CountryCode: city.Country.IsoCode,
+					CityCode:    city.City.Names["en"],
+					CountryName: city.Country.Names["en"],
+					ZipCode:     city.Postal.Code,
+				})
+				if err != nil {
+					uc.log.WithContext(ctx).Errorf("update user geo info failed: %v", err)
+				}
+			}
+		}
+	}
+
+	if err := uc.repo.RecordLogin(ctx, loginLog); err != nil {
+		uc.log.WithContext(ctx).Errorf("record login log failed: %v", err)
+	}
+}
+
+// lookupCity 根据客户端IP查询地理信息,IP无效或未配置GeoIP库时返回nil
+func (uc *UserUseCase) lookupCity(clientIP string) (*geoip2.City, error) {
+	ip := net.ParseIP(clientIP)
+	if uc.geoip == nil || ip == nil {
+		return nil, nil
+	}
+	return uc.geoip.City(ip)
+}
+
+// ListSessions 获取当前用户的登录会话列表
+func (uc *UserUseCase) ListSessions(ctx context.Context, req *emptypb.Empty) (res *userv1.ListSessionsResponse, err error) {
+	res = new(userv1.ListSessionsResponse)
+	claims := middleware.GetClaims(ctx)
+
+	sessions, err := uc.sessions.List(ctx, claims.Uid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+
+	for _, s := range sessions {
+		res.Sessions = append(res.Sessions, &userv1.Session{
+			Id:         s.ID,
+			Device:     s.Device,
+			Ip:         s.IP,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  timestamppb.New(s.CreatedAt),
+			LastSeenAt: timestamppb.New(s.LastSeenAt),
+			Current:    s.ID == claims.Sid,
+		})
+	}
+
+	return res, nil
+}
+
+// RevokeSession 撤销当前用户的指定会话
+func (uc *UserUseCase) RevokeSession(ctx context.Context, req *userv1.RevokeSessionRequest) (res *emptypb.Empty, err error) {
+	claims := middleware.GetClaims(ctx)
+
+	_, err = uc.sessions.Get(ctx, claims.Uid, req.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrSessionNotFound) {
+			return nil, errors.NewUserError("session not found")
+		}
+		return nil, errors.Wrap(err, "failed to get session")
+	}
+
+	err = uc.sessions.Revoke(ctx, claims.Uid, req.SessionId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke session")
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// RevokeAllSessions 撤销当前用户的所有会话,默认保留当前会话
+func (uc *UserUseCase) RevokeAllSessions(ctx context.Context, req *userv1.RevokeAllSessionsRequest) (res *emptypb.Empty, err error) {
+	claims := middleware.GetClaims(ctx)
+
+	var except []string
+	if !req.IncludeCurrent {
+		except = append(except, claims.Sid)
+	}
+
+	err = uc.sessions.RevokeAll(ctx, claims.Uid, except...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke sessions")
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// platformName 将 X-Platform 转换为设备名称
+func platformName(platform int) string {
+	switch platform {
+	case 1:
+		return "h5"
+	case 2:
+		return "pc"
+	default:
+		return "unknown(" + strconv.Itoa(platform) + ")"
+	}
+}
@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"time"
+
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/pkg/queue"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 队列任务定义,处理函数在 internal/job 中注册
+var (
+	TaskTest = queue.NewTaskType[*jobv1.PayLoadTest](jobv1.JobType_TEST_JOB.String(), queue.TaskOptions{
+		Queue:    queue.QueueLow,
+		MaxRetry: 1,
+	})
+	TaskTestCron = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_TEST_CRON_JOB.String(), queue.TaskOptions{
+		Queue:    queue.QueueLow,
+		MaxRetry: 1,
+	})
+	TaskCleanUserUploadFiles = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_CLEAN_USER_UPLOAD_FILES.String(), queue.TaskOptions{
+		Queue:   queue.QueueLow,
+		Timeout: time.Hour,
+		Unique:  time.Hour,
+		Backoff: &queue.Backoff{Base: 5 * time.Minute, Max: 6 * time.Hour},
+	})
+	TaskCleanOldLogFiles = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_CLEAN_OLD_LOG_FILES.String(), queue.TaskOptions{
+		Queue:   queue.QueueLow,
+		Timeout: 10 * time.Minute,
+		Unique:  time.Hour,
+	})
+	TaskCleanExpiredUploads = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_CLEAN_EXPIRED_UPLOADS.String(), queue.TaskOptions{
+		Queue:   queue.QueueLow,
+		Timeout: 30 * time.Minute,
+		Unique:  30 * time.Minute,
+	})
+	// 同一文件的处理任务在完成前只投递一次,用户等待处理结果,失败后尽快重试
+	TaskProcessMedia = queue.NewTaskType[*jobv1.PayLoadProcessMedia](jobv1.JobType_PROCESS_MEDIA.String(), queue.TaskOptions{
+		MaxRetry: mediaMaxRetry,
+		Timeout:  mediaProcessTimeout,
+		Unique:   mediaProcessTimeout * (mediaMaxRetry + 1),
+		Backoff:  &queue.Backoff{Base: 10 * time.Second, Max: 5 * time.Minute},
+	})
+)
+
+// 以下任务依赖的实例、订阅、积分、视频和字符额度功能尚未接入本项目,
+// 处理函数只把任务归档,不在管理后台开放提交
+var (
+	TaskGoogleInstanceAdjust = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_GOOGLE_INSTANCE_ADJUST.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+	TaskSubscriptionRenewal = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_SUBSCRIPTION_RENEWAL.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+	TaskTimeoutRefund = queue.NewTaskType[*jobv1.PayLoadTaskTimeout](jobv1.JobType_TASK_TIMEOUT_REFUND.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+	TaskUpdateVideoSort = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_UPDATE_VIDEO_SORT.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+	TaskCleanUserExpiredPoints = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_CLEAN_USER_EXPIRED_POINTS.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+	TaskResetDailyCharacters = queue.NewTaskType[*emptypb.Empty](jobv1.JobType_RESET_DAILY_CHARACTERS.String(), queue.TaskOptions{
+		Queue: queue.QueueLow,
+	})
+)
+
+// taskTypes 可通过管理后台提交的任务
+var taskTypes = queue.NewTaskTypes(
+	TaskTest,
+	TaskTestCron,
+	TaskCleanUserUploadFiles,
+	TaskCleanOldLogFiles,
+	TaskCleanExpiredUploads,
+	TaskProcessMedia,
+)
@@ -0,0 +1,266 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/totp"
+	"github.com/ydssx/kratos-kit/pkg/util"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+const (
+	mfaChallengeTTL         = 5 * time.Minute // 两步验证挑战令牌有效期
+	mfaChallengeMaxAttempts = 5               // 单个挑战令牌最多可尝试次数
+	recoveryCodeCount       = 10              // 恢复码数量
+)
+
+var errMFATokenInvalid = errors.NewUserError("two-factor token is invalid or expired")
+
+// mfaChallenge 缓存中的两步验证挑战,错误次数单独计数
+type mfaChallenge struct {
+	UserID    uint      `json:"user_id"`
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func mfaChallengeKey(token string) string {
+	return "mfa:challenge:" + hashResetToken(token)
+}
+
+func mfaAttemptsKey(token string) string {
+	return "mfa:attempts:" + hashResetToken(token)
+}
+
+// discardChallenge 作废挑战令牌并删除错误次数
+func (uc *UserUseCase) discardChallenge(ctx context.Context, token string) error {
+	if err := uc.cache.Delete(ctx, mfaChallengeKey(token)); err != nil {
+		return err
+	}
+	return uc.guard.ClearAttempts(ctx, mfaAttemptsKey(token))
+}
+
+// loginToken 主凭证校验通过后签发令牌,已启用两步验证的账号只返回挑战令牌
+func (uc *UserUseCase) loginToken(ctx context.Context, user *models.User) (*userv1.LoginResponse, error) {
+	if user.IsBanned() {
+		return nil, errors.ErrUserBanned
+	}
+	t, err := uc.totpRepo.GetTOTP(ctx, user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Wrap(err, "failed to get totp")
+	}
+	if err != nil || !t.Enabled {
+		return uc.issueToken(ctx, user)
+	}
+
+	token, err := generateResetToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate mfa token")
+	}
+	err = uc.cache.Set(ctx, mfaChallengeKey(token), mfaChallenge{
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: time.Now().Add(mfaChallengeTTL),
+	}, mfaChallengeTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save mfa challenge")
+	}
+
+	return &userv1.LoginResponse{
+		Uuid:        user.UUID,
+		MfaRequired: true,
+		MfaToken:    token,
+	}, nil
+}
+
+// EnrollTOTP 生成TOTP密钥,确认前不生效,重复调用会替换未确认的密钥
+func (uc *UserUseCase) EnrollTOTP(ctx context.Context, req *emptypb.Empty) (res *userv1.EnrollTOTPResponse, err error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	t, err := uc.totpRepo.GetTOTP(ctx, userID)
+	if err == nil && t.Enabled {
+		return nil, errors.NewUserError("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate totp secret")
+	}
+	encrypted, err := util.Encrypt([]byte(secret), uc.c.Aes.GetKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt totp secret")
+	}
+	if err = uc.totpRepo.SaveTOTPSecret(ctx, userID, encrypted); err != nil {
+		return nil, errors.Wrap(err, "failed to save totp secret")
+	}
+
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+	return &userv1.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthUri: totp.URI(uc.c.Name, account, secret),
+	}, nil
+}
+
+// ConfirmTOTP 校验验证码后启用两步验证,并生成恢复码。
+// 当前会话不会升级为两步验证会话,管理员需重新登录才能访问管理后台。
+func (uc *UserUseCase) ConfirmTOTP(ctx context.Context, req *userv1.ConfirmTOTPRequest) (res *userv1.ConfirmTOTPResponse, err error) {
+	userID := uint(middleware.GetClaims(ctx).Uid)
+
+	t, err := uc.totpRepo.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NewUserError("please enroll two-factor authentication first")
+		}
+		return nil, errors.Wrap(err, "failed to get totp")
+	}
+	if t.Enabled {
+		return nil, errors.NewUserError("two-factor authentication is already enabled")
+	}
+
+	secret, err := util.Decrypt(t.Secret, uc.c.Aes.GetKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt totp secret")
+	}
+	step, ok := totp.Validate(secret, req.Code, time.Now())
+	if !ok {
+		return nil, errors.NewUserError("verification code is incorrect")
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate recovery codes")
+	}
+	if err = uc.totpRepo.EnableTOTP(ctx, userID, step, hashes); err != nil {
+		return nil, errors.Wrap(err, "failed to enable totp")
+	}
+
+	return &userv1.ConfirmTOTPResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyTOTP 使用挑战令牌和验证码(或恢复码)完成登录
+func (uc *UserUseCase) VerifyTOTP(ctx context.Context, req *userv1.VerifyTOTPRequest) (res *userv1.LoginResponse, err error) {
+	var challenge mfaChallenge
+	if err := uc.cache.Get(ctx, mfaChallengeKey(req.MfaToken), &challenge); err != nil {
+		return nil, errMFATokenInvalid
+	}
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl < time.Second {
+		return nil, errMFATokenInvalid
+	}
+
+	// 两步验证失败同样计入账号/IP的登录失败次数
+	clientIP := middleware.GetHeaderInfo(ctx).ClientIP
+	if err := uc.guard.CheckLogin(ctx, challenge.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	// 校验前原子递增尝试次数,并发请求合计最多校验 mfaChallengeMaxAttempts 次
+	attempts, err := uc.guard.CountAttempt(ctx, mfaAttemptsKey(req.MfaToken), ttl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count mfa attempts")
+	}
+	if attempts > mfaChallengeMaxAttempts {
+		_ = uc.discardChallenge(ctx, req.MfaToken)
+		return nil, errMFATokenInvalid
+	}
+
+	ok, err := uc.verifySecondFactor(ctx, challenge.UserID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if attempts >= mfaChallengeMaxAttempts {
+			_ = uc.discardChallenge(ctx, req.MfaToken)
+		}
+		if lockErr := uc.guard.LoginFailed(ctx, challenge.Email, clientIP); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, errors.NewUserError("verification code is incorrect")
+	}
+
+	// 挑战令牌只能使用一次
+	if err := uc.discardChallenge(ctx, req.MfaToken); err != nil {
+		return nil, errors.Wrap(err, "failed to consume mfa challenge")
+	}
+	if err := uc.guard.LoginSucceeded(ctx, challenge.Email); err != nil {
+		uc.log.WithContext(ctx).Errorf("reset login attempts failed: %v", err)
+	}
+
+	user, err := uc.repo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user.IsBanned() {
+		return nil, errors.ErrUserBanned
+	}
+	return uc.issueMFAToken(ctx, user)
+}
+
+// verifySecondFactor 校验TOTP验证码或恢复码,同一验证码和恢复码均只能使用一次
+func (uc *UserUseCase) verifySecondFactor(ctx context.Context, userID uint, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		ok, err := uc.totpRepo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+		if err != nil {
+			return false, errors.Wrap(err, "failed to use recovery code")
+		}
+		return ok, nil
+	}
+
+	t, err := uc.totpRepo.GetTOTP(ctx, userID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get totp")
+	}
+	secret, err := util.Decrypt(t.Secret, uc.c.Aes.GetKey())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decrypt totp secret")
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	ok, err = uc.totpRepo.UseTOTPStep(ctx, userID, step)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to record totp step")
+	}
+	return ok, nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes 生成恢复码,格式为 xxxxx-xxxxx,返回明文和哈希
+func generateRecoveryCodes(n int) (codes, hashes []string, err error) {
+	for i := 0; i < n; i++ {
+		b := make([]byte, 7)
+		if _, err = rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 恢复码忽略大小写和分隔符,只以哈希形式存储
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return hashResetToken(code)
+}
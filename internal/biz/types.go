@@ -1,15 +1,9 @@
 package biz
 
-import goauth2 "google.golang.org/api/oauth2/v2"
-
 type UploadResult struct {
 	FileUrl      string `json:"file_url"`
 	ThumbnailURL string `json:"thumbnail_url"`
 	FileId       int    `json:"file_id"`
 	FileName     string `json:"file_name"`
-}
-
-type GoogleClaims struct {
-	Sub string `json:"sub"`
-	*goauth2.Userinfo
+	Status       string `json:"status"` // 处理状态,processing 时可通过 GetFile 轮询或等待 websocket 通知
 }
@@ -3,9 +3,6 @@ package biz
 import (
 	"context"
 	"fmt"
-	"io"
-	"runtime"
-	"strings"
 
 	// "math"
 	"mime/multipart"
@@ -16,7 +13,6 @@ import (
 	"github.com/ydssx/kratos-kit/common/conf"
 	"github.com/ydssx/kratos-kit/models"
 	"github.com/ydssx/kratos-kit/pkg/errors"
-	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/storage"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
@@ -47,23 +43,21 @@ func (uc *UploadUseCase) UploadFile(c *gin.Context, userID int, file *multipart.
 	}
 	defer src.Close()
 
-	// 读取文件内容
-	fileBytes, err := io.ReadAll(src)
-	if err != nil {
-		return nil, fmt.Errorf("Failed to read file: %v", err)
-	}
-
-	if len(fileBytes) > 8*1024*1024 {
+	if file.Size > 8*1024*1024 {
 		return nil, errors.NewUserError("file size exceeds 8MB")
 	}
 
-	file_md5 := util.MD5Bytes(fileBytes)
+	file_md5, err := util.MD5Reader(src)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read file: %v", err)
+	}
 
 	fileInfo, err := models.NewFileMetadataModel().SetMd5(file_md5).SetUserId(int64(userID)).FirstOne()
 	if err == nil {
 		result.FileId = int(fileInfo.ID)
 		result.FileUrl = fileInfo.FileUrl
 		result.ThumbnailURL = fileInfo.CoverUrl
+		result.Status = string(fileInfo.Status)
 		return result, nil
 	}
 
@@ -76,19 +70,19 @@ func (uc *UploadUseCase) UploadFile(c *gin.Context, userID int, file *multipart.
 		return nil, errors.NewUserError("file type is not supported")
 	}
 
-	// TODO: 本地存储路径需要配置
-	// 创建本地存储路径
-	uploadDir := "C:\\uploads\\"
-	if runtime.GOOS == "linux" {
-		uploadDir = "/tmp/uploads/"
+	// 每次上传使用独立的临时目录,避免并发上传互相删除文件
+	uploadDir, err := os.MkdirTemp("", "uploads-")
+	if err != nil {
+		log.Error("Failed to create upload directory:", err)
+		return nil, err
 	}
+	defer os.RemoveAll(uploadDir) // 删除本地存储路径
 	filePath := filepath.Join(uploadDir, storedName)
 
 	if err := c.SaveUploadedFile(file, filePath); err != nil {
-		log.Error("Failed to create upload directory:", err)
+		log.Error("Failed to save uploaded file:", err)
 		return nil, err
 	}
-	defer os.RemoveAll(uploadDir) // 删除本地存储路径
 
 	saveToStorage := true
 	defer func() {
@@ -104,6 +98,7 @@ func (uc *UploadUseCase) UploadFile(c *gin.Context, userID int, file *multipart.
 			result.FileId = int(fileInfo.ID)
 			result.FileUrl = fileInfo.FileUrl
 			result.ThumbnailURL = fileInfo.CoverUrl
+			result.Status = string(fileInfo.Status)
 		}
 	}()
 
@@ -123,7 +118,7 @@ func (uc *UploadUseCase) UploadFile(c *gin.Context, userID int, file *multipart.
 	return result, errInfo
 }
 
-// 清理用户上传文件
+// 清理用户上传文件,文件和缩略图等衍生文件使用相同的前缀,按前缀列出后一起删除
 func (uc *UploadUseCase) CleanUploadFile(ctx context.Context) error {
 	users, err := models.NewUserModel().SetUserType(models.UserTypeNormal).PluckIds()
 	if err != nil {
@@ -134,17 +129,7 @@ func (uc *UploadUseCase) CleanUploadFile(ctx context.Context) error {
 		if file.FileType == models.FileTypeImage {
 			continue
 		}
-		err = uc.store.DeleteFile(ctx, file.Filename)
-		if err != nil {
-			logger.Errorf(ctx, "Failed to delete file %s: %s", file.Filename, err.Error())
-		}
-		if file.CoverUrl != "" {
-			err = uc.store.DeleteFile(ctx, strings.TrimSuffix(file.Filename, filepath.Ext(file.Filename))+"_thumbnail.jpg")
-			if err != nil {
-				logger.Errorf(ctx, "Failed to delete file %s: %s", file.CoverUrl, err.Error())
-			}
-		}
-		if err == nil || strings.Contains(err.Error(), "object doesn't exist") {
+		if deleteFileObjects(ctx, uc.store, fileObjectKey(&file)) {
 			models.NewFileMetadataModel().SetIds(int64(file.ID)).Delete()
 		}
 	}
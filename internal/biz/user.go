@@ -2,12 +2,12 @@ package biz
 
 import (
 	"context"
-	"net/url"
 	"strings"
 	"sync"
 	"time"
 
 	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/common/conf"
 	"github.com/ydssx/kratos-kit/constants"
 	"github.com/ydssx/kratos-kit/internal/middleware"
 	"github.com/ydssx/kratos-kit/models"
@@ -17,13 +17,13 @@ import (
 	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/lock"
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/oauth"
+	"github.com/ydssx/kratos-kit/pkg/password"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
-	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/log"
-	"golang.org/x/oauth2"
-	goauth2 "google.golang.org/api/oauth2/v2"
-	"google.golang.org/api/option"
+	"github.com/oschwald/geoip2-golang"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
@@ -35,9 +35,19 @@ type UserUseCase struct {
 	commonUc          *CommonUseCase
 	mu                sync.Mutex
 	locker            lock.Locker
-	googleOauthConfig *oauth2.Config
+	oauth             *oauth.Registry
 	cache             cache.Cache
 	email             *email.Email
+	jwtManager        *jwt.Manager
+	sessions          session.Store
+	hasher            password.Hasher
+	resetRepo         PasswordResetRepo
+	guard             *LoginGuard
+	totpRepo          TOTPRepo
+	stateRepo         OAuthStateRepo
+	identityRepo      IdentityRepo
+	geoip             *geoip2.Reader
+	c                 *conf.Bootstrap
 }
 
 func NewUserUseCase(
@@ -46,9 +56,19 @@ func NewUserUseCase(
 	transaction Transaction,
 	commonUc *CommonUseCase,
 	locker lock.Locker,
-	googleOauthConfig *oauth2.Config,
+	oauthRegistry *oauth.Registry,
 	cache cache.Cache,
 	email *email.Email,
+	jwtManager *jwt.Manager,
+	sessions session.Store,
+	hasher password.Hasher,
+	resetRepo PasswordResetRepo,
+	guard *LoginGuard,
+	totpRepo TOTPRepo,
+	stateRepo OAuthStateRepo,
+	identityRepo IdentityRepo,
+	geoip *geoip2.Reader,
+	c *conf.Bootstrap,
 ) *UserUseCase {
 	return &UserUseCase{
 		repo:              userRepo,
@@ -56,9 +76,19 @@ func NewUserUseCase(
 		tm:                transaction,
 		commonUc:          commonUc,
 		locker:            locker,
-		googleOauthConfig: googleOauthConfig,
+		oauth:             oauthRegistry,
 		cache:             cache,
 		email:             email,
+		jwtManager:        jwtManager,
+		sessions:          sessions,
+		hasher:            hasher,
+		resetRepo:         resetRepo,
+		guard:             guard,
+		totpRepo:          totpRepo,
+		stateRepo:         stateRepo,
+		identityRepo:      identityRepo,
+		geoip:             geoip,
+		c:                 c,
 	}
 }
 
@@ -69,6 +99,36 @@ func (uc *UserUseCase) Login(ctx context.Context, req *userv1.LoginRequest) (*us
 		return nil, errors.NewUserError("email is required")
 	}
 
+	if req.Password == "" && req.Code == "" {
+		return nil, errors.NewUserError("please provide a password or verification code")
+	}
+
+	// 账号或IP已被锁定时直接拒绝
+	clientIP := middleware.GetHeaderInfo(ctx).ClientIP
+	if err := uc.guard.CheckLogin(ctx, req.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.authenticate(ctx, req)
+	if err != nil {
+		// 只有凭证错误才计入失败次数
+		if errors.IsUserError(err) {
+			if lockErr := uc.guard.LoginFailed(ctx, req.Email, clientIP); lockErr != nil {
+				return nil, lockErr
+			}
+		}
+		return nil, err
+	}
+	if err := uc.guard.LoginSucceeded(ctx, req.Email); err != nil {
+		uc.log.WithContext(ctx).Errorf("reset login attempts failed: %v", err)
+	}
+
+	// 签发令牌,开启两步验证的账号返回挑战令牌
+	return uc.loginToken(ctx, user)
+}
+
+// authenticate 校验密码或验证码,返回登录用户
+func (uc *UserUseCase) authenticate(ctx context.Context, req *userv1.LoginRequest) (*models.User, error) {
 	// 根据邮箱获取用户
 	user, err := uc.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil {
@@ -78,23 +138,37 @@ func (uc *UserUseCase) Login(ctx context.Context, req *userv1.LoginRequest) (*us
 	// 验证密码或验证码
 	if req.Password != "" {
 		// 密码登录
-		if util.MD5(req.Password) != user.PasswordHash {
+		ok, err := uc.hasher.Verify(user.PasswordHash, req.Password)
+		if err != nil || !ok {
 			return nil, errors.NewUserError("password is incorrect")
 		}
-	} else if req.Code != "" {
-		// 验证码登录
-		code, err := uc.GetVerificationCode(ctx, req.Email)
-		if err != nil || code != req.Code {
-			return nil, errors.NewUserError("verification code is incorrect or expired")
+		// 旧算法或旧参数生成的哈希,登录成功后升级
+		if uc.hasher.NeedsRehash(user.PasswordHash) {
+			uc.rehashPassword(ctx, user, req.Password)
 		}
-	} else {
-		return nil, errors.NewUserError("please provide a password or verification code")
+		return user, nil
 	}
 
-	// 返回登录响应
-	return &userv1.LoginResponse{
-		Uuid: user.UUID,
-	}, nil
+	// 验证码登录,验证码校验成功后即作废
+	if err := uc.guard.VerifyCode(ctx, req.Email, req.Code); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// rehashPassword 使用当前默认算法重新计算密码哈希,失败不影响登录
+func (uc *UserUseCase) rehashPassword(ctx context.Context, user *models.User, plain string) {
+	passwordHash, err := uc.hasher.Hash(plain)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("rehash password failed: %v", err)
+		return
+	}
+	err = uc.repo.UpdateUser(ctx, int(user.ID), map[string]interface{}{"password_hash": passwordHash})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("update password hash failed: %v", err)
+		return
+	}
+	user.PasswordHash = passwordHash
 }
 
 // GetUser 根据userID获取用户信息
@@ -121,7 +195,6 @@ func (uc *UserUseCase) GetUser(ctx context.Context, g *emptypb.Empty) (*userv1.G
 func (uc *UserUseCase) Create(ctx context.Context, req *userv1.CreateRequest) (res *userv1.LoginResponse, err error) {
 	// TODO 根据x-u-key和token校验客户端动态加密结果
 	header := middleware.GetHeaderInfo(ctx)
-	res = &userv1.LoginResponse{}
 
 	if req.XUKey != "" {
 		err = uc.locker.Lock(ctx, req.XUKey, lock.WithTTL(time.Second*2))
@@ -138,24 +211,23 @@ func (uc *UserUseCase) Create(ctx context.Context, req *userv1.CreateRequest) (r
 
 	user, err := uc.repo.GetUserByBrowserFingerprint(ctx, req.XUKey)
 	if err == nil && user.IPAddress == header.ClientIP && user.Email == "" {
-		res.Uuid = user.UUID
-		return
+		return uc.issueToken(ctx, user)
 	}
 
 	// 创建用户
-	uuid := util.GetUUID()
-	res.Uuid = uuid
-	_, err = uc.repo.CreateUser(ctx, &models.User{
-		UUID:               uuid,
+	user = &models.User{
+		UUID:               util.GetUUID(),
 		Platform:           header.Platform,
 		BrowserFingerprint: req.XUKey,
 		IPAddress:          header.ClientIP,
-	})
+	}
+	userId, err := uc.repo.CreateUser(ctx, user)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create user")
 	}
+	user.ID = uint(userId)
 
-	return res, nil
+	return uc.issueToken(ctx, user)
 }
 
 // IsAccountExist 检测邮箱账号是否存在
@@ -174,142 +246,23 @@ func (uc *UserUseCase) IsAccountExist(ctx context.Context, req *userv1.IsAccount
 	}, nil
 }
 
-// 处理Google回调
-func (uc *UserUseCase) GoogleCallback(ctx *gin.Context) (*userv1.LoginResponse, error) {
-	code := ctx.Query("code")
-	// state := ctx.Query("state")
-	jwtToken := ctx.Query("token")
-	code, _ = url.QueryUnescape(code)
-
-	var userInfo *goauth2.Userinfo
-	var err error
-	var token *oauth2.Token
-	if jwtToken != "" {
-		googleClaims, err := uc.parseJWTToken(jwtToken)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to verify jwt token")
-		}
-		userInfo = googleClaims.Userinfo
-		userInfo.Id = googleClaims.Sub
-	} else {
-		token, err = uc.googleOauthConfig.Exchange(ctx, code)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to exchange token")
-		}
-
-		service, err := goauth2.NewService(ctx, option.WithTokenSource(uc.googleOauthConfig.TokenSource(ctx, token)))
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to create service")
-		}
-
-		userInfo, err = service.Userinfo.Get().Do()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get user info")
-		}
-	}
-
-	user, err := uc.repo.GetUserByGoogleID(ctx, userInfo.Id)
-	if err != nil {
-		user, err = uc.repo.GetUserByEmail(ctx, userInfo.Email)
-		if err == nil {
-			goto updateUser
-		}
-
-		uuid := util.GetUUID()
-		_, err := uc.repo.CreateUser(ctx, &models.User{
-			UUID:       uuid,
-			Email:      userInfo.Email,
-			Username:   userInfo.Name,
-			FirstName:  userInfo.GivenName,
-			LastName:   userInfo.FamilyName,
-			AvatarPath: userInfo.Picture,
-			GoogleId:   userInfo.Id,
-		})
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to create user")
-		}
-		return &userv1.LoginResponse{
-			Uuid: uuid,
-		}, nil
-
-	}
-
-updateUser:
-	// 更新用户的Google ID和头像
-	user.GoogleId = userInfo.Id
-	user.AvatarPath = userInfo.Picture
-	user.Email = userInfo.Email
-	user.Username = userInfo.Name
-	user.FirstName = userInfo.GivenName
-	user.LastName = userInfo.FamilyName
-	err = uc.repo.UpdateUserByID(ctx, int(user.ID), user)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to update user")
-	}
-
-	return &userv1.LoginResponse{
-		Uuid: user.UUID,
-	}, nil
-}
-
-// GoogleLogin Google登录
-func (uc *UserUseCase) GoogleLogin(ctx context.Context, req *emptypb.Empty) (res *userv1.GoogleLoginResponse, err error) {
-	res = new(userv1.GoogleLoginResponse)
-
-	claim := middleware.GetClaims(ctx)
-
-	res.Url = uc.googleOauthConfig.AuthCodeURL(claim.Uuid, oauth2.AccessTypeOffline)
-
-	return
-}
-
-// JWT令牌解析
-func (uc *UserUseCase) parseJWTToken(token string) (user *GoogleClaims, err error) {
-	claims, err := jwt.DecodeJWT(token)
-	if err != nil {
-		return nil, err
-	}
-	err = util.MapDecode(claims.Payload, &user)
-	if err != nil {
-		return nil, err
-	}
-	return
-}
-
-// Logout 用户登出
+// Logout 用户登出,撤销当前会话
 func (uc *UserUseCase) Logout(ctx context.Context, req *emptypb.Empty) (res *userv1.LoginResponse, err error) {
-	res = new(userv1.LoginResponse)
-	header := middleware.GetHeaderInfo(ctx)
-
-	uuid := util.GetUUID()
-	user := &models.User{
-		UUID:      uuid,
-		Type:      int(models.UserTypeLogout),
-		IPAddress: header.ClientIP,
-		Platform:  header.Platform,
-	}
+	claims := middleware.GetClaims(ctx)
 
-	_, err = uc.repo.CreateUser(ctx, user)
+	err = uc.sessions.Revoke(ctx, claims.Uid, claims.Sid)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create user")
+		return nil, errors.Wrap(err, "failed to revoke session")
 	}
 
-	res.Uuid = uuid
-
-	return
+	return new(userv1.LoginResponse), nil
 }
 
 // Register 用户注册
 func (uc *UserUseCase) Register(ctx context.Context, req *userv1.RegisterRequest) (res *userv1.LoginResponse, err error) {
-	res = new(userv1.LoginResponse)
-
-	// 验证验证码
-	storedCode, err := uc.GetVerificationCode(ctx, req.Email)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get verification code")
-	}
-	if storedCode != req.Code {
-		return nil, errors.NewUserError("verification code is incorrect")
+	// 验证验证码,校验成功后即作废
+	if err := uc.guard.VerifyCode(ctx, req.Email, req.Code); err != nil {
+		return nil, err
 	}
 
 	// 检查邮箱是否已被注册
@@ -321,44 +274,79 @@ func (uc *UserUseCase) Register(ctx context.Context, req *userv1.RegisterRequest
 		return nil, errors.NewUserError("email already registered")
 	}
 
+	passwordHash, err := uc.hasher.Hash(req.Password)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash password")
+	}
+
 	newUser := &models.User{
 		UUID:         util.GetUUID(),
 		Email:        req.Email,
-		PasswordHash: util.MD5(req.Password),
+		PasswordHash: passwordHash,
 		IPAddress:    middleware.GetHeaderInfo(ctx).ClientIP,
 		Username:     strings.Split(req.Email, "@")[0],
 	}
 
-	_, err = uc.repo.CreateUser(ctx, newUser)
+	userId, err := uc.repo.CreateUser(ctx, newUser)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create user")
 	}
+	newUser.ID = uint(userId)
 
-	res.Uuid = newUser.UUID
+	return uc.issueToken(ctx, newUser)
+}
 
-	// 删除验证码
-	// err = uc.cache.Delete(ctx, "verification:"+req.Email)
-	// if err != nil {
-	// 	uc.log.Errorf("删除验证码失败: %v", err)
-	// }
+// RefreshToken 使用刷新令牌换取新的令牌对,会话保持不变
+func (uc *UserUseCase) RefreshToken(ctx context.Context, req *userv1.RefreshTokenRequest) (*userv1.LoginResponse, error) {
+	claims, err := uc.jwtManager.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, errors.Unauthorized
+	}
 
-	return res, nil
-}
+	sess, err := uc.sessions.Get(ctx, claims.Uid, claims.Sid)
+	if err != nil {
+		return nil, errors.Unauthorized
+	}
+	// 刷新令牌只能使用一次,重复使用视为令牌泄露,直接撤销整个会话;
+	// 管理员以用户身份登录的会话不允许刷新
+	if sess.RefreshJti != claims.ID || sess.ImpersonatorID != 0 {
+		uc.revokeSession(ctx, claims.Uid, claims.Sid)
+		return nil, errors.Unauthorized
+	}
+
+	// 重新加载用户,确保令牌中的用户信息是最新的
+	user, err := uc.repo.GetUserByID(ctx, uint(claims.Uid))
+	if err != nil {
+		return nil, errors.Unauthorized
+	}
+	if user.IsBanned() {
+		return nil, errors.ErrUserBanned
+	}
 
-func (uc *UserUseCase) SetVerificationCode(ctx context.Context, email, code string) error {
-	return uc.cache.Set(ctx, "verification:"+email, code, 5*time.Minute)
+	// 轮换时再次原子比较刷新令牌,并发使用同一刷新令牌的请求只有一个成功
+	res, err := uc.rotateToken(ctx, user, sess)
+	if errors.Is(err, session.ErrRefreshTokenReused) {
+		uc.revokeSession(ctx, claims.Uid, claims.Sid)
+		return nil, errors.Unauthorized
+	}
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return nil, errors.Unauthorized
+	}
+	return res, err
 }
 
-func (uc *UserUseCase) GetVerificationCode(ctx context.Context, email string) (code string, err error) {
-	err = uc.cache.Get(ctx, "verification:"+email, &code)
-	return
+// revokeSession 撤销刷新令牌被重复使用的会话
+func (uc *UserUseCase) revokeSession(ctx context.Context, uid int64, sid string) {
+	if err := uc.sessions.Revoke(ctx, uid, sid); err != nil {
+		uc.log.WithContext(ctx).Errorf("revoke session failed: %v", err)
+	}
 }
 
 // SendVerificationCode 发送验证码
 func (uc *UserUseCase) SendVerificationCode(ctx context.Context, req *userv1.SendVerificationCodeRequest) (res *emptypb.Empty, err error) {
-	_, err = uc.GetVerificationCode(ctx, req.Email)
-	if err == nil {
-		return nil, errors.ErrVerificationCodeSent
+	// 发送频率限制: 同一邮箱冷却时间及邮箱/IP每日上限
+	if err := uc.guard.AllowSendCode(ctx, req.Email, middleware.GetHeaderInfo(ctx).ClientIP); err != nil {
+		return nil, err
 	}
 
 	// 生成随机验证码
@@ -371,7 +359,7 @@ func (uc *UserUseCase) SendVerificationCode(ctx context.Context, req *userv1.Sen
 	}
 
 	// 将验证码存储到 Redis
-	err = uc.SetVerificationCode(ctx, req.Email, code)
+	err = uc.guard.SaveCode(ctx, req.Email, code)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to store verification code")
 	}
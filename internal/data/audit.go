@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+)
+
+var _ biz.AuditRepo = (*auditRepo)(nil)
+
+type auditRepo struct {
+	data *Data
+}
+
+func NewAuditRepo(data *Data) biz.AuditRepo {
+	return &auditRepo{data: data}
+}
+
+// BatchCreateAuditLogs implements biz.AuditRepo.
+func (r *auditRepo) BatchCreateAuditLogs(ctx context.Context, logs []*models.AdminAuditLog) error {
+	return models.NewAdminAuditLogModel(r.data.DB(ctx)).BatchCreate(logs)
+}
+
+// PageListAuditLogs implements biz.AuditRepo.
+func (r *auditRepo) PageListAuditLogs(ctx context.Context, cond *biz.AuditLogCond) ([]models.AdminAuditLog, int64, error) {
+	return models.NewAdminAuditLogModel(r.data.DB(ctx)).Filter(auditLogFilter(cond)).
+		PageList(cond.PageSize, (cond.Page-1)*cond.PageSize)
+}
+
+// ListAuditLogsBefore implements biz.AuditRepo.
+func (r *auditRepo) ListAuditLogsBefore(ctx context.Context, cond *biz.AuditLogCond, beforeID uint, limit int) ([]models.AdminAuditLog, error) {
+	f := auditLogFilter(cond)
+	f.IdLt = beforeID
+	return models.NewAdminAuditLogModel(r.data.DB(ctx)).Filter(f).List(limit)
+}
+
+func auditLogFilter(cond *biz.AuditLogCond) models.AdminAuditLogFilter {
+	return models.AdminAuditLogFilter{
+		ActorId:     cond.ActorID,
+		Operation:   cond.Operation,
+		Target:      cond.Target,
+		Result:      cond.Result,
+		CreatedFrom: cond.CreatedFrom,
+		CreatedTo:   cond.CreatedTo,
+	}
+}
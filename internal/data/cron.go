@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+)
+
+// cronJobsChangedChannel 定时任务变更通知的频道
+const cronJobsChangedChannel = "cron_jobs:changed"
+
+var _ biz.CronJobRepo = (*cronJobRepo)(nil)
+
+type cronJobRepo struct {
+	data *Data
+}
+
+func NewCronJobRepo(data *Data) biz.CronJobRepo {
+	return &cronJobRepo{data: data}
+}
+
+// ListEnabledCronJobs implements biz.CronJobRepo.
+func (r *cronJobRepo) ListEnabledCronJobs(ctx context.Context) ([]models.CronJob, error) {
+	return models.NewCronJobModel(r.data.DB(ctx)).SetEnabled(true).List()
+}
+
+// PageListCronJobs implements biz.CronJobRepo.
+func (r *cronJobRepo) PageListCronJobs(ctx context.Context, page, pageSize int) ([]models.CronJob, int64, error) {
+	return models.NewCronJobModel(r.data.DB(ctx)).PageList(pageSize, (page-1)*pageSize)
+}
+
+// GetCronJob implements biz.CronJobRepo.
+func (r *cronJobRepo) GetCronJob(ctx context.Context, id uint) (*models.CronJob, error) {
+	job, err := models.NewCronJobModel(r.data.DB(ctx)).SetId(id).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// CreateCronJob implements biz.CronJobRepo.
+func (r *cronJobRepo) CreateCronJob(ctx context.Context, job *models.CronJob) error {
+	return models.NewCronJobModel(r.data.DB(ctx)).Create(job)
+}
+
+// UpdateCronJob implements biz.CronJobRepo.
+func (r *cronJobRepo) UpdateCronJob(ctx context.Context, job *models.CronJob, fields ...string) error {
+	return models.NewCronJobModel(r.data.DB(ctx)).SetId(job.ID).Select(fields...).Updates(job)
+}
+
+// CountCronJobsByType implements biz.CronJobRepo.
+func (r *cronJobRepo) CountCronJobsByType(ctx context.Context, jobType string) (int64, error) {
+	return models.NewCronJobModel(r.data.DB(ctx)).SetJobType(jobType).Count()
+}
+
+// CreateCronJobRun implements biz.CronJobRepo.
+func (r *cronJobRepo) CreateCronJobRun(ctx context.Context, run *models.CronJobRun) error {
+	return models.NewCronJobRunModel(r.data.DB(ctx)).Create(run)
+}
+
+// UpdateCronJobRun implements biz.CronJobRepo.
+func (r *cronJobRepo) UpdateCronJobRun(ctx context.Context, run *models.CronJobRun, fields ...string) error {
+	return models.NewCronJobRunModel(r.data.DB(ctx)).SetId(run.ID).Select(fields...).Updates(run)
+}
+
+// ListLatestCronJobRuns implements biz.CronJobRepo.
+func (r *cronJobRepo) ListLatestCronJobRuns(ctx context.Context, cronJobIDs ...uint) ([]models.CronJobRun, error) {
+	return models.NewCronJobRunModel(r.data.DB(ctx)).ListLatest(cronJobIDs...)
+}
+
+// NotifyCronJobsChanged implements biz.CronJobRepo.
+func (r *cronJobRepo) NotifyCronJobsChanged(ctx context.Context) error {
+	return r.data.rdb.Publish(ctx, cronJobsChangedChannel, "").Err()
+}
+
+// WatchCronJobsChanged implements biz.CronJobRepo.
+func (r *cronJobRepo) WatchCronJobsChanged(ctx context.Context) <-chan struct{} {
+	ch := make(chan struct{}, 1)
+	sub := r.data.rdb.Subscribe(ctx, cronJobsChangedChannel)
+	go func() {
+		defer close(ch)
+		defer sub.Close()
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-msgs:
+				// 合并连续的通知
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return ch
+}
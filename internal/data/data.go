@@ -22,6 +22,15 @@ var ProviderSet = wire.NewSet(
 	common.NewMysqlDB,
 	NewTransaction,
 	NewUserRepo,
+	NewPasswordResetRepo,
+	NewTOTPRepo,
+	NewOAuthStateRepo,
+	NewIdentityRepo,
+	NewRBACRepo,
+	NewAuditRepo,
+	NewFileRepo,
+	NewUploadSessionRepo,
+	NewCronJobRepo,
 )
 
 // Data .
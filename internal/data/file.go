@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+)
+
+var (
+	_ biz.FileRepo          = (*fileRepo)(nil)
+	_ biz.UploadSessionRepo = (*uploadSessionRepo)(nil)
+)
+
+type fileRepo struct {
+	data *Data
+}
+
+func NewFileRepo(data *Data) biz.FileRepo {
+	return &fileRepo{data: data}
+}
+
+// GetUserFileByMd5 implements biz.FileRepo.
+func (r *fileRepo) GetUserFileByMd5(ctx context.Context, userID int, md5 string) (*models.FileMetadata, error) {
+	file, err := models.NewFileMetadataModel(r.data.DB(ctx)).SetMd5(md5).SetUserId(int64(userID)).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// CreateFile implements biz.FileRepo.
+func (r *fileRepo) CreateFile(ctx context.Context, file *models.FileMetadata) error {
+	_, err := models.NewFileMetadataModel(r.data.DB(ctx)).Create(file)
+	return err
+}
+
+// GetFile implements biz.FileRepo.
+func (r *fileRepo) GetFile(ctx context.Context, id int64) (*models.FileMetadata, error) {
+	file, err := models.NewFileMetadataModel(r.data.DB(ctx)).SetIds(id).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// UpdateFile implements biz.FileRepo.
+func (r *fileRepo) UpdateFile(ctx context.Context, file *models.FileMetadata, fields ...string) error {
+	return models.NewFileMetadataModel(r.data.DB(ctx)).SetIds(int64(file.ID)).Select(fields...).Updates(file)
+}
+
+// PageListFiles implements biz.FileRepo.
+func (r *fileRepo) PageListFiles(ctx context.Context, cond *biz.FileCond) ([]models.FileMetadata, int64, error) {
+	m := models.NewFileMetadataModel(r.data.DB(ctx)).SetUserId(int64(cond.UserID))
+	if cond.FileType != "" {
+		m.SetFileType(cond.FileType)
+	}
+	return m.Order("id DESC").PageList(cond.PageSize, (cond.Page-1)*cond.PageSize)
+}
+
+// DeleteFile implements biz.FileRepo.
+func (r *fileRepo) DeleteFile(ctx context.Context, id int64) error {
+	return models.NewFileMetadataModel(r.data.DB(ctx)).SetIds(id).Delete()
+}
+
+// CountFilesByFilename implements biz.FileRepo.
+func (r *fileRepo) CountFilesByFilename(ctx context.Context, filename string) (int64, error) {
+	return models.NewFileMetadataModel(r.data.DB(ctx)).SetFilename(filename).Count()
+}
+
+// SumUserFileSize implements biz.FileRepo.
+func (r *fileRepo) SumUserFileSize(ctx context.Context, userID int) (int64, error) {
+	return models.NewFileMetadataModel(r.data.DB(ctx)).SetUserId(int64(userID)).SumFileSize()
+}
+
+// LockUserQuota implements biz.FileRepo.
+func (r *fileRepo) LockUserQuota(ctx context.Context, userID int) error {
+	_, err := models.NewUserModel(r.data.DB(ctx)).SetIds(userID).Select("id").XLock().FirstOne()
+	return err
+}
+
+type uploadSessionRepo struct {
+	data *Data
+}
+
+func NewUploadSessionRepo(data *Data) biz.UploadSessionRepo {
+	return &uploadSessionRepo{data: data}
+}
+
+// CreateUploadSession implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) CreateUploadSession(ctx context.Context, s *models.UploadSession) error {
+	return models.NewUploadSessionModel(r.data.DB(ctx)).Create(s)
+}
+
+// GetUploadSession implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) GetUploadSession(ctx context.Context, uploadID string) (*models.UploadSession, error) {
+	s, err := models.NewUploadSessionModel(r.data.DB(ctx)).SetUploadId(uploadID).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// FindPendingUploadSession implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) FindPendingUploadSession(ctx context.Context, userID int, md5 string, size int64) (*models.UploadSession, error) {
+	s, err := models.NewUploadSessionModel(r.data.DB(ctx)).SetUserId(userID).SetMd5(md5).SetFileSize(size).
+		SetStatus(models.UploadStatusPending).ExpiresAtGt(time.Now()).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// UpdateUploadSessionStatus implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) UpdateUploadSessionStatus(ctx context.Context, id uint, from, to string) (bool, error) {
+	// 条件更新保证并发修改同一会话时只有一个请求成功
+	affected, err := models.NewUploadSessionModel(r.data.DB(ctx)).SetId(id).SetStatus(from).
+		UpdatesAffected(map[string]interface{}{"status": to})
+	return affected > 0, err
+}
+
+// DeleteUploadSession implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) DeleteUploadSession(ctx context.Context, id uint) error {
+	return models.NewUploadSessionModel(r.data.DB(ctx)).SetId(id).Delete()
+}
+
+// ListExpiredUploadSessions implements biz.UploadSessionRepo.
+func (r *uploadSessionRepo) ListExpiredUploadSessions(ctx context.Context, now time.Time, limit int) ([]models.UploadSession, error) {
+	return models.NewUploadSessionModel(r.data.DB(ctx)).ExpiresAtLte(now).List(limit)
+}
@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+var (
+	_ biz.OAuthStateRepo = (*oauthStateRepo)(nil)
+	_ biz.IdentityRepo   = (*identityRepo)(nil)
+)
+
+// errOAuthStateInvalid state不存在、已过期或已被使用
+var errOAuthStateInvalid = errors.New("oauth state invalid")
+
+type oauthStateRepo struct {
+	data *Data
+}
+
+func NewOAuthStateRepo(data *Data) biz.OAuthStateRepo {
+	return &oauthStateRepo{data: data}
+}
+
+func oauthStateKey(state string) string {
+	return "oauth:state:" + state
+}
+
+// SaveState implements biz.OAuthStateRepo.
+func (r *oauthStateRepo) SaveState(ctx context.Context, state string, s *biz.OAuthState, ttl time.Duration) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "marshal oauth state error")
+	}
+	return errors.Wrap(r.data.rdb.Set(ctx, oauthStateKey(state), b, ttl).Err(), "save oauth state error")
+}
+
+// ConsumeState implements biz.OAuthStateRepo.
+func (r *oauthStateRepo) ConsumeState(ctx context.Context, state string) (*biz.OAuthState, error) {
+	b, err := r.data.rdb.GetDel(ctx, oauthStateKey(state)).Bytes()
+	if err == goredis.Nil {
+		return nil, errOAuthStateInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "consume oauth state error")
+	}
+	s := new(biz.OAuthState)
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, errors.Wrap(err, "unmarshal oauth state error")
+	}
+	return s, nil
+}
+
+type identityRepo struct {
+	data *Data
+}
+
+func NewIdentityRepo(data *Data) biz.IdentityRepo {
+	return &identityRepo{data: data}
+}
+
+// GetIdentity implements biz.IdentityRepo.
+func (r *identityRepo) GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	return models.NewUserIdentityModel(r.data.DB(ctx)).SetProvider(provider).SetSubject(subject).FirstOne()
+}
+
+// ListIdentities implements biz.IdentityRepo.
+func (r *identityRepo) ListIdentities(ctx context.Context, userID uint) ([]models.UserIdentity, error) {
+	return models.NewUserIdentityModel(r.data.DB(ctx)).SetUserId(userID).Order("id ASC").List()
+}
+
+// CreateIdentity implements biz.IdentityRepo.
+func (r *identityRepo) CreateIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	return models.NewUserIdentityModel(r.data.DB(ctx)).Create(identity)
+}
+
+// DeleteIdentity implements biz.IdentityRepo.
+func (r *identityRepo) DeleteIdentity(ctx context.Context, userID uint, provider string) (bool, error) {
+	affected, err := models.NewUserIdentityModel(r.data.DB(ctx)).SetUserId(userID).SetProvider(provider).DeleteAffected()
+	return affected > 0, err
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+var _ biz.PasswordResetRepo = (*passwordResetRepo)(nil)
+
+// errResetTokenInvalid 重置令牌不存在、已过期或已被使用
+var errResetTokenInvalid = errors.New("reset token invalid")
+
+type passwordResetRepo struct {
+	data *Data
+}
+
+func NewPasswordResetRepo(data *Data) biz.PasswordResetRepo {
+	return &passwordResetRepo{data: data}
+}
+
+func resetTokenKey(tokenHash string) string {
+	return "password_reset:token:" + tokenHash
+}
+
+func resetUserKey(userID uint) string {
+	return fmt.Sprintf("password_reset:user:%d", userID)
+}
+
+// SaveResetToken implements biz.PasswordResetRepo.
+func (r *passwordResetRepo) SaveResetToken(ctx context.Context, userID uint, tokenHash string, ttl time.Duration) error {
+	// 使之前申请的令牌失效
+	old, err := r.data.rdb.Get(ctx, resetUserKey(userID)).Result()
+	if err != nil && err != goredis.Nil {
+		return errors.Wrap(err, "get reset token error")
+	}
+
+	pipe := r.data.rdb.TxPipeline()
+	if old != "" {
+		pipe.Del(ctx, resetTokenKey(old))
+	}
+	pipe.Set(ctx, resetTokenKey(tokenHash), userID, ttl)
+	pipe.Set(ctx, resetUserKey(userID), tokenHash, ttl)
+	_, err = pipe.Exec(ctx)
+	return errors.Wrap(err, "save reset token error")
+}
+
+// ConsumeResetToken implements biz.PasswordResetRepo.
+func (r *passwordResetRepo) ConsumeResetToken(ctx context.Context, tokenHash string) (uint, error) {
+	// GETDEL 保证并发请求下令牌只会被消费一次
+	userID, err := r.data.rdb.GetDel(ctx, resetTokenKey(tokenHash)).Uint64()
+	if err == goredis.Nil {
+		return 0, errResetTokenInvalid
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "consume reset token error")
+	}
+	r.data.rdb.Del(ctx, resetUserKey(uint(userID)))
+	return uint(userID), nil
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+
+	"gorm.io/gorm"
+)
+
+var _ biz.RBACRepo = (*rbacRepo)(nil)
+
+type rbacRepo struct {
+	data *Data
+}
+
+func NewRBACRepo(data *Data) biz.RBACRepo {
+	return &rbacRepo{data: data}
+}
+
+// ListUserRoles implements biz.RBACRepo.
+func (r *rbacRepo) ListUserRoles(ctx context.Context, userID uint) ([]models.Role, error) {
+	return models.NewRoleModel(r.data.DB(ctx)).ListUserRoles(userID)
+}
+
+// ListRoleGrants implements biz.RBACRepo.
+func (r *rbacRepo) ListRoleGrants(ctx context.Context, roles []string) ([]models.RoleGrant, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+	return models.NewRoleModel(r.data.DB(ctx)).SetNames(roles).ListGrants()
+}
+
+// ListRoleUserIDs implements biz.RBACRepo.
+func (r *rbacRepo) ListRoleUserIDs(ctx context.Context, role string) ([]uint, error) {
+	rl, err := models.NewRoleModel(r.data.DB(ctx)).SetName(role).FirstOne()
+	if err != nil {
+		return nil, err
+	}
+	return models.NewUserRoleModel(r.data.DB(ctx)).SetRoleId(rl.ID).UserIds()
+}
+
+// AssignRole implements biz.RBACRepo.
+func (r *rbacRepo) AssignRole(ctx context.Context, userID uint, role string) error {
+	rl, err := models.NewRoleModel(r.data.DB(ctx)).SetName(role).FirstOne()
+	if err != nil {
+		return err
+	}
+	return models.NewUserRoleModel(r.data.DB(ctx)).FirstOrCreate(&models.UserRole{UserId: userID, RoleId: rl.ID})
+}
+
+// RevokeRole implements biz.RBACRepo.
+func (r *rbacRepo) RevokeRole(ctx context.Context, userID uint, role string) (bool, error) {
+	rl, err := models.NewRoleModel(r.data.DB(ctx)).SetName(role).FirstOne()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	affected, err := models.NewUserRoleModel(r.data.DB(ctx)).SetUserId(userID).SetRoleId(rl.ID).DeleteAffected()
+	return affected > 0, err
+}
+
+// GrantPermission implements biz.RBACRepo.
+func (r *rbacRepo) GrantPermission(ctx context.Context, role string, p rbac.Permission) error {
+	return r.data.WithTx(ctx, func(ctx context.Context) error {
+		rl, err := models.NewRoleModel(r.data.DB(ctx)).SetName(role).FirstOne()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			rl = &models.Role{Name: role}
+			err = models.NewRoleModel(r.data.DB(ctx)).Create(rl)
+		}
+		if err != nil {
+			return err
+		}
+
+		perm, err := models.NewPermissionModel(r.data.DB(ctx)).SetResource(p.Resource).SetAction(p.Action).FirstOne()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			perm = &models.Permission{Resource: p.Resource, Action: p.Action}
+			err = models.NewPermissionModel(r.data.DB(ctx)).Create(perm)
+		}
+		if err != nil {
+			return err
+		}
+
+		return models.NewRolePermissionModel(r.data.DB(ctx)).FirstOrCreate(&models.RolePermission{RoleId: rl.ID, PermissionId: perm.ID})
+	})
+}
+
+// RevokePermission implements biz.RBACRepo.
+func (r *rbacRepo) RevokePermission(ctx context.Context, role string, p rbac.Permission) (bool, error) {
+	rl, err := models.NewRoleModel(r.data.DB(ctx)).SetName(role).FirstOne()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	perm, err := models.NewPermissionModel(r.data.DB(ctx)).SetResource(p.Resource).SetAction(p.Action).FirstOne()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	affected, err := models.NewRolePermissionModel(r.data.DB(ctx)).SetRoleId(rl.ID).SetPermissionId(perm.ID).DeleteAffected()
+	return affected > 0, err
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	"gorm.io/gorm"
+)
+
+var _ biz.TOTPRepo = (*totpRepo)(nil)
+
+type totpRepo struct {
+	data *Data
+}
+
+func NewTOTPRepo(data *Data) biz.TOTPRepo {
+	return &totpRepo{data: data}
+}
+
+// GetTOTP implements biz.TOTPRepo.
+func (r *totpRepo) GetTOTP(ctx context.Context, userID uint) (*models.UserTotp, error) {
+	return models.NewUserTotpModel(r.data.DB(ctx)).SetUserId(userID).FirstOne()
+}
+
+// SaveTOTPSecret implements biz.TOTPRepo.
+func (r *totpRepo) SaveTOTPSecret(ctx context.Context, userID uint, encryptedSecret string) error {
+	_, err := r.GetTOTP(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.NewUserTotpModel(r.data.DB(ctx)).Create(&models.UserTotp{
+			UserId: userID,
+			Secret: encryptedSecret,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	// 只允许覆盖未启用的配置
+	return models.NewUserTotpModel(r.data.DB(ctx)).SetUserId(userID).Where("enabled = ?", false).
+		Updates(map[string]interface{}{"secret": encryptedSecret, "last_used_step": 0})
+}
+
+// EnableTOTP implements biz.TOTPRepo.
+func (r *totpRepo) EnableTOTP(ctx context.Context, userID uint, step int64, recoveryCodeHashes []string) error {
+	return r.data.WithTx(ctx, func(ctx context.Context) error {
+		affected, err := models.NewUserTotpModel(r.data.DB(ctx)).SetUserId(userID).Where("enabled = ?", false).
+			UpdatesAffected(map[string]interface{}{"enabled": true, "last_used_step": step, "confirmed_at": time.Now()})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return r.replaceRecoveryCodes(ctx, userID, recoveryCodeHashes)
+	})
+}
+
+// replaceRecoveryCodes 删除旧恢复码并写入新的恢复码
+func (r *totpRepo) replaceRecoveryCodes(ctx context.Context, userID uint, hashes []string) error {
+	if err := models.NewUserRecoveryCodeModel(r.data.DB(ctx)).SetUserId(userID).Delete(); err != nil {
+		return err
+	}
+	codes := make([]models.UserRecoveryCode, 0, len(hashes))
+	for _, h := range hashes {
+		codes = append(codes, models.UserRecoveryCode{UserId: userID, CodeHash: h})
+	}
+	return models.NewUserRecoveryCodeModel(r.data.DB(ctx)).BatchCreate(codes)
+}
+
+// UseTOTPStep implements biz.TOTPRepo.
+func (r *totpRepo) UseTOTPStep(ctx context.Context, userID uint, step int64) (bool, error) {
+	// 条件更新保证同一时间步的验证码只能使用一次
+	affected, err := models.NewUserTotpModel(r.data.DB(ctx)).SetUserId(userID).LastUsedStepLt(step).
+		UpdatesAffected(map[string]interface{}{"last_used_step": step})
+	return affected > 0, err
+}
+
+// UseRecoveryCode implements biz.TOTPRepo.
+func (r *totpRepo) UseRecoveryCode(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	affected, err := models.NewUserRecoveryCodeModel(r.data.DB(ctx)).SetUserId(userID).SetCodeHash(codeHash).Unused().
+		UpdatesAffected(map[string]interface{}{"used_at": time.Now()})
+	return affected > 0, err
+}
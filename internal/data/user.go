@@ -22,11 +22,6 @@ type userRepo struct {
 	log  *log.Helper
 }
 
-// GetUserByGoogleID implements biz.UserRepo.
-func (r *userRepo) GetUserByGoogleID(ctx context.Context, googleID string) (*models.User, error) {
-	return models.NewUserModel(r.data.DB(ctx)).SetGoogleID(googleID).FirstOne()
-}
-
 // GetUserByBrowserFingerprint implements biz.UserRepo.
 func (r *userRepo) GetUserByBrowserFingerprint(ctx context.Context, fingerprint string) (*models.User, error) {
 	return models.NewUserModel(r.data.DB(ctx)).SetBrowserFingerprint(fingerprint).FirstOne()
@@ -37,6 +32,18 @@ func (r *userRepo) GetUserVisitCount(ctx context.Context, domain string, startTi
 }
 
 // GetUserByIDWithLock implements biz.UserRepo.
+// RecordLogin 记录用户当天的登录日志,当天已有记录时跳过
+func (r *userRepo) RecordLogin(ctx context.Context, loginLog *models.UserLoginLog) error {
+	_, err := models.NewUserLoginLogModel(r.data.DB(ctx)).SetUserId(loginLog.UserId).SetLoginDate(loginLog.LoginDate.Time).FirstOne()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return models.NewUserLoginLogModel(r.data.DB(ctx)).Create(*loginLog)
+}
+
 func (r *userRepo) GetUserByIDWithLock(ctx context.Context, id uint) (*models.User, error) {
 	if !r.data.IsInTx(ctx) {
 		return nil, errors.New("context is not in tx")
@@ -137,6 +144,27 @@ func (r *userRepo) ListUser(ctx context.Context, cond *biz.ListUserCond) []model
 	return users
 }
 
+// PageListUser implements biz.UserRepo.
+func (r *userRepo) PageListUser(ctx context.Context, cond *biz.PageListUserCond) ([]models.User, int64, error) {
+	m := models.NewUserModel(r.data.DB(ctx))
+	if cond.Type != nil {
+		m.SetUserType(*cond.Type)
+	}
+	if cond.EmailPrefix != "" {
+		m.EmailPrefix(cond.EmailPrefix)
+	}
+	if cond.CountryCode != "" {
+		m.SetCountryCode(cond.CountryCode)
+	}
+	if !cond.CreatedFrom.IsZero() {
+		m.CreatedAtGte(cond.CreatedFrom)
+	}
+	if !cond.CreatedTo.IsZero() {
+		m.CreatedAtLt(cond.CreatedTo)
+	}
+	return m.Order("id DESC").PageList(cond.PageSize, (cond.Page-1)*cond.PageSize)
+}
+
 // GetTotalUsers 获取总用户数
 func (r *userRepo) GetTotalUsers(ctx context.Context) (int, error) {
 	total, err := models.NewUserModel(r.data.DB(ctx)).Count()
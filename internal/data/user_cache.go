@@ -62,3 +62,17 @@ func (u *UserRepoCacheDecorator) UpdateUser(ctx context.Context, userId int, use
 
 	return nil
 }
+
+// DeleteUser 删除用户,并删除与该用户相关的缓存
+func (u *UserRepoCacheDecorator) DeleteUser(ctx context.Context, id uint) error {
+	if err := u.userRepo.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("user:%v", id)
+	if err := u.Cache.Delete(ctx, key); err != nil {
+		logger.Errorf(ctx, "删除缓存失败：%v", err)
+	}
+
+	return nil
+}
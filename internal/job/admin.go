@@ -1,23 +1,12 @@
 package job
 
 import (
-	"fmt"
-
-	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
 	"github.com/ydssx/kratos-kit/pkg/queue"
 )
 
-var (
-	// CronJobMap 定时任务注册
-	AdminCronJobMap = map[string]jobv1.AdminJob{}
-
-	// JobHandlerMap 任务处理函数注册
-	AdminJobHandlerMap = map[jobv1.AdminJob]queue.HandleFunc{}
-)
-
-func ValidateAdminTask(jobType jobv1.AdminJob) error {
-	if _, ok := AdminJobHandlerMap[jobType]; !ok {
-		return fmt.Errorf("the cron job [%s] does not have any registered handlers", jobType.String())
-	}
-	return nil
+// NewAdminRegistry 注册管理后台的任务处理函数和定时任务。
+// 报表任务尚未实现,jobv1.AdminJob 暂不校验是否都注册了处理函数
+func NewAdminRegistry() (*queue.Registry, error) {
+	r := queue.NewRegistry()
+	return r, r.Validate(nil)
 }
@@ -5,54 +5,92 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/hibiken/asynq"
 	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
 	"github.com/ydssx/kratos-kit/constants"
 	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
+	"github.com/hibiken/asynq"
 	"github.com/ydssx/kratos-kit/pkg/queue"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var (
-	// 定时任务注册
-	CronJobMap = map[string]jobv1.JobType{
-		// "0 0 * * *":   jobv1.JobType_RESET_DAILY_CHARACTERS,
-		// "*/2 * * * *": jobv1.JobType_TASK_TIMEOUT_REFUND, // 每2分钟执行一次
-		"10 0 * * *": jobv1.JobType_CLEAN_OLD_LOG_FILES,
-	}
+// NewRegistry 注册任务处理函数和默认的定时任务,每个任务类型都需要注册处理函数。
+// 定时任务保存在数据库中,默认的定时任务在任务类型还没有定时任务时写入数据库
+func NewRegistry() (*queue.Registry, error) {
+	r := queue.NewRegistry()
 
-	// 任务处理函数注册
-	JobHandlerMap = map[jobv1.JobType]queue.HandleFunc{
-		jobv1.JobType_CLEAN_OLD_LOG_FILES: ClearLogFile,
-	}
-)
+	queue.Handle(r, biz.TaskTest, TestJob)
+	queue.Handle(r, biz.TaskTestCron, TestCronJob)
+	queue.Handle(r, biz.TaskCleanUserUploadFiles, ClearUserUploadFile)
+	queue.Handle(r, biz.TaskCleanOldLogFiles, ClearLogFile)
+	queue.Handle(r, biz.TaskCleanExpiredUploads, ClearExpiredUploads)
+	queue.Handle(r, biz.TaskProcessMedia, ProcessMedia)
+	queue.Handle(r, biz.TaskGoogleInstanceAdjust, notImplemented(biz.TaskGoogleInstanceAdjust))
+	queue.Handle(r, biz.TaskSubscriptionRenewal, notImplemented(biz.TaskSubscriptionRenewal))
+	queue.Handle(r, biz.TaskTimeoutRefund, notImplemented(biz.TaskTimeoutRefund))
+	queue.Handle(r, biz.TaskUpdateVideoSort, notImplemented(biz.TaskUpdateVideoSort))
+	queue.Handle(r, biz.TaskCleanUserExpiredPoints, notImplemented(biz.TaskCleanUserExpiredPoints))
+	queue.Handle(r, biz.TaskResetDailyCharacters, notImplemented(biz.TaskResetDailyCharacters))
 
-func ValidateTask(jobType jobv1.JobType) error {
-	if _, ok := JobHandlerMap[jobType]; !ok {
-		return fmt.Errorf("the cron job [%s] does not have any registered handlers", jobType.String())
-	}
-	return nil
+	queue.Cron(r, "10 0 * * *", biz.TaskCleanOldLogFiles, &emptypb.Empty{})
+	queue.Cron(r, "30 * * * *", biz.TaskCleanExpiredUploads, &emptypb.Empty{}) // 每小时执行一次
+
+	return r, r.Validate(jobv1.JobType(0).Descriptor())
 }
 
 // ====================================================================================
 //                        以下为定时任务和队列任务处理函数
 // ====================================================================================
 
+// 队列任务：测试任务
+func TestJob(ctx context.Context, payload *jobv1.PayLoadTest) error {
+	logger.Infof(ctx, "测试任务执行成功:%s", payload.Msg)
+	return nil
+}
+
+// 定时任务：测试定时任务
+func TestCronJob(ctx context.Context, _ *emptypb.Empty) error {
+	logger.Info(ctx, "测试定时任务执行成功")
+	return nil
+}
+
 // 定时任务：清理用户上传文件
-func ClearUserUploadFile(ctx context.Context, _ *asynq.Task) error {
+func ClearUserUploadFile(ctx context.Context, _ *emptypb.Empty) error {
 	if err := biz.UsecaseSetFromContext(ctx).UploadBiz.CleanUploadFile(ctx); err != nil {
 		logger.Errorf(ctx, "清理用户上传文件异常:%s", err.Error())
 	}
 	return nil
 }
 
+// 定时任务：清理过期的分片上传会话
+func ClearExpiredUploads(ctx context.Context, _ *emptypb.Empty) error {
+	if err := biz.UsecaseSetFromContext(ctx).FileBiz.CleanExpiredUploads(ctx); err != nil {
+		logger.Errorf(ctx, "清理过期上传会话异常:%s", err.Error())
+	}
+	return nil
+}
+
+// 队列任务：处理上传的图片和视频,返回错误时由队列重试
+func ProcessMedia(ctx context.Context, payload *jobv1.PayLoadProcessMedia) error {
+	return biz.UsecaseSetFromContext(ctx).MediaBiz.Process(ctx, payload.FileId)
+}
+
 // 定时任务：清理日志文件
-func ClearLogFile(ctx context.Context, _ *asynq.Task) error {
+func ClearLogFile(ctx context.Context, _ *emptypb.Empty) error {
 	err := util.DeleteOldFiles(os.Getenv(string(constants.EnvKeyLogPath)), 30)
 	if err != nil {
 		logger.Errorf(ctx, "清理日志文件异常:%s", err.Error())
 	}
 	return nil
 }
+
+// notImplemented 尚未接入业务的任务,不重试直接归档,便于在管理后台查看
+func notImplemented[P proto.Message](t *queue.TaskType[P]) func(context.Context, P) error {
+	return func(ctx context.Context, _ P) error {
+		logger.Warnf(ctx, "任务[%s]尚未实现,已归档", t.Name())
+		return fmt.Errorf("task %s is not implemented: %w", t.Name(), asynq.SkipRetry)
+	}
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hibiken/asynq"
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/pkg/queue"
+)
+
+// RecordCronRun 记录定时任务的每次执行,包括立即执行和重试
+func RecordCronRun(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		cronID, err := strconv.ParseUint(queue.CronID(t), 10, 64)
+		if err != nil {
+			return next.ProcessTask(ctx, t)
+		}
+
+		uc := biz.UsecaseSetFromContext(ctx).CronBiz
+		taskID, _ := asynq.GetTaskID(ctx)
+		attempt, _ := asynq.GetRetryCount(ctx)
+		run := uc.StartCronRun(ctx, uint(cronID), t.Type(), taskID, attempt)
+		err = next.ProcessTask(ctx, t)
+		uc.FinishCronRun(ctx, run, err)
+		return err
+	})
+}
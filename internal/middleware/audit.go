@@ -0,0 +1,243 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/ydssx/kratos-kit/models"
+	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditEntry 审计日志条目
+type AuditEntry struct {
+	ActorID   uint
+	Operation string
+	Target    string
+	Request   string // 请求参数JSON,敏感字段已脱敏
+	Before    string // 修改前的数据JSON,敏感字段已脱敏
+	After     string // 修改后的数据JSON,敏感字段已脱敏
+	ClientIP  string
+	TraceID   string
+	Result    string // models.AuditResultSuccess/Failure/Denied
+	Reason    string
+	Latency   time.Duration
+	CreatedAt time.Time
+}
+
+// AuditRecorder 审计日志记录器,Record 不能阻塞请求
+type AuditRecorder interface {
+	Record(ctx context.Context, e *AuditEntry)
+}
+
+// auditRecordKey 审计中间件放入 ctx 的记录
+type auditRecordKey struct{}
+
+// auditRecord 认证中间件和业务逻辑补充的审计信息
+type auditRecord struct {
+	mu      sync.Mutex
+	actorID uint
+	before  string
+	after   string
+}
+
+func withAuditRecord(ctx context.Context, rec *auditRecord) context.Context {
+	return context.WithValue(ctx, auditRecordKey{}, rec)
+}
+
+// setAuditActor 认证中间件解析出令牌后记录操作人,认证失败的请求同样可以审计到操作人
+func setAuditActor(ctx context.Context, uid int64) {
+	if rec, ok := ctx.Value(auditRecordKey{}).(*auditRecord); ok {
+		rec.mu.Lock()
+		rec.actorID = uint(uid)
+		rec.mu.Unlock()
+	}
+}
+
+// RecordAuditChange 记录写操作修改前后的数据,before/after 为字段名到值的映射,敏感字段会脱敏。
+// 请求不经过审计中间件时忽略
+func RecordAuditChange(ctx context.Context, before, after map[string]interface{}) {
+	rec, ok := ctx.Value(auditRecordKey{}).(*auditRecord)
+	if !ok {
+		return
+	}
+	rec.mu.Lock()
+	rec.before, rec.after = marshalMasked(before), marshalMasked(after)
+	rec.mu.Unlock()
+}
+
+// Audit 审计中间件,记录所有写操作(GET/HEAD/OPTIONS以外的请求),需放在认证中间件之前,
+// 认证失败的请求同样会被记录,未解析出令牌时操作人为0。
+// resources 为operation到资源名的映射,请求包含id时操作对象记为 资源名:id。
+func Audit(recorder AuditRecorder, resources map[string]string) middleware.Middleware {
+	return func(h middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return h(ctx, req)
+			}
+			r, isHTTP := http.RequestFromServerContext(ctx)
+			if isHTTP && isSafeMethod(r.Method) {
+				return h(ctx, req)
+			}
+
+			start := time.Now()
+			rec := &auditRecord{actorID: uint(GetClaims(ctx).Uid)}
+			reply, err := h(withAuditRecord(ctx, rec), req)
+
+			rec.mu.Lock()
+			entry := &AuditEntry{
+				ActorID:   rec.actorID,
+				Operation: tr.Operation(),
+				Target:    auditTarget(resources[tr.Operation()], req),
+				Request:   auditRequest(req),
+				Before:    rec.before,
+				After:     rec.after,
+				TraceID:   kratos.TraceIDFromContext(ctx),
+				Latency:   time.Since(start),
+				CreatedAt: start,
+			}
+			rec.mu.Unlock()
+			if isHTTP {
+				entry.ClientIP = getClientIP(r)
+			}
+			entry.Result, entry.Reason = auditResult(err)
+			recorder.Record(ctx, entry)
+
+			return reply, err
+		}
+	}
+}
+
+// AuditGin Gin审计中间件,记录所有写操作,需放在认证中间件之前
+func AuditGin(recorder AuditRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isSafeMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		rec := &auditRecord{}
+		c.Request = c.Request.WithContext(withAuditRecord(c.Request.Context(), rec))
+		c.Next()
+
+		rec.mu.Lock()
+		entry := &AuditEntry{
+			ActorID:   uint(c.GetInt("user_id")),
+			Operation: c.Request.Method + " " + c.FullPath(),
+			Request:   auditForm(c),
+			Before:    rec.before,
+			After:     rec.after,
+			ClientIP:  getClientIP(c.Request),
+			TraceID:   kratos.TraceIDFromContext(c.Request.Context()),
+			Latency:   time.Since(start),
+			CreatedAt: start,
+		}
+		if entry.ActorID == 0 {
+			entry.ActorID = rec.actorID
+		}
+		rec.mu.Unlock()
+		var lastErr error
+		if e := c.Errors.Last(); e != nil {
+			lastErr = e.Err
+		}
+		entry.Result, entry.Reason = auditResult(lastErr)
+		if status := c.Writer.Status(); entry.Result == models.AuditResultSuccess && status >= stdhttp.StatusBadRequest {
+			entry.Result, entry.Reason = models.AuditResultFailure, stdhttp.StatusText(status)
+			if status == stdhttp.StatusUnauthorized || status == stdhttp.StatusForbidden {
+				entry.Result = models.AuditResultDenied
+			}
+		}
+		recorder.Record(c.Request.Context(), entry)
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == stdhttp.MethodGet || method == stdhttp.MethodHead || method == stdhttp.MethodOptions
+}
+
+// auditTarget 根据资源名和请求中的id生成操作对象
+func auditTarget(resource string, req interface{}) string {
+	r, ok := req.(interface{ GetId() uint64 })
+	if !ok || r.GetId() == 0 {
+		return resource
+	}
+	if resource == "" {
+		resource = "id"
+	}
+	return resource + ":" + strconv.FormatUint(r.GetId(), 10)
+}
+
+// auditRequest 将请求序列化为JSON并脱敏
+func auditRequest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return ""
+	}
+	return marshalMasked(m)
+}
+
+// auditForm 将表单和查询参数序列化为JSON并脱敏,不包含上传的文件
+func auditForm(c *gin.Context) string {
+	m := make(map[string]interface{})
+	for k, v := range c.Request.Form {
+		if len(v) > 0 {
+			m[k] = v[0]
+		}
+	}
+	if c.Request.MultipartForm != nil {
+		for k, files := range c.Request.MultipartForm.File {
+			if len(files) > 0 {
+				m[k] = files[0].Filename
+			}
+		}
+	}
+	return marshalMasked(m)
+}
+
+func marshalMasked(m map[string]interface{}) string {
+	if len(m) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(util.MaskSensitive(m))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// auditResult 根据错误判断操作结果,权限不足记为denied
+func auditResult(err error) (result, reason string) {
+	if err == nil {
+		return models.AuditResultSuccess, ""
+	}
+	se := kerrors.FromError(err)
+	if se.Code == stdhttp.StatusForbidden || se.Code == stdhttp.StatusUnauthorized {
+		return models.AuditResultDenied, se.Reason
+	}
+	reason = se.Message
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+	return models.AuditResultFailure, reason
+}
@@ -2,24 +2,22 @@ package middleware
 
 import (
 	"context"
+	stderrors "errors"
 	"net"
-	"os"
 	"strings"
 	"time"
 
-	"github.com/Gre-Z/common/jtime"
 	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/oschwald/geoip2-golang"
-	"github.com/ydssx/kratos-kit/models"
 	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/util"
-	"gorm.io/gorm"
 )
 
 const (
@@ -27,11 +25,14 @@ const (
 	userTypeAdmin = 2
 )
 
+// errMFARequired 管理员令牌未经过两步验证
+var errMFARequired = errors.Forbidden("mfa_required", "two-factor authentication is required for admin accounts")
+
 // AuthServer 返回认证中间件
-func AuthServer(geoip *geoip2.Reader) middleware.Middleware {
+func AuthServer(geoip *geoip2.Reader, jm *jwt.Manager, sessions session.Store) middleware.Middleware {
 	return func(h middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			claims, err := handleAuth(ctx, geoip)
+			claims, err := handleAuth(ctx, geoip, jm, sessions)
 			if err != nil {
 				return nil, err
 			}
@@ -41,169 +42,67 @@ func AuthServer(geoip *geoip2.Reader) middleware.Middleware {
 }
 
 // handleAuth 处理认证逻辑
-func handleAuth(ctx context.Context, geoip *geoip2.Reader) (*jwt.Claims, error) {
+func handleAuth(ctx context.Context, geoip *geoip2.Reader, jm *jwt.Manager, sessions session.Store) (*jwt.Claims, error) {
 	if r, ok := http.RequestFromServerContext(ctx); ok {
-		return parseToken(ctx, r, geoip)
+		return parseToken(ctx, r, geoip, jm, sessions)
 	}
 
 	if tr, ok := transport.FromServerContext(ctx); ok {
-		return handleTransportAuth(tr)
+		return handleTransportAuth(ctx, tr, jm, sessions)
 	}
 
 	return nil, errors.Forbidden("forbidden", "no token")
 }
 
 // handleTransportAuth 处理传输层认证
-func handleTransportAuth(tr transport.Transporter) (*jwt.Claims, error) {
-	token := extractToken(tr.RequestHeader().Get("Authorization"))
-	user, err := models.NewUserModel().SetUUIds(token).FirstOne()
-	if err != nil {
-		user = &models.User{}
-	}
-	return &jwt.Claims{
-		Uid: int64(user.ID),
-	}, nil
+func handleTransportAuth(ctx context.Context, tr transport.Transporter, jm *jwt.Manager, sessions session.Store) (*jwt.Claims, error) {
+	return ValidateToken(ctx, jm, sessions, extractToken(tr.RequestHeader().Get("Authorization")))
 }
 
-// parseToken 解析token并更新用户信息
-func parseToken(ctx context.Context, r *http.Request, geoip *geoip2.Reader) (*jwt.Claims, error) {
-	token := extractToken(r.Header.Get("Authorization"))
-	if token == "" {
-		return nil, errors.Unauthorized("unauthorized", "missing bearer token")
-	}
-
-	// 可选的 JWT 校验：当环境变量配置了密钥时启用
-	accessSecret := os.Getenv("JWT_ACCESS_SECRET")
-	if accessSecret != "" {
-		manager := jwt.NewManager(jwt.Config{
-			AccessSecret:          accessSecret,
-			RefreshSecret:         os.Getenv("JWT_REFRESH_SECRET"),
-			AccessTokenDuration:   time.Hour,
-			RefreshTokenDuration:  30 * 24 * time.Hour,
-			AccessTokenCookieName: "",
-		})
-		if claims, err := manager.ValidateAccessToken(token); err == nil {
-			// 已通过签名校验，直接使用 claims；后续补全地理信息
-			clientIP := getClientIP(r)
-			if err := fillGeoOnDemand(geoip, clientIP, claims); err != nil {
-				logger.Warnf(ctx, "fill geo info failed: %v", err)
-			}
-			return claims, nil
-		} else {
-			// 签名校验失败，返回 401
-			return nil, errors.Unauthorized("unauthorized", "invalid token")
-		}
-	}
-
-	// 兼容旧方案：将 Bearer 当作 UUID 使用
-	user, err := models.NewUserModel().SetUUIds(token).FirstOne()
+// parseToken 解析token并补全地理信息
+func parseToken(ctx context.Context, r *http.Request, geoip *geoip2.Reader, jm *jwt.Manager, sessions session.Store) (*jwt.Claims, error) {
+	claims, err := ValidateToken(ctx, jm, sessions, extractToken(r.Header.Get("Authorization")))
 	if err != nil {
-		return &jwt.Claims{}, nil
+		return nil, err
 	}
 
 	clientIP := getClientIP(r)
-	claims := buildClaims(user, clientIP)
-
-	if err := handleUserLogin(user, clientIP, geoip, claims); err != nil {
-		logger.Errorf(ctx, "handle user login error: %v", err)
+	if err := fillGeoOnDemand(geoip, clientIP, claims); err != nil {
+		logger.Warnf(ctx, "fill geo info failed: %v", err)
 	}
 
-	logger.Infof(ctx, "auth success, uid: %d, type: %d, clientIP: %s", user.ID, user.Type, clientIP)
 	return claims, nil
 }
 
-// handleUserLogin 处理用户登录相关逻辑
-func handleUserLogin(user *models.User, clientIP string, geoip *geoip2.Reader, claims *jwt.Claims) error {
-	userLoginLog := buildLoginLog(user, clientIP)
-	needCreate := false
-
-	today := util.GetDate(time.Now())
-	_, err := models.NewUserLoginLogModel().SetUserId(int(user.ID)).SetLoginDate(today).FirstOne()
-	if err != nil && errors.Is(err, gorm.ErrRecordNotFound) {
-		needCreate = true
-		userLoginLog.LoginDate = jtime.JsonTime{Time: today}
+// ValidateToken 校验访问令牌签名、有效期和类型,并确认令牌所属会话未被撤销
+func ValidateToken(ctx context.Context, jm *jwt.Manager, sessions session.Store, token string) (*jwt.Claims, error) {
+	if token == "" {
+		return nil, errors.Unauthorized("unauthorized", "missing bearer token")
 	}
-
-	if shouldUpdateGeoInfo(user, clientIP) {
-		if err := updateGeoInfo(geoip, clientIP, user, userLoginLog, claims); err != nil {
-			return err
+	claims, err := jm.ValidateAccessToken(token)
+	if err != nil {
+		if stderrors.Is(err, jwt.ErrExpiredToken) {
+			return nil, errors.Unauthorized("unauthorized", "token expired")
 		}
+		return nil, errors.Unauthorized("unauthorized", "invalid token")
 	}
 
-	if needCreate {
-		return models.NewUserLoginLogModel().Create(*userLoginLog)
-	}
-	return nil
-}
-
-// shouldUpdateGeoInfo 判断是否需要更新地理信息
-func shouldUpdateGeoInfo(user *models.User, clientIP string) bool {
-	return user.CountryCode == "" || clientIP != user.IPAddress
-}
-
-// updateGeoInfo 更新地理信息
-func updateGeoInfo(geoip *geoip2.Reader, clientIP string, user *models.User, log *models.UserLoginLog, claims *jwt.Claims) error {
-	city, err := geoip.City(net.ParseIP(clientIP))
+	sess, err := sessions.Get(ctx, claims.Uid, claims.Sid)
 	if err != nil {
-		return err
-	}
-
-	if user.CountryCode == "" {
-		userInfo := models.User{
-			IPAddress:   clientIP,
-			CountryCode: city.Country.IsoCode,
-			CityCode:    city.City.Names["en"],
-			CountryName: city.Country.Names["en"],
-			ZipCode:     city.Postal.Code,
-		}
-		if err := models.NewUserModel().SetIds(int(user.ID)).Updates(userInfo); err != nil {
-			return err
+		if stderrors.Is(err, session.ErrSessionNotFound) {
+			return nil, errors.Unauthorized("unauthorized", "session revoked")
 		}
+		return nil, err
 	}
-
-	updateLoginLogGeoInfo(log, city, clientIP)
-	updateClaimsGeoInfo(claims, city)
-	return nil
-}
-
-// updateLoginLogGeoInfo 更新登录日志地理信息
-func updateLoginLogGeoInfo(log *models.UserLoginLog, city *geoip2.City, clientIP string) {
-	log.IpAddress = clientIP
-	log.CountryCode = city.Country.IsoCode
-	log.CountryName = city.Country.Names["en"]
-	log.CityName = city.City.Names["en"]
-}
-
-// updateClaimsGeoInfo 更新claims地理信息
-func updateClaimsGeoInfo(claims *jwt.Claims, city *geoip2.City) {
-	claims.CountryCode = city.Country.IsoCode
-	claims.Country = city.Country.Names["en"]
-	claims.City = city.City.Names["en"]
-	claims.ZipCode = city.Postal.Code
-}
-
-// buildLoginLog 构建登录日志
-func buildLoginLog(user *models.User, clientIP string) *models.UserLoginLog {
-	return &models.UserLoginLog{
-		UserId:      int(user.ID),
-		IpAddress:   clientIP,
-		CountryName: user.CountryName,
-		CountryCode: user.CountryCode,
-		CityName:    user.CityCode,
+	// 令牌刷新后旧的访问令牌立即失效
+	if sess.AccessJti != claims.ID {
+		return nil, errors.Unauthorized("unauthorized", "session revoked")
 	}
-}
-
-// buildClaims 构建claims
-func buildClaims(user *models.User, clientIP string) *jwt.Claims {
-	return &jwt.Claims{
-		Uid:         int64(user.ID),
-		ClientIP:    clientIP,
-		CountryCode: user.CountryCode,
-		Country:     user.CountryName,
-		City:        user.CityCode,
-		ZipCode:     user.ZipCode,
-		Uuid:        user.UUID,
+	if err := sessions.Touch(ctx, claims.Uid, claims.Sid, time.Now()); err != nil {
+		logger.Warnf(ctx, "touch session failed: %v", err)
 	}
+
+	return claims, nil
 }
 
 // getClientIP 获取客户端IP
@@ -281,7 +180,7 @@ func GetClaims(ctx context.Context) *jwt.Claims {
 }
 
 // AuthAdmin 管理员认证中间件
-func AuthAdmin() middleware.Middleware {
+func AuthAdmin(jm *jwt.Manager, sessions session.Store) middleware.Middleware {
 	return func(h middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			tr, ok := transport.FromServerContext(ctx)
@@ -289,45 +188,57 @@ func AuthAdmin() middleware.Middleware {
 				return nil, errors.Forbidden("forbidden", "no token")
 			}
 
-			token := extractToken(tr.RequestHeader().Get("Authorization"))
-			user, err := models.NewUserModel().SetUUIds(token).FirstOne()
-			if err != nil || user.Type != userTypeAdmin {
+			claims, err := ValidateToken(ctx, jm, sessions, extractToken(tr.RequestHeader().Get("Authorization")))
+			if claims != nil {
+				setAuditActor(ctx, claims.Uid)
+			}
+			if err != nil || claims.Type != userTypeAdmin {
 				return nil, errors.Unauthorized("unauthorized", "user no auth")
 			}
+			// 管理员必须通过两步验证登录
+			if !claims.MFA {
+				return nil, errMFARequired
+			}
 
-			ctx = NewContext(ctx, &jwt.Claims{
-				Uid: int64(user.ID),
-			})
-			return h(ctx, req)
+			return h(NewContext(ctx, claims), req)
 		}
 	}
 }
 
 // AuthGin Gin认证中间件
-func AuthGin(geoip *geoip2.Reader) gin.HandlerFunc {
+func AuthGin(geoip *geoip2.Reader, jm *jwt.Manager, sessions session.Store) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		claims, err := parseToken(c.Request.Context(), c.Request, geoip)
+		claims, err := parseToken(c.Request.Context(), c.Request, geoip, jm, sessions)
 		if err != nil {
 			c.Abort()
 			util.FailWithError(c, err)
 			return
 		}
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), claims))
 		c.Set("user_id", int(claims.Uid))
 		c.Next()
 	}
 }
 
 // AuthGinAdmin Gin管理员认证中间件
-func AuthGinAdmin() gin.HandlerFunc {
+func AuthGinAdmin(jm *jwt.Manager, sessions session.Store) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		token := extractToken(c.Request.Header.Get("Authorization"))
-		user, err := models.NewUserModel().SetUUIds(token).FirstOne()
-		if err != nil || user.Type != userTypeAdmin {
+		claims, err := ValidateToken(c.Request.Context(), jm, sessions, extractToken(c.Request.Header.Get("Authorization")))
+		if claims != nil {
+			setAuditActor(c.Request.Context(), claims.Uid)
+		}
+		if err != nil || claims.Type != userTypeAdmin {
 			c.AbortWithStatus(401)
 			return
 		}
-		c.Set("user_id", int(user.ID))
-		c.Set("user_type", int(user.Type))
+		if !claims.MFA {
+			c.Abort()
+			util.FailWithError(c, errMFARequired)
+			return
+		}
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), claims))
+		c.Set("user_id", int(claims.Uid))
+		c.Set("user_type", claims.Type)
 		c.Next()
 	}
 }
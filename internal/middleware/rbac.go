@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+)
+
+// PermissionChecker 判断用户是否拥有权限
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, userID uint, p rbac.Permission) (bool, error)
+}
+
+// Authorize 权限校验中间件,按operation查找所需权限,未配置的operation不做校验。
+// 需放在认证中间件之后。
+func Authorize(checker PermissionChecker, rules map[string]rbac.Permission) middleware.Middleware {
+	return func(h middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return h(ctx, req)
+			}
+			required, ok := rules[tr.Operation()]
+			if !ok {
+				return h(ctx, req)
+			}
+
+			claims := GetClaims(ctx)
+			if claims.Uid == 0 {
+				return nil, errors.Unauthorized
+			}
+			allowed, err := checker.HasPermission(ctx, uint(claims.Uid), required)
+			if err != nil {
+				logger.Errorf(ctx, "check permission %s failed: %v", required, err)
+				return nil, errors.ErrPermissionDenied
+			}
+			if !allowed {
+				return nil, errors.ErrPermissionDenied
+			}
+			return h(ctx, req)
+		}
+	}
+}
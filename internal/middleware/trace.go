@@ -49,9 +49,12 @@ func TraceServer() middleware.Middleware {
 	}
 }
 
-// initTraceContext 初始化跟踪上下文
+// initTraceContext 初始化跟踪上下文,链路ID优先使用 tracing 中间件创建的 span 的 trace id
 func initTraceContext(ctx context.Context) (context.Context, string) {
-	traceID := uuid.NewString()
+	traceID := kratos.TraceIDFromContext(ctx)
+	if traceID == "" {
+		traceID = uuid.NewString()
+	}
 	ctx = kratos.NewTraceIDContext(ctx, traceID)
 
 	var operation string
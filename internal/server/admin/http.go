@@ -3,64 +3,87 @@ package admin
 import (
 	"errors"
 
-	// admin "github.com/ydssx/kratos-kit/api/admin/v1"
+	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
 	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/internal/middleware"
 	"github.com/ydssx/kratos-kit/internal/server"
 	"github.com/ydssx/kratos-kit/internal/service"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/limit"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	mgin "github.com/ydssx/kratos-kit/pkg/middleware/gin"
+	kratosmw "github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func NewHttpServer(
 	c *conf.Bootstrap,
 	limiter limit.Limiter,
 	adminSvc *service.AdminService,
+	jobSvc *service.JobService,
+	jm *jwt.Manager,
+	sessions session.Store,
+	rbacUc *biz.RBACUseCase,
+	auditUc *biz.AuditUseCase,
 ) *http.Server {
+	rules := newPermissionRules()
 	opts := []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			kratosmw.MetricServer(),
+			// 审计放在限流和参数校验之前,被拒绝的写操作同样留有记录
+			middleware.Audit(auditUc, auditResources(rules)),
 			middleware.RateLimit(limiter),
 			middleware.Validator(),
 			middleware.TraceServer(),
-			middleware.AuthAdmin(),
+			middleware.AuthAdmin(jm, sessions),
+			middleware.Authorize(rbacUc, rules),
 			middleware.LanguageMiddleware(),
 		),
 		http.ResponseEncoder(server.CustomizeResponseEncoder),
 		http.ErrorEncoder(server.CustomizeErrorEncoder),
 	}
-	server := c.Server
-	if server.Http.Addr != "" {
-		opts = append(opts, http.Address(server.Http.Addr))
+	httpConf := c.Server.Http
+	if httpConf.Addr != "" {
+		opts = append(opts, http.Address(httpConf.Addr))
 	}
-	if server.Http.Timeout != nil {
-		opts = append(opts, http.Timeout(server.Http.Timeout.AsDuration()))
+	if httpConf.Timeout != nil {
+		opts = append(opts, http.Timeout(httpConf.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
 
-	// admin.RegisterAdminServiceHTTPServer(srv, adminSvc)
+	srv.Handle("/metrics", promhttp.Handler())
+	adminv1.RegisterAdminServiceHTTPServer(srv, adminSvc)
+	jobv1.RegisterJobServiceHTTPServer(srv, jobSvc)
 
 	gin.SetMode(gin.ReleaseMode)
 	ginServer := gin.New()
 	ginServer.Use(
 		mgin.Logger(),
+		mgin.Metrics(),
 		gin.CustomRecoveryWithWriter(logger.Writer, func(c *gin.Context, err any) {
 			logger.Errorf(c.Request.Context(), "panic recovered: %+v", err)
 			c.AbortWithError(util.ERROR, errors.New("internal server error"))
 			return
 		}),
-		middleware.AuthGinAdmin(),
+		middleware.AuditGin(auditUc),
+		middleware.AuthGinAdmin(jm, sessions),
 	)
 
 	ginServer.POST("/admin/upload", adminSvc.Upload)
+	ginServer.GET("/admin/audit_logs/export", adminSvc.ExportAuditLogs)
 
-	srv.HandlePrefix("/admin", ginServer)
+	srv.HandlePrefix("/admin", server.TraceHandler(ginServer))
 
 	return srv
 }
@@ -6,15 +6,26 @@ import (
 	"github.com/ydssx/kratos-kit/common/conf"
 	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/internal/job"
+	"github.com/ydssx/kratos-kit/internal/server"
+	"github.com/ydssx/kratos-kit/pkg/lock"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/queue"
 )
 
+// cronLeaderKey 管理后台定时任务调度主节点锁
+const cronLeaderKey = "job:admin_cron:leader"
+
+// JobServer 管理后台任务服务器,只有选举出的主节点调度定时任务和监控队列失败率
 type JobServer struct {
-	client *queue.Server
+	client    *queue.Server
+	scheduler *queue.Scheduler
+	monitor   *queue.FailureMonitor
+	elector   *lock.Elector
+	cancel    context.CancelFunc
+	done      chan struct{}
 }
 
-func NewJobServer(c *conf.Bootstrap, serviceSet *biz.AdminUseCase) *JobServer {
+func NewJobServer(c *conf.Bootstrap, serviceSet *biz.AdminUseCase, locker *lock.RedisLocker) *JobServer {
 	cfg := &queue.Config{
 		ConnConfig: queue.ConnConfig{
 			RedisAddr:     c.Data.JobRedis.Addr,
@@ -24,31 +35,64 @@ func NewJobServer(c *conf.Bootstrap, serviceSet *biz.AdminUseCase) *JobServer {
 			WriteTimeout:  c.Data.JobRedis.WriteTimeout.AsDuration(),
 		},
 		Concurrency: int(c.Asynq.Concurrency),
+		Backoff:     server.JobBackoff(c),
+		Alert:       server.JobAlertConfig(c),
 		BaseContext: func() context.Context {
 			return biz.WithAdminUseCase(context.Background(), serviceSet)
 		},
 	}
 
+	registry, err := job.NewAdminRegistry()
+	if err != nil {
+		panic(err)
+	}
 	client, err := queue.NewServer(cfg)
 	if err != nil {
 		panic(err)
 	}
-
 	// 注册管理员任务处理器
-	registerJobHandler(client)
-	// 注册管理员定时任务
-	registerCronJob(client)
+	client.Register(registry)
 
-	return &JobServer{client: client}
+	return &JobServer{
+		client:    client,
+		scheduler: client.Scheduler(queue.StaticCrons(registry.Crons())),
+		monitor:   client.FailureMonitor(),
+		elector:   lock.NewElector(locker, cronLeaderKey, c.Asynq.GetLeaderTtl().AsDuration()),
+	}
 }
 
 // Start starts the JobServer
 func (j *JobServer) Start(ctx context.Context) error {
-	return j.client.Start()
+	if err := j.client.Start(); err != nil {
+		return err
+	}
+
+	ctx, j.cancel = context.WithCancel(context.Background())
+	j.done = make(chan struct{})
+	go func() {
+		defer close(j.done)
+		j.elector.Run(ctx, j.lead)
+	}()
+	return nil
+}
+
+// lead 成为主节点后调度定时任务并监控队列失败率
+func (j *JobServer) lead(ctx context.Context) {
+	monitorDone := make(chan struct{})
+	go func() {
+		defer close(monitorDone)
+		j.monitor.Run(ctx)
+	}()
+	j.scheduler.Run(ctx, nil)
+	<-monitorDone
 }
 
 // Stop stops the JobServer gracefully
 func (j *JobServer) Stop(ctx context.Context) error {
+	if j.cancel != nil {
+		j.cancel()
+		<-j.done
+	}
 	err := j.client.Close()
 	if err != nil {
 		logger.Errorf(ctx, "failed to stop admin job server: %v", err)
@@ -57,30 +101,3 @@ func (j *JobServer) Stop(ctx context.Context) error {
 	logger.Info(ctx, "admin job server stopped")
 	return nil
 }
-
-// registerJobHandler registers all admin job handlers defined in AdminJobHandlerMap to the client
-func registerJobHandler(client *queue.Server) {
-	for k, v := range job.AdminJobHandlerMap {
-		client.RegisterHandler(k.String(), queue.HandleFunc(v))
-	}
-}
-
-// registerCronJob registers all admin cron jobs defined in AdminCronJobMap
-func registerCronJob(client *queue.Server) {
-	for spec, jobType := range job.AdminCronJobMap {
-		err := job.ValidateAdminTask(jobType)
-		if err != nil {
-			panic(err)
-		}
-
-		task := &queue.Task{
-			TypeName: jobType.String(),
-			Payload:  nil,
-		}
-
-		err = client.EnqueuePeriodicTask(context.Background(), task, spec)
-		if err != nil {
-			panic(err)
-		}
-	}
-}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+)
+
+// newPermissionRules 管理后台接口所需权限
+func newPermissionRules() map[string]rbac.Permission {
+	return map[string]rbac.Permission{
+		adminv1.OperationAdminServiceListUsers:       biz.PermAdminUserRead,
+		adminv1.OperationAdminServiceGetUser:         biz.PermAdminUserRead,
+		adminv1.OperationAdminServiceUpdateUser:      biz.PermAdminUserUpdate,
+		adminv1.OperationAdminServiceBanUser:         biz.PermAdminUserBan,
+		adminv1.OperationAdminServiceUnbanUser:       biz.PermAdminUserBan,
+		adminv1.OperationAdminServiceDeleteUser:      biz.PermAdminUserDelete,
+		adminv1.OperationAdminServiceImpersonateUser: biz.PermAdminUserImpersonate,
+		adminv1.OperationAdminServiceListAuditLogs:   biz.PermAuditLogRead,
+		adminv1.OperationAdminServiceListCronJobs:    biz.PermCronJobRead,
+		adminv1.OperationAdminServiceUpsertCronJob:   biz.PermCronJobUpdate,
+		adminv1.OperationAdminServicePauseCronJob:    biz.PermCronJobUpdate,
+		adminv1.OperationAdminServiceTriggerNow:      biz.PermCronJobTrigger,
+		jobv1.OperationJobServiceEnqueue:             biz.PermJobEnqueue,
+		jobv1.OperationJobServiceQueryTasks:          biz.PermJobRead,
+		jobv1.OperationJobServiceQueuingTime:         biz.PermJobRead,
+		jobv1.OperationJobServiceCancelTask:          biz.PermJobCancel,
+		jobv1.OperationJobServiceRetryTask:           biz.PermJobRetry,
+		jobv1.OperationJobServiceListArchivedTasks:   biz.PermJobRead,
+		jobv1.OperationJobServiceGetArchivedTask:     biz.PermJobRead,
+		jobv1.OperationJobServiceRequeueArchivedTask: biz.PermJobRetry,
+		jobv1.OperationJobServiceDeleteArchivedTask:  biz.PermJobDelete,
+	}
+}
+
+// auditResources 审计日志中操作对象的资源名,取自接口所需权限的资源
+func auditResources(rules map[string]rbac.Permission) map[string]string {
+	resources := make(map[string]string, len(rules))
+	for op, p := range rules {
+		resources[op] = p.Resource
+	}
+	return resources
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/ydssx/kratos-kit/docs"
 	"github.com/ydssx/kratos-kit/internal/middleware"
 	"github.com/ydssx/kratos-kit/internal/service"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	mgin "github.com/ydssx/kratos-kit/pkg/middleware/gin"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
 	"github.com/gin-gonic/gin"
@@ -24,6 +26,8 @@ func NewGinMux(
 	geoip *geoip2.Reader,
 	commonSvc *service.CommonService,
 	userSvc *service.UserService,
+	jm *jwt.Manager,
+	sessions session.Store,
 ) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 
@@ -31,6 +35,7 @@ func NewGinMux(
 	mux.ContextWithFallback = true
 	mux.Use(
 		mgin.Logger(),
+		mgin.Metrics(),
 		gin.CustomRecoveryWithWriter(logger.Writer, func(c *gin.Context, err any) {
 			logger.Errorf(c.Request.Context(), "panic recovered: %+v", err)
 			c.AbortWithError(util.ERROR, errors.New("internal server error"))
@@ -61,8 +66,10 @@ func NewGinMux(
 		mux.GET("/debug/pprof/threadcreate", gin.WrapH(pprof.Handler("threadcreate")))
 	}
 
-	mux.POST("/api/upload", middleware.AuthGin(geoip), commonSvc.Upload)
+	mux.POST("/api/upload", middleware.AuthGin(geoip, jm, sessions), commonSvc.Upload)
 	mux.GET("/api/users/google-callback", userSvc.GoogleCallback)
+	mux.GET("/api/users/oauth/:provider/callback", userSvc.OAuthCallback)
+	mux.POST("/api/users/oauth/:provider/callback", userSvc.OAuthCallback)
 
 	return mux
 }
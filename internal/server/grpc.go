@@ -2,18 +2,30 @@ package server
 
 import (
 	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/internal/middleware"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
+	kratosmw "github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/session"
 
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/oschwald/geoip2-golang"
 )
 
-func NewGRPCServer(c *conf.Bootstrap, geoip *geoip2.Reader) *grpc.Server {
+func NewGRPCServer(c *conf.Bootstrap, geoip *geoip2.Reader, jm *jwt.Manager, sessions session.Store, rbacUc *biz.RBACUseCase) *grpc.Server {
 	server := grpc.NewServer(
 		grpc.Address(c.Server.Grpc.Addr),
 		grpc.Timeout(c.Server.Grpc.Timeout.AsDuration()),
-		grpc.Middleware(recovery.Recovery(), middleware.AuthServer(geoip)),
+		grpc.Middleware(
+			recovery.Recovery(),
+			tracing.Server(),
+			kratosmw.MetricServer(),
+			selector.Server(middleware.AuthServer(geoip, jm, sessions)).Match(newWhiteListMatcher()).Build(),
+			middleware.Authorize(rbacUc, newPermissionRules()),
+		),
 	)
 
 	return server
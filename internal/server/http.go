@@ -16,21 +16,30 @@ import (
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/hibiken/asynqmon"
 	"github.com/oschwald/geoip2-golang"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	filev1 "github.com/ydssx/kratos-kit/api/file/v1"
 	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
 	"github.com/ydssx/kratos-kit/common"
 	"github.com/ydssx/kratos-kit/common/conf"
+	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/internal/middleware"
 	"github.com/ydssx/kratos-kit/internal/service"
 	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/health"
+	"github.com/ydssx/kratos-kit/pkg/jwt"
 	"github.com/ydssx/kratos-kit/pkg/limit"
 	"github.com/ydssx/kratos-kit/pkg/middleware/auth"
+	kratosmw "github.com/ydssx/kratos-kit/pkg/middleware/kratos"
 	securitymw "github.com/ydssx/kratos-kit/pkg/middleware/security"
 	validatormw "github.com/ydssx/kratos-kit/pkg/middleware/validator"
+	"github.com/ydssx/kratos-kit/pkg/session"
 	"github.com/ydssx/kratos-kit/pkg/sse"
+	"github.com/ydssx/kratos-kit/pkg/storage"
 	"github.com/ydssx/kratos-kit/pkg/util"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -65,12 +74,23 @@ func NewHTTPServer(
 	limiter limit.Limiter,
 	ginServer *gin.Engine,
 	userSvc *service.UserService,
+	jm *jwt.Manager,
+	sessions session.Store,
+	rbacUc *biz.RBACUseCase,
+	hs *health.HealthService,
+	store storage.Storage,
+	fileSvc *service.FileService,
 ) *khttp.Server {
 	cfg := getHTTPConfig(c)
-	srv := khttp.NewServer(buildServerOptions(cfg, geoip, limiter)...)
+	srv := khttp.NewServer(buildServerOptions(cfg, geoip, limiter, jm, sessions, rbacUc)...)
 
 	// 基础路由
-	registerBasicRoutes(srv, cfg.Username, cfg.Password, c)
+	registerBasicRoutes(srv, cfg.Username, cfg.Password, c, hs)
+
+	// 本地存储的文件由签名路由提供访问
+	if fs, ok := store.(storage.Servable); ok {
+		srv.HandlePrefix(fs.RoutePrefix()+"/", fs)
+	}
 
 	// WebSocket
 	srv.HandleFunc("/ws", ws.HandleWebSocket)
@@ -79,19 +99,23 @@ func NewHTTPServer(
 
 	// 用户服务
 	userv1.RegisterUserServiceHTTPServer(srv, userSvc)
+	// 文件服务
+	filev1.RegisterFileServiceHTTPServer(srv, fileSvc)
 
 	logRoutes(srv)
 
-	srv.HandlePrefix("/", ginServer)
+	srv.HandlePrefix("/", TraceHandler(ginServer))
 
 	return srv
 }
 
 // buildServerOptions 构建服务器选项
-func buildServerOptions(cfg HTTPServerConfig, geoip *geoip2.Reader, limiter limit.Limiter) []khttp.ServerOption {
+func buildServerOptions(cfg HTTPServerConfig, geoip *geoip2.Reader, limiter limit.Limiter, jm *jwt.Manager, sessions session.Store, checker middleware.PermissionChecker) []khttp.ServerOption {
 	opts := []khttp.ServerOption{
 		khttp.Middleware(
 			recovery.Recovery(),
+			tracing.Server(),
+			kratosmw.MetricServer(),
 			// 安全相关中间件
 			securitymw.SecurityHeaders(),
 			securitymw.RateLimiter(cfg.RateLimit, cfg.RateBurst),
@@ -103,11 +127,12 @@ func buildServerOptions(cfg HTTPServerConfig, geoip *geoip2.Reader, limiter limi
 			validatormw.PathTraversalValidator(),
 			validatormw.CommandInjectionValidator(),
 			// 认证和授权中间件
-			selector.Server(auth.JWTAuth(cfg.JWTSecret, []string{"/health", "/metrics", "/monitor"})).Match(newWhiteListMatcher()).Build(),
+			selector.Server(auth.JWTAuth(cfg.JWTSecret, []string{"/health", "/livez", "/readyz", "/metrics", "/monitor"})).Match(newWhiteListMatcher()).Build(),
 			// 其他中间件
 			middleware.RateLimit(limiter),
 			middleware.TraceServer(),
-			selector.Server(middleware.AuthServer(geoip)).Match(newWhiteListMatcher()).Build(),
+			selector.Server(middleware.AuthServer(geoip, jm, sessions)).Match(newWhiteListMatcher()).Build(),
+			middleware.Authorize(checker, newPermissionRules()),
 			middleware.LanguageMiddleware(),
 		),
 		khttp.ResponseEncoder(CustomizeResponseEncoder),
@@ -126,9 +151,11 @@ func buildServerOptions(cfg HTTPServerConfig, geoip *geoip2.Reader, limiter limi
 }
 
 // registerBasicRoutes 注册基础路由
-func registerBasicRoutes(srv *khttp.Server, username, password string, c *conf.Bootstrap) {
-	// 健康检查
-	srv.HandleFunc("/health", healthCheck)
+func registerBasicRoutes(srv *khttp.Server, username, password string, c *conf.Bootstrap, hs *health.HealthService) {
+	// 健康检查,/health 保留给旧的探针使用,等同于 /livez
+	srv.HandleFunc("/health", hs.LivenessHandler())
+	srv.HandleFunc("/livez", hs.LivenessHandler())
+	srv.HandleFunc("/readyz", hs.ReadinessHandler())
 	// Prometheus 指标
 	srv.Handle("/metrics", promhttp.Handler())
 	// Asynq监控
@@ -139,12 +166,6 @@ func registerBasicRoutes(srv *khttp.Server, username, password string, c *conf.B
 	srv.HandlePrefix(h.RootPath(), BasicAuth(username, password, h))
 }
 
-// healthCheck 健康检查处理器
-func healthCheck(w http.ResponseWriter, _ *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("ok"))
-}
-
 // CustomizeResponseEncoder 自定义响应编码器
 func CustomizeResponseEncoder(w http.ResponseWriter, r *http.Request, v interface{}) error {
 	if rd, ok := v.(khttp.Redirector); ok {
@@ -257,6 +278,13 @@ func writeJSON(w http.ResponseWriter, v interface{}) error {
 	return err
 }
 
+// TraceHandler 为不经过kratos中间件的路由(如gin)提取上游的 traceparent 并创建span
+func TraceHandler(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "gin", otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		return r.Method + " " + r.URL.Path
+	}))
+}
+
 // BasicAuth 基本认证中间件
 func BasicAuth(username, password string, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -275,6 +303,16 @@ func BasicAuth(username, password string, next http.Handler) http.Handler {
 func newWhiteListMatcher() selector.MatchFunc {
 	whiteList := map[string]struct{}{
 		userv1.OperationUserServiceSendVerificationCode: {},
+		userv1.OperationUserServiceLogin:                {},
+		userv1.OperationUserServiceRegister:             {},
+		userv1.OperationUserServiceRefreshToken:         {},
+		userv1.OperationUserServiceIsAccountExist:       {},
+		userv1.OperationUserServiceRequestPasswordReset: {},
+		userv1.OperationUserServiceResetPassword:        {},
+		userv1.OperationUserServiceVerifyTOTP:           {},
+		userv1.OperationUserServiceGoogleLogin:          {},
+		userv1.OperationUserServiceOAuthLogin:           {},
+		userv1.UserService_Create_FullMethodName:        {},
 	}
 
 	return func(ctx context.Context, operation string) bool {
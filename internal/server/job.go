@@ -6,15 +6,28 @@ import (
 	"github.com/ydssx/kratos-kit/common/conf"
 	"github.com/ydssx/kratos-kit/internal/biz"
 	"github.com/ydssx/kratos-kit/internal/job"
+	"github.com/ydssx/kratos-kit/pkg/lock"
 	"github.com/ydssx/kratos-kit/pkg/logger"
 	"github.com/ydssx/kratos-kit/pkg/queue"
+	"github.com/ydssx/kratos-kit/pkg/webhook"
 )
 
+// cronLeaderKey 定时任务调度主节点锁
+const cronLeaderKey = "job:cron:leader"
+
+// JobServer 任务服务器,所有实例都处理任务,只有选举出的主节点调度定时任务和监控队列失败率
 type JobServer struct {
-	client *queue.Server
+	client    *queue.Server
+	scheduler *queue.Scheduler
+	monitor   *queue.FailureMonitor
+	cron      *biz.CronUseCase
+	defaults  []queue.CronEntry // 默认的定时任务
+	elector   *lock.Elector
+	cancel    context.CancelFunc
+	done      chan struct{}
 }
 
-func NewJobServer(c *conf.Bootstrap, serviceSet *biz.UsecaseSet) *JobServer {
+func NewJobServer(c *conf.Bootstrap, serviceSet *biz.UsecaseSet, locker *lock.RedisLocker) *JobServer {
 	cfg := &queue.Config{
 		ConnConfig: queue.ConnConfig{
 			RedisAddr:     c.Data.Redis.Addr,
@@ -24,63 +37,104 @@ func NewJobServer(c *conf.Bootstrap, serviceSet *biz.UsecaseSet) *JobServer {
 			WriteTimeout:  c.Data.Redis.WriteTimeout.AsDuration(),
 		},
 		Concurrency:   int(c.Asynq.Concurrency),
+		Backoff:       JobBackoff(c),
+		Alert:         JobAlertConfig(c),
 		BaseContext: func() context.Context {
 			return biz.NewContextWithUsecaseSet(context.Background(), serviceSet)
 		},
 	}
 
+	registry, err := job.NewRegistry()
+	if err != nil {
+		panic(err)
+	}
 	client, err := queue.NewServer(cfg)
 	if err != nil {
 		panic(err)
 	}
+	// 注册任务处理器,记录定时任务的执行
+	client.Register(registry)
+	client.Use(job.RecordCronRun)
 
-	// 注册任务处理器
-	registerJobHandler(client)
-	// 注册定时任务
-	registerCronJob(client)
-
-	return &JobServer{client: client}
+	return &JobServer{
+		client:    client,
+		scheduler: client.Scheduler(serviceSet.CronBiz.CronEntries),
+		monitor:   client.FailureMonitor(),
+		cron:      serviceSet.CronBiz,
+		defaults:  registry.Crons(),
+		elector:   lock.NewElector(locker, cronLeaderKey, c.Asynq.GetLeaderTtl().AsDuration()),
+	}
 }
 
 // Start starts the JobServer
 func (j *JobServer) Start(ctx context.Context) error {
-	return j.client.Start()
-}
-
-// Stop stops the JobServer gracefully
-func (j *JobServer) Stop(ctx context.Context) error {
-	err := j.client.Close()
-	if err != nil {
-		logger.Errorf(ctx, "failed to stop job server: %v", err)
+	if err := j.client.Start(); err != nil {
 		return err
 	}
-	logger.Info(ctx, "job server stopped")
+
+	ctx, j.cancel = context.WithCancel(context.Background())
+	j.done = make(chan struct{})
+	go func() {
+		defer close(j.done)
+		j.elector.Run(ctx, j.runScheduler)
+	}()
 	return nil
 }
 
-// registerJobHandler registers all job handlers defined in jobHandlerMap to the client
-func registerJobHandler(client *queue.Server) {
-	for k, v := range job.JobHandlerMap {
-		client.RegisterHandler(k.String(), queue.HandleFunc(v))
+// runScheduler 成为主节点后调度数据库中的定时任务,定时任务变更时重新加载,同时监控队列失败率
+func (j *JobServer) runScheduler(ctx context.Context) {
+	logger.Info(ctx, "became cron leader, start scheduling cron jobs")
+	if err := j.cron.SeedCronJobs(ctx, j.defaults); err != nil {
+		logger.Errorf(ctx, "failed to seed cron jobs: %v", err)
 	}
+	monitorDone := make(chan struct{})
+	go func() {
+		defer close(monitorDone)
+		j.monitor.Run(ctx)
+	}()
+	j.scheduler.Run(ctx, j.cron.WatchCronJobs(ctx))
+	<-monitorDone
+	logger.Info(ctx, "cron leadership released")
 }
 
-// registerCronJob registers all cron jobs defined in cronJobMap
-func registerCronJob(client *queue.Server) {
-	for spec, jobType := range job.CronJobMap {
-		err := job.ValidateTask(jobType)
-		if err != nil {
-			panic(err)
-		}
+// JobBackoff 任务服务器默认的重试间隔,retry_jitter 设置为 0 时不随机
+func JobBackoff(c *conf.Bootstrap) queue.Backoff {
+	b := queue.Backoff{
+		Base:   c.Asynq.GetRetryBaseDelay().AsDuration(),
+		Max:    c.Asynq.GetRetryMaxDelay().AsDuration(),
+		Jitter: c.Asynq.GetRetryJitter(),
+	}
+	if c.Asynq != nil && c.Asynq.RetryJitter != nil && b.Jitter == 0 {
+		b.Jitter = queue.NoJitter
+	}
+	return b
+}
 
-		task := &queue.Task{
-			TypeName: jobType.String(),
-			Payload:  nil,
-		}
+// JobAlertConfig 任务归档和队列失败率告警配置,告警发送到配置的 webhook,未配置时不告警
+func JobAlertConfig(c *conf.Bootstrap) queue.AlertConfig {
+	cfg := queue.AlertConfig{
+		FailureRate:  c.Asynq.GetAlertFailureRate(),
+		MinProcessed: int(c.Asynq.GetAlertMinProcessed()),
+		Window:       c.Asynq.GetAlertWindow().AsDuration(),
+	}
+	if url := c.Webhook.GetUrl(); url != "" {
+		cfg.Webhook = webhook.NewWebhook(url)
+	}
+	return cfg
+}
 
-		err = client.EnqueuePeriodicTask(context.Background(), task, spec)
-		if err != nil {
-			panic(err)
-		}
+// Stop stops the JobServer gracefully
+func (j *JobServer) Stop(ctx context.Context) error {
+	// 先退出选主并释放锁,其他实例可以尽快接管定时任务
+	if j.cancel != nil {
+		j.cancel()
+		<-j.done
 	}
+	err := j.client.Close()
+	if err != nil {
+		logger.Errorf(ctx, "failed to stop job server: %v", err)
+		return err
+	}
+	logger.Info(ctx, "job server stopped")
+	return nil
 }
@@ -0,0 +1,18 @@
+package server
+
+import (
+	userv1 "github.com/ydssx/kratos-kit/api/user/v1"
+	"github.com/ydssx/kratos-kit/internal/biz"
+	"github.com/ydssx/kratos-kit/pkg/rbac"
+)
+
+// newPermissionRules 接口所需权限,未配置的接口只要求登录
+func newPermissionRules() map[string]rbac.Permission {
+	return map[string]rbac.Permission{
+		userv1.OperationUserServiceGetUser:           biz.PermUserRead,
+		userv1.OperationUserServiceUpdateUser:        biz.PermUserUpdate,
+		userv1.OperationUserServiceListSessions:      biz.PermSessionRead,
+		userv1.OperationUserServiceRevokeSession:     biz.PermSessionRevoke,
+		userv1.OperationUserServiceRevokeAllSessions: biz.PermSessionRevoke,
+	}
+}
@@ -12,6 +12,7 @@ import (
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(
 	common.NewRateLimiter,
+	common.NewHealthService,
 	wire.Bind(new(limit.Limiter), new(*limit.RedisLimiter)),
 	NewHTTPServer,
 	NewJobServer,
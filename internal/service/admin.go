@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	adminv1 "github.com/ydssx/kratos-kit/api/admin/v1"
 	"github.com/ydssx/kratos-kit/internal/biz"
@@ -9,18 +11,20 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http/binding"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-var _ = context.Background
-
 type AdminService struct {
-	uc *biz.AdminUseCase
+	uc    *biz.AdminUseCase
+	audit *biz.AuditUseCase
+	cron  *biz.CronUseCase
 
 	adminv1.UnimplementedAdminServiceServer
 }
 
-func NewAdminService(uc *biz.AdminUseCase) *AdminService {
-	return &AdminService{uc: uc}
+func NewAdminService(uc *biz.AdminUseCase, audit *biz.AuditUseCase, cron *biz.CronUseCase) *AdminService {
+	return &AdminService{uc: uc, audit: audit, cron: cron}
 }
 
 func (s *AdminService) Upload(c *gin.Context) {
@@ -45,3 +49,89 @@ func (s *AdminService) Upload(c *gin.Context) {
 	// 返回文件URL
 	util.OKWithData(c, data)
 }
+
+// ListUsers 用户列表
+func (s *AdminService) ListUsers(ctx context.Context, req *adminv1.ListUsersRequest) (*adminv1.ListUsersResponse, error) {
+	return s.uc.ListUsers(ctx, req)
+}
+
+// GetUser 用户详情
+func (s *AdminService) GetUser(ctx context.Context, req *adminv1.GetUserRequest) (*adminv1.User, error) {
+	return s.uc.GetUser(ctx, req)
+}
+
+// UpdateUser 更新用户信息
+func (s *AdminService) UpdateUser(ctx context.Context, req *adminv1.UpdateUserRequest) (*adminv1.User, error) {
+	return s.uc.UpdateUser(ctx, req)
+}
+
+// BanUser 封禁用户
+func (s *AdminService) BanUser(ctx context.Context, req *adminv1.BanUserRequest) (*emptypb.Empty, error) {
+	return s.uc.BanUser(ctx, req)
+}
+
+// UnbanUser 解除封禁
+func (s *AdminService) UnbanUser(ctx context.Context, req *adminv1.UnbanUserRequest) (*emptypb.Empty, error) {
+	return s.uc.UnbanUser(ctx, req)
+}
+
+// DeleteUser 删除用户
+func (s *AdminService) DeleteUser(ctx context.Context, req *adminv1.DeleteUserRequest) (*emptypb.Empty, error) {
+	return s.uc.DeleteUser(ctx, req)
+}
+
+// ImpersonateUser 以用户身份登录
+func (s *AdminService) ImpersonateUser(ctx context.Context, req *adminv1.ImpersonateUserRequest) (*adminv1.ImpersonateUserResponse, error) {
+	return s.uc.ImpersonateUser(ctx, req)
+}
+
+// ListAuditLogs 审计日志列表
+func (s *AdminService) ListAuditLogs(ctx context.Context, req *adminv1.ListAuditLogsRequest) (*adminv1.ListAuditLogsResponse, error) {
+	return s.audit.ListAuditLogs(ctx, req)
+}
+
+// ExportAuditLogs 导出审计日志CSV,查询参数与 ListAuditLogs 相同
+func (s *AdminService) ExportAuditLogs(c *gin.Context) {
+	req := new(adminv1.ListAuditLogsRequest)
+	if err := binding.BindQuery(c.Request.URL.Query(), req); err != nil {
+		util.FailWithError(c, err)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		util.FailWithError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=audit_logs_%s.csv", time.Now().Format("20060102150405")))
+	if err := s.audit.ExportAuditLogs(c.Request.Context(), req, c.Writer); err != nil {
+		// 已开始写入CSV时无法再返回错误响应
+		if c.Writer.Written() {
+			_ = c.Error(err)
+			return
+		}
+		c.Header("Content-Type", "")
+		c.Header("Content-Disposition", "")
+		util.FailWithError(c, err)
+	}
+}
+
+// ListCronJobs 定时任务列表
+func (s *AdminService) ListCronJobs(ctx context.Context, req *adminv1.ListCronJobsRequest) (*adminv1.ListCronJobsResponse, error) {
+	return s.cron.ListCronJobs(ctx, req)
+}
+
+// UpsertCronJob 创建或更新定时任务
+func (s *AdminService) UpsertCronJob(ctx context.Context, req *adminv1.UpsertCronJobRequest) (*adminv1.CronJob, error) {
+	return s.cron.UpsertCronJob(ctx, req)
+}
+
+// PauseCronJob 暂停或恢复定时任务
+func (s *AdminService) PauseCronJob(ctx context.Context, req *adminv1.PauseCronJobRequest) (*emptypb.Empty, error) {
+	return s.cron.PauseCronJob(ctx, req)
+}
+
+// TriggerNow 立即执行一次定时任务
+func (s *AdminService) TriggerNow(ctx context.Context, req *adminv1.TriggerNowRequest) (*adminv1.TriggerNowResponse, error) {
+	return s.cron.TriggerNow(ctx, req)
+}
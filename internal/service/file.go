@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	filev1 "github.com/ydssx/kratos-kit/api/file/v1"
+	"github.com/ydssx/kratos-kit/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// FileService 文件服务
+type FileService struct {
+	uc *biz.FileUseCase
+}
+
+func NewFileService(uc *biz.FileUseCase) *FileService {
+	return &FileService{uc: uc}
+}
+
+// CreateUpload 创建分片上传会话
+func (s *FileService) CreateUpload(ctx context.Context, req *filev1.CreateUploadRequest) (*filev1.CreateUploadResponse, error) {
+	return s.uc.CreateUpload(ctx, req)
+}
+
+// CompleteUpload 完成分片上传
+func (s *FileService) CompleteUpload(ctx context.Context, req *filev1.CompleteUploadRequest) (*filev1.CompleteUploadResponse, error) {
+	return s.uc.CompleteUpload(ctx, req)
+}
+
+// AbortUpload 取消分片上传
+func (s *FileService) AbortUpload(ctx context.Context, req *filev1.AbortUploadRequest) (*emptypb.Empty, error) {
+	return s.uc.AbortUpload(ctx, req)
+}
+
+// GetFile 获取文件信息
+func (s *FileService) GetFile(ctx context.Context, req *filev1.GetFileRequest) (*filev1.File, error) {
+	return s.uc.GetFile(ctx, req)
+}
+
+// ListFiles 分页获取文件列表
+func (s *FileService) ListFiles(ctx context.Context, req *filev1.ListFilesRequest) (*filev1.ListFilesResponse, error) {
+	return s.uc.ListFiles(ctx, req)
+}
+
+// DeleteFile 删除文件
+func (s *FileService) DeleteFile(ctx context.Context, req *filev1.DeleteFileRequest) (*emptypb.Empty, error) {
+	return s.uc.DeleteFile(ctx, req)
+}
+
+// RenameFile 修改文件名称
+func (s *FileService) RenameFile(ctx context.Context, req *filev1.RenameFileRequest) (*filev1.File, error) {
+	return s.uc.RenameFile(ctx, req)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	jobv1 "github.com/ydssx/kratos-kit/api/job/v1"
+	"github.com/ydssx/kratos-kit/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// JobService 队列任务管理服务
+type JobService struct {
+	jobv1.UnimplementedJobServiceServer
+
+	uc *biz.JobUseCase
+}
+
+func NewJobService(uc *biz.JobUseCase) *JobService {
+	return &JobService{uc: uc}
+}
+
+// Enqueue 提交任务
+func (s *JobService) Enqueue(ctx context.Context, req *jobv1.EnqueueRequest) (*jobv1.EnqueueResponse, error) {
+	return s.uc.Enqueue(ctx, req)
+}
+
+// QueryTasks 查询任务
+func (s *JobService) QueryTasks(ctx context.Context, req *jobv1.QueryTasksRequest) (*jobv1.QueryTasksResponse, error) {
+	return s.uc.QueryTasks(ctx, req)
+}
+
+// CancelTask 取消任务
+func (s *JobService) CancelTask(ctx context.Context, req *jobv1.CancelTaskRequest) (*emptypb.Empty, error) {
+	return s.uc.CancelTask(ctx, req)
+}
+
+// RetryTask 立即重试任务
+func (s *JobService) RetryTask(ctx context.Context, req *jobv1.RetryTaskRequest) (*emptypb.Empty, error) {
+	return s.uc.RetryTask(ctx, req)
+}
+
+// QueuingTime 查询任务排队时间
+func (s *JobService) QueuingTime(ctx context.Context, req *jobv1.QueuingTimeRequest) (*jobv1.QueuingTimeResponse, error) {
+	return s.uc.QueuingTime(ctx, req)
+}
+
+// ListArchivedTasks 查询已归档的任务
+func (s *JobService) ListArchivedTasks(ctx context.Context, req *jobv1.ListArchivedTasksRequest) (*jobv1.ListArchivedTasksResponse, error) {
+	return s.uc.ListArchivedTasks(ctx, req)
+}
+
+// GetArchivedTask 查看已归档的任务
+func (s *JobService) GetArchivedTask(ctx context.Context, req *jobv1.GetArchivedTaskRequest) (*jobv1.ArchivedTask, error) {
+	return s.uc.GetArchivedTask(ctx, req)
+}
+
+// RequeueArchivedTask 重新投递已归档的任务
+func (s *JobService) RequeueArchivedTask(ctx context.Context, req *jobv1.RequeueArchivedTaskRequest) (*jobv1.RequeueArchivedTaskResponse, error) {
+	return s.uc.RequeueArchivedTask(ctx, req)
+}
+
+// DeleteArchivedTask 删除已归档的任务
+func (s *JobService) DeleteArchivedTask(ctx context.Context, req *jobv1.DeleteArchivedTaskRequest) (*emptypb.Empty, error) {
+	return s.uc.DeleteArchivedTask(ctx, req)
+}
@@ -9,4 +9,6 @@ var ProviderSet = wire.NewSet(
 	NewUserService,
 	NewCommonService,
 	NewAdminService,
+	NewFileService,
+	NewJobService,
 )
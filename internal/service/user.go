@@ -14,11 +14,12 @@ import (
 // GreeterService is a greeter service.
 type UserService struct {
 	userv1.UserServiceHTTPServer
-	uc *biz.UserUseCase
+	uc   *biz.UserUseCase
+	rbac *biz.RBACUseCase
 }
 
-func NewUserService(uc *biz.UserUseCase) *UserService {
-	return &UserService{uc: uc}
+func NewUserService(uc *biz.UserUseCase, rbac *biz.RBACUseCase) *UserService {
+	return &UserService{uc: uc, rbac: rbac}
 }
 
 // Login 用户登录
@@ -31,6 +32,11 @@ func (s *UserService) GetUser(ctx context.Context, g *emptypb.Empty) (*userv1.Ge
 	return s.uc.GetUser(ctx, g)
 }
 
+// GetUserPermission 获取用户权限
+func (s *UserService) GetUserPermission(ctx context.Context, req *userv1.GetUserPermissionRequest) (*userv1.UserPermissionListResponse, error) {
+	return s.rbac.GetUserPermission(ctx, req)
+}
+
 // Create 创建用户
 func (s *UserService) Create(ctx context.Context, req *userv1.CreateRequest) (*userv1.LoginResponse, error) {
 	return s.uc.Create(ctx, req)
@@ -46,6 +52,36 @@ func (s *UserService) GoogleLogin(ctx context.Context, req *emptypb.Empty) (*use
 	return s.uc.GoogleLogin(ctx, req)
 }
 
+// OAuthLogin 获取第三方登录授权地址
+func (s *UserService) OAuthLogin(ctx context.Context, req *userv1.OAuthLoginRequest) (*userv1.OAuthLoginResponse, error) {
+	return s.uc.OAuthLogin(ctx, req)
+}
+
+// OAuthCallback 第三方登录回调,Apple以form_post方式回调
+func (s *UserService) OAuthCallback(ctx *gin.Context) {
+	res, err := s.uc.OAuthCallback(ctx, ctx.Param("provider"), ctx.Request.FormValue("code"), ctx.Request.FormValue("state"))
+	if err != nil {
+		util.FailWithError(ctx, err)
+		return
+	}
+	util.OKWithData(ctx, res)
+}
+
+// LinkIdentity 关联第三方账号
+func (s *UserService) LinkIdentity(ctx context.Context, req *userv1.LinkIdentityRequest) (*userv1.OAuthLoginResponse, error) {
+	return s.uc.LinkIdentity(ctx, req)
+}
+
+// UnlinkIdentity 解除第三方账号关联
+func (s *UserService) UnlinkIdentity(ctx context.Context, req *userv1.UnlinkIdentityRequest) (*emptypb.Empty, error) {
+	return s.uc.UnlinkIdentity(ctx, req)
+}
+
+// ListIdentities 获取已关联的第三方账号
+func (s *UserService) ListIdentities(ctx context.Context, req *emptypb.Empty) (*userv1.ListIdentitiesResponse, error) {
+	return s.uc.ListIdentities(ctx, req)
+}
+
 // GoogleCallback 处理Google回调
 func (s *UserService) GoogleCallback(ctx *gin.Context) {
 	res, err := s.uc.GoogleCallback(ctx)
@@ -75,3 +111,53 @@ func (s *UserService) SendVerificationCode(ctx context.Context, req *userv1.Send
 func (s *UserService) UpdateUser(ctx context.Context, req *userv1.UpdateUserRequest) (res *emptypb.Empty, err error) {
 	return s.uc.UpdateUser(ctx, req)
 }
+
+// RefreshToken 刷新令牌
+func (s *UserService) RefreshToken(ctx context.Context, req *userv1.RefreshTokenRequest) (res *userv1.LoginResponse, err error) {
+	return s.uc.RefreshToken(ctx, req)
+}
+
+// ListSessions 获取登录会话列表
+func (s *UserService) ListSessions(ctx context.Context, req *emptypb.Empty) (res *userv1.ListSessionsResponse, err error) {
+	return s.uc.ListSessions(ctx, req)
+}
+
+// RevokeSession 撤销指定会话
+func (s *UserService) RevokeSession(ctx context.Context, req *userv1.RevokeSessionRequest) (res *emptypb.Empty, err error) {
+	return s.uc.RevokeSession(ctx, req)
+}
+
+// RevokeAllSessions 撤销所有会话
+func (s *UserService) RevokeAllSessions(ctx context.Context, req *userv1.RevokeAllSessionsRequest) (res *emptypb.Empty, err error) {
+	return s.uc.RevokeAllSessions(ctx, req)
+}
+
+// RequestPasswordReset 申请重置密码
+func (s *UserService) RequestPasswordReset(ctx context.Context, req *userv1.RequestPasswordResetRequest) (res *emptypb.Empty, err error) {
+	return s.uc.RequestPasswordReset(ctx, req)
+}
+
+// ResetPassword 重置密码
+func (s *UserService) ResetPassword(ctx context.Context, req *userv1.ResetPasswordRequest) (res *emptypb.Empty, err error) {
+	return s.uc.ResetPassword(ctx, req)
+}
+
+// ChangePassword 修改密码
+func (s *UserService) ChangePassword(ctx context.Context, req *userv1.ChangePasswordRequest) (res *emptypb.Empty, err error) {
+	return s.uc.ChangePassword(ctx, req)
+}
+
+// EnrollTOTP 开始绑定两步验证
+func (s *UserService) EnrollTOTP(ctx context.Context, req *emptypb.Empty) (res *userv1.EnrollTOTPResponse, err error) {
+	return s.uc.EnrollTOTP(ctx, req)
+}
+
+// ConfirmTOTP 确认绑定两步验证
+func (s *UserService) ConfirmTOTP(ctx context.Context, req *userv1.ConfirmTOTPRequest) (res *userv1.ConfirmTOTPResponse, err error) {
+	return s.uc.ConfirmTOTP(ctx, req)
+}
+
+// VerifyTOTP 两步验证登录
+func (s *UserService) VerifyTOTP(ctx context.Context, req *userv1.VerifyTOTPRequest) (res *userv1.LoginResponse, err error) {
+	return s.uc.VerifyTOTP(ctx, req)
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 审计结果
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+	AuditResultDenied  = "denied"
+)
+
+// table admin_audit_logs 管理员操作审计日志,只追加不修改
+type AdminAuditLog struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ActorId   uint      `json:"actor_id" gorm:"column:actor_id;not null;index:idx_actor_created"`           // 操作人ID
+	Operation string    `json:"operation" gorm:"column:operation;type:VARCHAR(128);not null;index"`         // 操作,如 /admin.v1.AdminService/BanUser
+	Target    string    `json:"target" gorm:"column:target;type:VARCHAR(128);not null;default:'';index"`    // 操作对象,如 admin_user:42
+	Request   string    `json:"request" gorm:"column:request;type:TEXT"`                                    // 请求参数,敏感字段已脱敏
+	Before    string    `json:"before" gorm:"column:before;type:TEXT"`                                      // 修改前的数据,敏感字段已脱敏
+	After     string    `json:"after" gorm:"column:after;type:TEXT"`                                        // 修改后的数据,敏感字段已脱敏
+	ClientIp  string    `json:"client_ip" gorm:"column:client_ip;type:VARCHAR(64);not null;default:''"`     // 客户端IP
+	TraceId   string    `json:"trace_id" gorm:"column:trace_id;type:VARCHAR(64);not null;default:''"`       // 链路ID
+	Result    string    `json:"result" gorm:"column:result;type:VARCHAR(16);not null"`                      // 结果 success/failure/denied
+	Reason    string    `json:"reason" gorm:"column:reason;type:VARCHAR(255);not null;default:''"`          // 失败原因
+	LatencyMs int64     `json:"latency_ms" gorm:"column:latency_ms;not null;default:0"`                     // 耗时(毫秒)
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;not null;index:idx_actor_created;index"` // 操作时间
+}
+
+type adminAuditLogModel DB
+
+func NewAdminAuditLogModel(tx ...*gorm.DB) *adminAuditLogModel {
+	db := getDB(tx...).Table("admin_audit_logs").Model(&AdminAuditLog{})
+	return &adminAuditLogModel{db: db}
+}
+
+// SetActorId 设置操作人ID
+func (m *adminAuditLogModel) SetActorId(actorId uint) *adminAuditLogModel {
+	m.db = m.db.Where("actor_id = ?", actorId)
+	return m
+}
+
+// SetOperation 设置操作
+func (m *adminAuditLogModel) SetOperation(operation string) *adminAuditLogModel {
+	m.db = m.db.Where("operation = ?", operation)
+	return m
+}
+
+// SetTarget 设置操作对象
+func (m *adminAuditLogModel) SetTarget(target string) *adminAuditLogModel {
+	m.db = m.db.Where("target = ?", target)
+	return m
+}
+
+// SetResult 设置结果
+func (m *adminAuditLogModel) SetResult(result string) *adminAuditLogModel {
+	m.db = m.db.Where("result = ?", result)
+	return m
+}
+
+// CreatedAtGte 操作时间不早于
+func (m *adminAuditLogModel) CreatedAtGte(t time.Time) *adminAuditLogModel {
+	m.db = m.db.Where("created_at >= ?", t)
+	return m
+}
+
+// CreatedAtLt 操作时间早于
+func (m *adminAuditLogModel) CreatedAtLt(t time.Time) *adminAuditLogModel {
+	m.db = m.db.Where("created_at < ?", t)
+	return m
+}
+
+// IdLt ID小于,用于按ID倒序分批导出
+func (m *adminAuditLogModel) IdLt(id uint) *adminAuditLogModel {
+	m.db = m.db.Where("id < ?", id)
+	return m
+}
+
+// AdminAuditLogFilter 审计日志筛选条件,零值字段不参与筛选
+type AdminAuditLogFilter struct {
+	ActorId     uint
+	Operation   string
+	Target      string
+	Result      string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	IdLt        uint
+}
+
+// Filter 按条件筛选
+func (m *adminAuditLogModel) Filter(f AdminAuditLogFilter) *adminAuditLogModel {
+	if f.ActorId > 0 {
+		m.SetActorId(f.ActorId)
+	}
+	if f.Operation != "" {
+		m.SetOperation(f.Operation)
+	}
+	if f.Target != "" {
+		m.SetTarget(f.Target)
+	}
+	if f.Result != "" {
+		m.SetResult(f.Result)
+	}
+	if !f.CreatedFrom.IsZero() {
+		m.CreatedAtGte(f.CreatedFrom)
+	}
+	if !f.CreatedTo.IsZero() {
+		m.CreatedAtLt(f.CreatedTo)
+	}
+	if f.IdLt > 0 {
+		m.IdLt(f.IdLt)
+	}
+	return m
+}
+
+func (m *adminAuditLogModel) BatchCreate(logs []*AdminAuditLog) error {
+	return m.db.Create(logs).Error
+}
+
+func (m *adminAuditLogModel) List(limit int) (data []AdminAuditLog, err error) {
+	err = m.db.Order("id DESC").Limit(limit).Find(&data).Error
+	return
+}
+
+func (m *adminAuditLogModel) PageList(limit, offset int) (data []AdminAuditLog, total int64, err error) {
+	if err = m.db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err = m.db.Order("id DESC").Limit(limit).Offset(offset).Find(&data).Error
+	return
+}
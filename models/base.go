@@ -1,6 +1,8 @@
 package models
 
 import (
+	"strings"
+
 	"github.com/ydssx/kratos-kit/pkg/client/mysql"
 
 	"github.com/Gre-Z/common/jtime"
@@ -87,3 +89,10 @@ func cloneDB(db *gorm.DB) *gorm.DB {
 	tx.Statement.DB = tx
 	return tx
 }
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike 转义LIKE查询中的通配符
+func escapeLike(s string) string {
+	return likeReplacer.Replace(s)
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// table cron_jobs 定时任务,任务服务器按此表调度,修改后热加载
+type CronJob struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	JobType     string    `json:"job_type" gorm:"column:job_type;type:VARCHAR(64);not null;index"`             // 任务类型,jobv1.JobType 的名称
+	Spec        string    `json:"spec" gorm:"column:spec;type:VARCHAR(64);not null"`                           // cron 表达式
+	Payload     string    `json:"payload" gorm:"column:payload;type:TEXT"`                                     // 任务参数,任务参数消息的 JSON 编码
+	Timezone    string    `json:"timezone" gorm:"column:timezone;type:VARCHAR(64);not null;default:''"`        // 时区,为空时使用服务器时区
+	Enabled     bool      `json:"enabled" gorm:"column:enabled;not null;default:false"`                        // 是否启用
+	Description string    `json:"description" gorm:"column:description;type:VARCHAR(255);not null;default:''"` // 描述
+	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at;not null"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at;not null"`
+}
+
+type cronJobModel DB
+
+func NewCronJobModel(tx ...*gorm.DB) *cronJobModel {
+	db := getDB(tx...).Table("cron_jobs").Model(&CronJob{})
+	return &cronJobModel{db: db}
+}
+
+// SetId 设置主键
+func (m *cronJobModel) SetId(id uint) *cronJobModel {
+	m.db = m.db.Where("id = ?", id)
+	return m
+}
+
+// SetJobType 设置任务类型
+func (m *cronJobModel) SetJobType(jobType string) *cronJobModel {
+	m.db = m.db.Where("job_type = ?", jobType)
+	return m
+}
+
+// SetEnabled 设置是否启用
+func (m *cronJobModel) SetEnabled(enabled bool) *cronJobModel {
+	m.db = m.db.Where("enabled = ?", enabled)
+	return m
+}
+
+func (m *cronJobModel) Select(fields ...string) *cronJobModel {
+	m.db = m.db.Select(fields)
+	return m
+}
+
+func (m *cronJobModel) Create(job *CronJob) error {
+	return m.db.Create(job).Error
+}
+
+func (m *cronJobModel) FirstOne() (data CronJob, err error) {
+	err = m.db.Take(&data).Error
+	return
+}
+
+func (m *cronJobModel) Updates(values interface{}) error {
+	return m.db.Updates(values).Error
+}
+
+func (m *cronJobModel) Count() (total int64, err error) {
+	err = m.db.Count(&total).Error
+	return
+}
+
+func (m *cronJobModel) List() (data []CronJob, err error) {
+	err = m.db.Order("id ASC").Find(&data).Error
+	return
+}
+
+func (m *cronJobModel) PageList(limit, offset int) (data []CronJob, total int64, err error) {
+	if err = m.db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err = m.db.Order("id ASC").Limit(limit).Offset(offset).Find(&data).Error
+	return
+}
+
+// 定时任务执行结果
+const (
+	CronRunRunning = "running"
+	CronRunSuccess = "success"
+	CronRunFailure = "failure"
+)
+
+// table cron_job_runs 定时任务执行记录,每次执行(包括重试)一条
+type CronJobRun struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	CronJobId  uint       `json:"cron_job_id" gorm:"column:cron_job_id;not null;index:idx_cron_started"` // 定时任务ID
+	JobType    string     `json:"job_type" gorm:"column:job_type;type:VARCHAR(64);not null"`             // 任务类型
+	TaskId     string     `json:"task_id" gorm:"column:task_id;type:VARCHAR(128);not null;default:''"`   // 队列任务ID
+	Attempt    int        `json:"attempt" gorm:"column:attempt;not null;default:0"`                      // 第几次重试,首次执行为0
+	Status     string     `json:"status" gorm:"column:status;type:VARCHAR(16);not null"`                 // 结果 running/success/failure
+	Error      string     `json:"error" gorm:"column:error;type:VARCHAR(1024);not null;default:''"`      // 失败原因
+	StartedAt  time.Time  `json:"started_at" gorm:"column:started_at;not null;index:idx_cron_started"`   // 开始时间
+	FinishedAt *time.Time `json:"finished_at" gorm:"column:finished_at"`                                 // 结束时间
+}
+
+type cronJobRunModel DB
+
+func NewCronJobRunModel(tx ...*gorm.DB) *cronJobRunModel {
+	db := getDB(tx...).Table("cron_job_runs").Model(&CronJobRun{})
+	return &cronJobRunModel{db: db}
+}
+
+// SetId 设置主键
+func (m *cronJobRunModel) SetId(id uint) *cronJobRunModel {
+	m.db = m.db.Where("id = ?", id)
+	return m
+}
+
+func (m *cronJobRunModel) Select(fields ...string) *cronJobRunModel {
+	m.db = m.db.Select(fields)
+	return m
+}
+
+func (m *cronJobRunModel) Create(run *CronJobRun) error {
+	return m.db.Create(run).Error
+}
+
+func (m *cronJobRunModel) Updates(values interface{}) error {
+	return m.db.Updates(values).Error
+}
+
+// ListLatest 获取每个定时任务最近一次的执行记录
+func (m *cronJobRunModel) ListLatest(cronJobIds ...uint) (data []CronJobRun, err error) {
+	latest := m.db.Session(&gorm.Session{NewDB: true}).Table("cron_job_runs").
+		Select("MAX(id)").Where("cron_job_id IN (?)", cronJobIds).Group("cron_job_id")
+	err = m.db.Where("id IN (?)", latest).Find(&data).Error
+	return
+}
@@ -13,17 +13,22 @@ type FileMetadata struct {
 	BaseModel
 	UserId        int            `json:"user_id" gorm:"column:user_id;default:0"`
 	Filename      string         `json:"filename" gorm:"column:filename;not null"`
-	FileUrl       string         `json:"file_url" gorm:"column:file_url;not null"` // 文件存储路径
+	Name          string         `json:"name" gorm:"column:name;type:VARCHAR(255);default:''"` // 显示的文件名,默认为上传时的文件名
+	FileUrl       string         `json:"file_url" gorm:"column:file_url;not null"`             // 文件存储路径
 	UploadTime    jtime.JsonTime `json:"upload_time" gorm:"column:upload_time;default:CURRENT_TIMESTAMP"`
-	FileSize      int            `json:"file_size" gorm:"column:file_size;default:NULL"`              // 文件大小(字节)
-	FileType      FileType       `json:"file_type" gorm:"column:file_type;default:NULL"`              // 文件类型
-	FileMd5       string         `json:"file_md5" gorm:"column:file_md5;default:NULL"`                // 文件MD5值
-	VideoDuration float64        `json:"video_duration" gorm:"column:video_duration;default:NULL"`    // 视频时长(秒)
-	CoverUrl      string         `json:"cover_url" gorm:"column:cover_url;default:NULL"`              // 视频封面图路径
-	Width         int            `json:"width" gorm:"column:width;not null;default:0"`                // 视频宽度，单位像素
-	Height        int            `json:"height" gorm:"column:height;not null;default:0"`              // 视频高度，单位像素
-	Fps           float64        `json:"fps" gorm:"column:fps;not null;default:0"`                    // 视频帧率
-	Encoding      string         `json:"encoding" gorm:"column:encoding;type:VARCHAR(50);default:''"` // 编码
+	FileSize      int            `json:"file_size" gorm:"column:file_size;default:NULL"`                         // 文件大小(字节)
+	FileType      FileType       `json:"file_type" gorm:"column:file_type;default:NULL"`                         // 文件类型
+	FileMd5       string         `json:"file_md5" gorm:"column:file_md5;default:NULL"`                           // 文件MD5值
+	VideoDuration float64        `json:"video_duration" gorm:"column:video_duration;default:NULL"`               // 视频时长(秒)
+	CoverUrl      string         `json:"cover_url" gorm:"column:cover_url;default:NULL"`                         // 视频封面图路径
+	Width         int            `json:"width" gorm:"column:width;not null;default:0"`                           // 视频宽度，单位像素
+	Height        int            `json:"height" gorm:"column:height;not null;default:0"`                         // 视频高度，单位像素
+	Fps           float64        `json:"fps" gorm:"column:fps;not null;default:0"`                               // 视频帧率
+	Encoding      string         `json:"encoding" gorm:"column:encoding;type:VARCHAR(50);default:''"`            // 编码
+	Status        FileStatus     `json:"status" gorm:"column:status;type:VARCHAR(16);not null;default:'ready'"`  // 处理状态
+	Steps         MediaSteps     `json:"steps" gorm:"column:steps;type:JSON;serializer:json"`                    // 各处理步骤的状态
+	Variants      MediaVariants  `json:"variants" gorm:"column:variants;type:JSON;serializer:json"`              // 衍生文件地址,如缩略图
+	ProcessError  string         `json:"process_error" gorm:"column:process_error;type:VARCHAR(512);default:''"` // 处理失败原因
 }
 
 type fileMetadataModel DB
@@ -36,6 +41,28 @@ const (
 	FileTypeAudio FileType = "audio"
 )
 
+// FileStatus 文件处理状态
+type FileStatus string
+
+const (
+	FileStatusProcessing FileStatus = "processing" // 已上传,等待转码、生成缩略图等处理
+	FileStatusReady      FileStatus = "ready"
+	FileStatusFailed     FileStatus = "failed"
+)
+
+// 处理步骤状态
+const (
+	StepPending = "pending"
+	StepDone    = "done"
+	StepFailed  = "failed"
+)
+
+// MediaSteps 处理步骤名到状态的映射
+type MediaSteps map[string]string
+
+// MediaVariants 衍生文件名到访问地址的映射
+type MediaVariants map[string]string
+
 func NewFileMetadataModel(tx ...*gorm.DB) *fileMetadataModel {
 	db := getDB(tx...).Table("file_metadata").Model(&FileMetadata{})
 	return &fileMetadataModel{db: db}
@@ -62,6 +89,18 @@ func (m *fileMetadataModel) SetUserId(userId ...int64) *fileMetadataModel {
 	return m
 }
 
+// SetFileType 设置文件类型
+func (m *fileMetadataModel) SetFileType(fileType FileType) *fileMetadataModel {
+	m.db = m.db.Where("file_type = ?", fileType)
+	return m
+}
+
+// SetFilename 设置对象名
+func (m *fileMetadataModel) SetFilename(filename string) *fileMetadataModel {
+	m.db = m.db.Where("filename = ?", filename)
+	return m
+}
+
 func (m *fileMetadataModel) Order(expr string) *fileMetadataModel {
 	m.db = m.db.Order(expr)
 	return m
@@ -115,6 +154,17 @@ func (m *fileMetadataModel) PageList(limit, offset int) (data []FileMetadata, to
 	return
 }
 
+func (m *fileMetadataModel) Count() (total int64, err error) {
+	err = m.db.Count(&total).Error
+	return
+}
+
+// SumFileSize 文件大小之和
+func (m *fileMetadataModel) SumFileSize() (total int64, err error) {
+	err = m.db.Select("COALESCE(SUM(file_size), 0)").Scan(&total).Error
+	return
+}
+
 func (m *fileMetadataModel) Delete() error {
 	return m.db.Delete(&FileMetadata{}).Error
 }
@@ -0,0 +1,191 @@
+package models
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// table roles 角色表
+type Role struct {
+	BaseModelNoDelete
+	Name        string `json:"name" gorm:"column:name;type:VARCHAR(64);not null;uniqueIndex"` // 角色名,如admin/user
+	Description string `json:"description" gorm:"column:description;default:NULL"`            // 描述
+}
+
+// table permissions 权限表,(resource, action) 唯一
+type Permission struct {
+	BaseModelNoDelete
+	Resource    string `json:"resource" gorm:"column:resource;type:VARCHAR(64);not null;uniqueIndex:idx_resource_action"` // 资源,如user
+	Action      string `json:"action" gorm:"column:action;type:VARCHAR(64);not null;uniqueIndex:idx_resource_action"`     // 操作,如read/update,*表示全部
+	Description string `json:"description" gorm:"column:description;default:NULL"`                                        // 描述
+}
+
+// table role_permissions 角色权限关联表
+type RolePermission struct {
+	ID           uint `json:"id" gorm:"primaryKey"`
+	RoleId       uint `json:"role_id" gorm:"column:role_id;not null;uniqueIndex:idx_role_permission"`             // 角色ID
+	PermissionId uint `json:"permission_id" gorm:"column:permission_id;not null;uniqueIndex:idx_role_permission"` // 权限ID
+}
+
+// table user_roles 用户角色关联表
+type UserRole struct {
+	BaseModelNoDelete
+	UserId uint `json:"user_id" gorm:"column:user_id;not null;uniqueIndex:idx_user_role"`       // 用户ID
+	RoleId uint `json:"role_id" gorm:"column:role_id;not null;uniqueIndex:idx_user_role;index"` // 角色ID
+}
+
+// RoleGrant 角色及其授予的权限,用于联表查询结果
+type RoleGrant struct {
+	Role     string `json:"role" gorm:"column:role"`
+	Resource string `json:"resource" gorm:"column:resource"`
+	Action   string `json:"action" gorm:"column:action"`
+}
+
+type roleModel DB
+
+func NewRoleModel(tx ...*gorm.DB) *roleModel {
+	db := getDB(tx...).Table("roles").Model(&Role{})
+	return &roleModel{db: db}
+}
+
+// SetName 设置角色名
+func (m *roleModel) SetName(name string) *roleModel {
+	m.db = m.db.Where("name = ?", name)
+	return m
+}
+
+// SetNames 设置角色名列表
+func (m *roleModel) SetNames(names []string) *roleModel {
+	m.db = m.db.Where("name IN ?", names)
+	return m
+}
+
+func (m *roleModel) WithContext(ctx context.Context) *roleModel {
+	m.db = m.db.WithContext(ctx)
+	return m
+}
+
+func (m *roleModel) Create(role *Role) error {
+	return m.db.Create(role).Error
+}
+
+func (m *roleModel) FirstOne() (data *Role, err error) {
+	err = m.db.Take(&data).Error
+	return
+}
+
+func (m *roleModel) List() (data []Role, err error) {
+	err = m.db.Order("id").Find(&data).Error
+	return
+}
+
+// ListUserRoles 获取用户拥有的角色
+func (m *roleModel) ListUserRoles(userId uint) (data []Role, err error) {
+	err = m.db.Joins("JOIN user_roles ON user_roles.role_id = roles.id").
+		Where("user_roles.user_id = ?", userId).Order("roles.id").Find(&data).Error
+	return
+}
+
+// ListGrants 获取角色授予的权限,需先通过 SetName/SetNames 限定角色
+func (m *roleModel) ListGrants() (data []RoleGrant, err error) {
+	err = m.db.Select("roles.name AS role, permissions.resource, permissions.action").
+		Joins("JOIN role_permissions ON role_permissions.role_id = roles.id").
+		Joins("JOIN permissions ON permissions.id = role_permissions.permission_id").
+		Scan(&data).Error
+	return
+}
+
+type permissionModel DB
+
+func NewPermissionModel(tx ...*gorm.DB) *permissionModel {
+	db := getDB(tx...).Table("permissions").Model(&Permission{})
+	return &permissionModel{db: db}
+}
+
+// SetResource 设置资源
+func (m *permissionModel) SetResource(resource string) *permissionModel {
+	m.db = m.db.Where("resource = ?", resource)
+	return m
+}
+
+// SetAction 设置操作
+func (m *permissionModel) SetAction(action string) *permissionModel {
+	m.db = m.db.Where("action = ?", action)
+	return m
+}
+
+func (m *permissionModel) Create(permission *Permission) error {
+	return m.db.Create(permission).Error
+}
+
+func (m *permissionModel) FirstOne() (data *Permission, err error) {
+	err = m.db.Take(&data).Error
+	return
+}
+
+type rolePermissionModel DB
+
+func NewRolePermissionModel(tx ...*gorm.DB) *rolePermissionModel {
+	db := getDB(tx...).Table("role_permissions").Model(&RolePermission{})
+	return &rolePermissionModel{db: db}
+}
+
+// SetRoleId 设置角色ID
+func (m *rolePermissionModel) SetRoleId(roleId uint) *rolePermissionModel {
+	m.db = m.db.Where("role_id = ?", roleId)
+	return m
+}
+
+// SetPermissionId 设置权限ID
+func (m *rolePermissionModel) SetPermissionId(permissionId uint) *rolePermissionModel {
+	m.db = m.db.Where("permission_id = ?", permissionId)
+	return m
+}
+
+// FirstOrCreate 不存在时创建
+func (m *rolePermissionModel) FirstOrCreate(rp *RolePermission) error {
+	return m.db.Where(RolePermission{RoleId: rp.RoleId, PermissionId: rp.PermissionId}).FirstOrCreate(rp).Error
+}
+
+// DeleteAffected 删除并返回受影响的行数
+func (m *rolePermissionModel) DeleteAffected() (int64, error) {
+	res := m.db.Delete(&RolePermission{})
+	return res.RowsAffected, res.Error
+}
+
+type userRoleModel DB
+
+func NewUserRoleModel(tx ...*gorm.DB) *userRoleModel {
+	db := getDB(tx...).Table("user_roles").Model(&UserRole{})
+	return &userRoleModel{db: db}
+}
+
+// SetUserId 设置用户ID
+func (m *userRoleModel) SetUserId(userId uint) *userRoleModel {
+	m.db = m.db.Where("user_id = ?", userId)
+	return m
+}
+
+// SetRoleId 设置角色ID
+func (m *userRoleModel) SetRoleId(roleId uint) *userRoleModel {
+	m.db = m.db.Where("role_id = ?", roleId)
+	return m
+}
+
+// FirstOrCreate 不存在时创建
+func (m *userRoleModel) FirstOrCreate(ur *UserRole) error {
+	return m.db.Where(UserRole{UserId: ur.UserId, RoleId: ur.RoleId}).FirstOrCreate(ur).Error
+}
+
+// UserIds 获取用户ID列表
+func (m *userRoleModel) UserIds() (ids []uint, err error) {
+	err = m.db.Pluck("user_id", &ids).Error
+	return
+}
+
+// DeleteAffected 删除并返回受影响的行数
+func (m *userRoleModel) DeleteAffected() (int64, error) {
+	res := m.db.Delete(&UserRole{})
+	return res.RowsAffected, res.Error
+}
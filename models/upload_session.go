@@ -0,0 +1,115 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 上传会话状态
+const (
+	UploadStatusPending    = "pending"
+	UploadStatusCompleting = "completing"
+	UploadStatusCompleted  = "completed"
+)
+
+// table upload_sessions 分片直传会话,过期后由定时任务清理分片和记录
+type UploadSession struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	UploadId    string    `json:"upload_id" gorm:"column:upload_id;type:VARCHAR(64);not null;uniqueIndex"`   // 会话ID
+	UserId      int       `json:"user_id" gorm:"column:user_id;not null;index:idx_user_md5"`                 // 用户ID
+	Filename    string    `json:"filename" gorm:"column:filename;type:VARCHAR(255);not null"`                // 原始文件名
+	ContentType string    `json:"content_type" gorm:"column:content_type;type:VARCHAR(128);not null"`        // 文件类型
+	FileSize    int64     `json:"file_size" gorm:"column:file_size;not null"`                                // 文件大小(字节)
+	FileMd5     string    `json:"file_md5" gorm:"column:file_md5;type:CHAR(32);not null;index:idx_user_md5"` // 文件MD5
+	PartSize    int64     `json:"part_size" gorm:"column:part_size;not null"`                                // 分片大小(字节)
+	PartCount   int       `json:"part_count" gorm:"column:part_count;not null"`                              // 分片数
+	ObjectKey   string    `json:"object_key" gorm:"column:object_key;type:VARCHAR(255);not null"`            // 合并后的对象名,不含扩展名
+	Status      string    `json:"status" gorm:"column:status;type:VARCHAR(16);not null"`                     // 状态 pending/completing/completed
+	ExpiresAt   time.Time `json:"expires_at" gorm:"column:expires_at;not null;index"`                        // 过期时间
+	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at;not null"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at;not null"`
+}
+
+type uploadSessionModel DB
+
+func NewUploadSessionModel(tx ...*gorm.DB) *uploadSessionModel {
+	db := getDB(tx...).Table("upload_sessions").Model(&UploadSession{})
+	return &uploadSessionModel{db: db}
+}
+
+// SetId 设置主键
+func (m *uploadSessionModel) SetId(id uint) *uploadSessionModel {
+	m.db = m.db.Where("id = ?", id)
+	return m
+}
+
+// SetUploadId 设置会话ID
+func (m *uploadSessionModel) SetUploadId(uploadId string) *uploadSessionModel {
+	m.db = m.db.Where("upload_id = ?", uploadId)
+	return m
+}
+
+// SetUserId 设置用户ID
+func (m *uploadSessionModel) SetUserId(userId int) *uploadSessionModel {
+	m.db = m.db.Where("user_id = ?", userId)
+	return m
+}
+
+// SetMd5 设置文件MD5
+func (m *uploadSessionModel) SetMd5(md5 string) *uploadSessionModel {
+	m.db = m.db.Where("file_md5 = ?", md5)
+	return m
+}
+
+// SetFileSize 设置文件大小
+func (m *uploadSessionModel) SetFileSize(size int64) *uploadSessionModel {
+	m.db = m.db.Where("file_size = ?", size)
+	return m
+}
+
+// SetStatus 设置状态
+func (m *uploadSessionModel) SetStatus(status string) *uploadSessionModel {
+	m.db = m.db.Where("status = ?", status)
+	return m
+}
+
+// ExpiresAtGt 过期时间晚于
+func (m *uploadSessionModel) ExpiresAtGt(t time.Time) *uploadSessionModel {
+	m.db = m.db.Where("expires_at > ?", t)
+	return m
+}
+
+// ExpiresAtLte 过期时间不晚于
+func (m *uploadSessionModel) ExpiresAtLte(t time.Time) *uploadSessionModel {
+	m.db = m.db.Where("expires_at <= ?", t)
+	return m
+}
+
+func (m *uploadSessionModel) Create(session *UploadSession) error {
+	return m.db.Create(session).Error
+}
+
+func (m *uploadSessionModel) FirstOne() (data UploadSession, err error) {
+	err = m.db.First(&data).Error
+	return
+}
+
+func (m *uploadSessionModel) Updates(values interface{}) error {
+	return m.db.Updates(values).Error
+}
+
+// UpdatesAffected 更新并返回影响的行数,用于条件更新
+func (m *uploadSessionModel) UpdatesAffected(values interface{}) (int64, error) {
+	res := m.db.Updates(values)
+	return res.RowsAffected, res.Error
+}
+
+func (m *uploadSessionModel) List(limit int) (data []UploadSession, err error) {
+	err = m.db.Order("id ASC").Limit(limit).Find(&data).Error
+	return
+}
+
+func (m *uploadSessionModel) Delete() error {
+	return m.db.Delete(&UploadSession{}).Error
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// table user_identities 第三方账号关联表,(provider, subject) 唯一
+type UserIdentity struct {
+	BaseModelNoDelete
+	UserId   uint   `json:"user_id" gorm:"column:user_id;not null;index"`                                               // 用户ID
+	Provider string `json:"provider" gorm:"column:provider;type:VARCHAR(32);not null;uniqueIndex:idx_provider_subject"` // 提供方,如google/github/apple
+	Subject  string `json:"subject" gorm:"column:subject;type:VARCHAR(255);not null;uniqueIndex:idx_provider_subject"`  // 第三方账号唯一标识
+	Email    string `json:"email" gorm:"column:email;default:NULL"`                                                     // 第三方账号邮箱
+	Name     string `json:"name" gorm:"column:name;default:NULL"`                                                       // 第三方账号昵称
+}
+
+type userIdentityModel DB
+
+func NewUserIdentityModel(tx ...*gorm.DB) *userIdentityModel {
+	db := getDB(tx...).Table("user_identities").Model(&UserIdentity{})
+	return &userIdentityModel{db: db}
+}
+
+// SetUserId 设置用户ID
+func (m *userIdentityModel) SetUserId(userId uint) *userIdentityModel {
+	m.db = m.db.Where("user_id = ?", userId)
+	return m
+}
+
+// SetProvider 设置提供方
+func (m *userIdentityModel) SetProvider(provider string) *userIdentityModel {
+	m.db = m.db.Where("provider = ?", provider)
+	return m
+}
+
+// SetSubject 设置第三方账号标识
+func (m *userIdentityModel) SetSubject(subject string) *userIdentityModel {
+	m.db = m.db.Where("subject = ?", subject)
+	return m
+}
+
+func (m *userIdentityModel) Order(expr string) *userIdentityModel {
+	m.db = m.db.Order(expr)
+	return m
+}
+
+func (m *userIdentityModel) WithContext(ctx context.Context) *userIdentityModel {
+	m.db = m.db.WithContext(ctx)
+	return m
+}
+
+func (m *userIdentityModel) Create(identity *UserIdentity) error {
+	return m.db.Create(identity).Error
+}
+
+func (m *userIdentityModel) FirstOne() (data *UserIdentity, err error) {
+	err = m.db.Take(&data).Error
+	return
+}
+
+func (m *userIdentityModel) List() (data []UserIdentity, err error) {
+	err = m.db.Find(&data).Error
+	return
+}
+
+// DeleteAffected 删除并返回受影响的行数
+func (m *userIdentityModel) DeleteAffected() (int64, error) {
+	res := m.db.Delete(&UserIdentity{})
+	return res.RowsAffected, res.Error
+}
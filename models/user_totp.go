@@ -0,0 +1,123 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// table user_totps 用户两步验证(TOTP)配置表
+type UserTotp struct {
+	BaseModelNoDelete
+	UserId       uint       `json:"user_id" gorm:"column:user_id;not null;uniqueIndex"`   // 用户ID
+	Secret       string     `json:"-" gorm:"column:secret;not null"`                      // TOTP密钥,使用aes密钥加密存储
+	Enabled      bool       `json:"enabled" gorm:"column:enabled;not null;default:0"`     // 是否已启用(确认绑定后启用)
+	LastUsedStep int64      `json:"-" gorm:"column:last_used_step;not null;default:0"`    // 最后一次使用的时间步,防止验证码重放
+	ConfirmedAt  *time.Time `json:"confirmed_at" gorm:"column:confirmed_at;default:NULL"` // 确认绑定时间
+}
+
+type userTotpModel DB
+
+func NewUserTotpModel(tx ...*gorm.DB) *userTotpModel {
+	db := getDB(tx...).Table("user_totps").Model(&UserTotp{})
+	return &userTotpModel{db: db}
+}
+
+// SetUserId 设置用户ID
+func (m *userTotpModel) SetUserId(userId uint) *userTotpModel {
+	m.db = m.db.Where("user_id = ?", userId)
+	return m
+}
+
+// LastUsedStepLt 最后使用的时间步小于指定值
+func (m *userTotpModel) LastUsedStepLt(step int64) *userTotpModel {
+	m.db = m.db.Where("last_used_step < ?", step)
+	return m
+}
+
+func (m *userTotpModel) Where(query string, args ...interface{}) *userTotpModel {
+	m.db = m.db.Where(query, args...)
+	return m
+}
+
+func (m *userTotpModel) WithContext(ctx context.Context) *userTotpModel {
+	m.db = m.db.WithContext(ctx)
+	return m
+}
+
+func (m *userTotpModel) Create(totp *UserTotp) error {
+	return m.db.Create(totp).Error
+}
+
+func (m *userTotpModel) Updates(values interface{}) error {
+	return m.db.Updates(values).Error
+}
+
+// UpdatesAffected 更新并返回受影响的行数
+func (m *userTotpModel) UpdatesAffected(values interface{}) (int64, error) {
+	res := m.db.Updates(values)
+	return res.RowsAffected, res.Error
+}
+
+func (m *userTotpModel) FirstOne() (data *UserTotp, err error) {
+	err = m.db.Take(&data).Error
+	return
+}
+
+func (m *userTotpModel) Delete() error {
+	return m.db.Delete(&UserTotp{}).Error
+}
+
+// table user_recovery_codes 两步验证恢复码表
+type UserRecoveryCode struct {
+	BaseModelNoDelete
+	UserId   uint       `json:"user_id" gorm:"column:user_id;not null;index"` // 用户ID
+	CodeHash string     `json:"-" gorm:"column:code_hash;not null"`           // 恢复码sha256哈希
+	UsedAt   *time.Time `json:"used_at" gorm:"column:used_at;default:NULL"`   // 使用时间,为空表示未使用
+}
+
+type userRecoveryCodeModel DB
+
+func NewUserRecoveryCodeModel(tx ...*gorm.DB) *userRecoveryCodeModel {
+	db := getDB(tx...).Table("user_recovery_codes").Model(&UserRecoveryCode{})
+	return &userRecoveryCodeModel{db: db}
+}
+
+// SetUserId 设置用户ID
+func (m *userRecoveryCodeModel) SetUserId(userId uint) *userRecoveryCodeModel {
+	m.db = m.db.Where("user_id = ?", userId)
+	return m
+}
+
+// SetCodeHash 设置恢复码哈希
+func (m *userRecoveryCodeModel) SetCodeHash(codeHash string) *userRecoveryCodeModel {
+	m.db = m.db.Where("code_hash = ?", codeHash)
+	return m
+}
+
+// Unused 未使用的恢复码
+func (m *userRecoveryCodeModel) Unused() *userRecoveryCodeModel {
+	m.db = m.db.Where("used_at IS NULL")
+	return m
+}
+
+func (m *userRecoveryCodeModel) WithContext(ctx context.Context) *userRecoveryCodeModel {
+	m.db = m.db.WithContext(ctx)
+	return m
+}
+
+// BatchCreate 批量创建
+func (m *userRecoveryCodeModel) BatchCreate(codes []UserRecoveryCode) error {
+	return m.db.Create(&codes).Error
+}
+
+// UpdatesAffected 更新并返回受影响的行数
+func (m *userRecoveryCodeModel) UpdatesAffected(values interface{}) (int64, error) {
+	res := m.db.Updates(values)
+	return res.RowsAffected, res.Error
+}
+
+func (m *userRecoveryCodeModel) Delete() error {
+	return m.db.Delete(&UserRecoveryCode{}).Error
+}
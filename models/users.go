@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -10,22 +11,28 @@ import (
 // table users 用户表
 type User struct {
 	BaseModel
-	Username           string `json:"username" gorm:"column:username;not null"`
-	Email              string `json:"email" gorm:"column:email;not null"`                 // 登录邮箱
-	AvatarPath         string `json:"avatar_path" gorm:"column:avatar_path;default:NULL"` // 头像路径
-	PasswordHash       string `json:"password_hash" gorm:"column:password_hash;not null"`
-	GoogleId           string `json:"google_id" gorm:"column:google_id;default:NULL"` // google登录id
-	UUID               string `json:"uuid" gorm:"column:uuid;not null"`
-	Type               int    `json:"type" gorm:"column:type;not null;default:0"`
-	IPAddress          string `json:"ip_address" gorm:"column:ip_address"`
-	CountryCode        string `json:"country_code" gorm:"column:country_code"`
-	CityCode           string `json:"city_code" gorm:"column:city_code"`
-	CountryName        string `json:"country_name" gorm:"column:country_name"`
-	ZipCode            string `json:"zip_code" gorm:"column:zip_code"`
-	Platform           int    `json:"platform" gorm:"column:platform;default:1"`             // 注册来源平台,1:h5,2:pc
-	FirstName          string `json:"first_name" gorm:"column:first_name"`                   // 姓
-	LastName           string `json:"last_name" gorm:"column:last_name"`                     // 名
-	BrowserFingerprint string `json:"browser_fingerprint" gorm:"column:browser_fingerprint"` // 浏览器指纹
+	Username           string     `json:"username" gorm:"column:username;not null"`
+	Email              string     `json:"email" gorm:"column:email;not null"`                 // 登录邮箱
+	AvatarPath         string     `json:"avatar_path" gorm:"column:avatar_path;default:NULL"` // 头像路径
+	PasswordHash       string     `json:"password_hash" gorm:"column:password_hash;not null"`
+	UUID               string     `json:"uuid" gorm:"column:uuid;not null"`
+	Type               int        `json:"type" gorm:"column:type;not null;default:0"`
+	IPAddress          string     `json:"ip_address" gorm:"column:ip_address"`
+	CountryCode        string     `json:"country_code" gorm:"column:country_code"`
+	CityCode           string     `json:"city_code" gorm:"column:city_code"`
+	CountryName        string     `json:"country_name" gorm:"column:country_name"`
+	ZipCode            string     `json:"zip_code" gorm:"column:zip_code"`
+	Platform           int        `json:"platform" gorm:"column:platform;default:1"`             // 注册来源平台,1:h5,2:pc
+	FirstName          string     `json:"first_name" gorm:"column:first_name"`                   // 姓
+	LastName           string     `json:"last_name" gorm:"column:last_name"`                     // 名
+	BrowserFingerprint string     `json:"browser_fingerprint" gorm:"column:browser_fingerprint"` // 浏览器指纹
+	BannedAt           *time.Time `json:"banned_at" gorm:"column:banned_at;default:NULL"`        // 封禁时间,为空表示未封禁
+	BanReason          string     `json:"ban_reason" gorm:"column:ban_reason;default:NULL"`      // 封禁原因
+}
+
+// IsBanned 是否已被封禁
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
 }
 
 type UserType int
@@ -68,6 +75,30 @@ func (m *userModel) SetEmail(email string) *userModel {
 	return m
 }
 
+// EmailPrefix 邮箱前缀匹配
+func (m *userModel) EmailPrefix(prefix string) *userModel {
+	m.db = m.db.Where("email LIKE ?", escapeLike(prefix)+"%")
+	return m
+}
+
+// SetCountryCode 设置国家代码
+func (m *userModel) SetCountryCode(countryCode string) *userModel {
+	m.db = m.db.Where("country_code = ?", countryCode)
+	return m
+}
+
+// CreatedAtGte 注册时间不早于
+func (m *userModel) CreatedAtGte(t time.Time) *userModel {
+	m.db = m.db.Where("created_at >= ?", t)
+	return m
+}
+
+// CreatedAtLt 注册时间早于
+func (m *userModel) CreatedAtLt(t time.Time) *userModel {
+	m.db = m.db.Where("created_at < ?", t)
+	return m
+}
+
 func (m *userModel) Order(expr string) *userModel {
 	m.db = m.db.Order(expr)
 	return m
@@ -179,9 +210,3 @@ func (u *userModel) SetSubscribeStatus(status string) *userModel {
 	u.db = u.db.Where("subscription_status = ?", status)
 	return u
 }
-
-// SetGoogleID 设置Google ID
-func (u *userModel) SetGoogleID(googleID string) *userModel {
-	u.db = u.db.Where("google_id = ?", googleID)
-	return u
-}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/metrics"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -26,8 +27,10 @@ func WithCache[T any](c Cache, ctx context.Context, key string, duration time.Du
 	var data T
 	err := c.Get(ctx, key, &data)
 	if err == nil {
+		metrics.CacheRequests.WithLabelValues(metrics.CacheKeyPrefix(key), "hit").Inc()
 		return data, nil
 	}
+	metrics.CacheRequests.WithLabelValues(metrics.CacheKeyPrefix(key), "miss").Inc()
 
 	// 使用singleflight防止缓存击穿
 	v, err, _ := g.Do(key, func() (interface{}, error) {
@@ -2,15 +2,23 @@ package mysql
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ydssx/kratos-kit/constants"
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/metrics"
+	"github.com/ydssx/kratos-kit/pkg/tracing"
 
 	"github.com/axiaoxin-com/goutils"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 )
 
@@ -85,6 +93,8 @@ func (g GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (strin
 	latency := time.Since(begin).Milliseconds()
 	sql, rows := fc()
 	sql = goutils.RemoveDuplicateWhitespace(sql, true)
+	traceSQL(ctx, begin, sql, rows, err)
+	observeSQL(begin, sql, err)
 	logger := g.CtxLogger(ctx)
 
 	logFields := []zap.Field{
@@ -113,3 +123,64 @@ func NewGormLogger(logLevel zapcore.Level, traceWithLevel zapcore.Level, slowThr
 		traceWithLevel: traceWithLevel,
 	}
 }
+
+// traceSQL 为已执行的SQL补记一个span,只在上下文中已有span时记录。
+// 日志中的SQL已填入参数,可能包含密码哈希、令牌等敏感数据,span 只记录操作和表名
+func traceSQL(ctx context.Context, begin time.Time, sql string, rows int64, err error) {
+	if !tracing.Recording(ctx) {
+		return
+	}
+	op, table := sqlOperation(sql)
+	name := "gorm " + op
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemMySQL,
+		semconv.DBOperationName(op),
+		attribute.Int64("db.rows_affected", rows),
+	}
+	if table != "" {
+		name += " " + table
+		attrs = append(attrs, semconv.DBCollectionName(table))
+	}
+	_, span := tracing.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(begin),
+		trace.WithAttributes(attrs...),
+	)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		tracing.RecordError(span, err)
+	}
+	span.End()
+}
+
+// sqlOperation 返回SQL的操作和操作的第一个表,无法识别表名时返回空
+func sqlOperation(sql string) (op, table string) {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	op = strings.ToUpper(fields[0])
+	keyword := map[string]string{"SELECT": "FROM", "DELETE": "FROM", "INSERT": "INTO", "REPLACE": "INTO", "UPDATE": "UPDATE"}[op]
+	if keyword == "" {
+		return op, ""
+	}
+	for i := 0; i < len(fields)-1; i++ {
+		if strings.EqualFold(fields[i], keyword) {
+			// 子查询没有表名
+			if !strings.HasPrefix(fields[i+1], "(") {
+				table = strings.Trim(fields[i+1], "`,;")
+			}
+			break
+		}
+	}
+	return op, table
+}
+
+// observeSQL 记录SQL执行耗时
+func observeSQL(begin time.Time, sql string, err error) {
+	op, _, _ := strings.Cut(sql, " ")
+	status := "ok"
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		status = "error"
+	}
+	metrics.DBQueryDuration.WithLabelValues(strings.ToUpper(op), status).Observe(time.Since(begin).Seconds())
+}
@@ -0,0 +1,21 @@
+package mysql
+
+import "testing"
+
+func TestSQLOperation(t *testing.T) {
+	cases := []struct {
+		sql, op, table string
+	}{
+		{"SELECT * FROM `users` WHERE email = 'a@example.com'", "SELECT", "users"},
+		{"INSERT INTO `user_sessions` (`token`) VALUES ('secret')", "INSERT", "user_sessions"},
+		{"UPDATE `users` SET `password_hash`='x' WHERE id = 1", "UPDATE", "users"},
+		{"delete from file_metadata where id = 1", "DELETE", "file_metadata"},
+		{"SELECT count(*) FROM (SELECT 1) t", "SELECT", ""},
+		{"BEGIN", "BEGIN", ""},
+	}
+	for _, c := range cases {
+		if op, table := sqlOperation(c.sql); op != c.op || table != c.table {
+			t.Errorf("sqlOperation(%q) = %q, %q, want %q, %q", c.sql, op, table, c.op, c.table)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ydssx/kratos-kit/pkg/errors"
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/metrics"
 
 	"go.uber.org/zap/zapcore"
 	"gorm.io/driver/mysql"
@@ -73,6 +74,9 @@ func NewDBWithConfig(config *DBConfig, dsn ...string) (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(config.MaxIdleConns)
 	sqlDB.SetMaxOpenConns(config.MaxOpenConns)
 	sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	if err := metrics.RegisterDBStats(sqlDB, "default"); err != nil {
+		return nil, errors.Wrap(err, "failed to register mysql metrics")
+	}
 	logger.Info(context.Background(), "init mysql success")
 	return db, nil
 }
@@ -107,6 +111,9 @@ func NewEventDB(dsn ...string) (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(100)
 	sqlDB.SetMaxOpenConns(100)
 	sqlDB.SetConnMaxLifetime(time.Hour)
+	if err := metrics.RegisterDBStats(sqlDB, "event"); err != nil {
+		return nil, errors.Wrap(err, "failed to register mysql metrics")
+	}
 	logger.Info(context.Background(), "init mysql success")
 	return eventDb, nil
 }
@@ -2,17 +2,23 @@ package redis
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ydssx/kratos-kit/pkg/errors"
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/metrics"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
 )
 
-// NewRedis 连接Redis并返回Client对象
+// NewRedis 连接Redis并返回Client对象,命令会记录到请求链路中
 func NewRedis(opt *redis.Options) (*redis.Client, error) {
 	cli := redis.NewClient(opt)
+	cli.AddHook(TracingHook{})
+	if err := metrics.Register(metrics.NewRedisPoolCollector(opt.Addr, opt.DB, cli.PoolStats)); err != nil {
+		return nil, errors.Wrap(err, "failed to register redis metrics")
+	}
 	_, err := cli.Ping(context.Background()).Result()
 	if err != nil {
 		return nil, errors.Wrap(err, "redis connect failed")
@@ -24,6 +30,10 @@ func NewRedis(opt *redis.Options) (*redis.Client, error) {
 // NewRedisCluster 连接Redis集群并返回ClusterClient对象
 func NewRedisCluster(opt *redis.ClusterOptions) *redis.ClusterClient {
 	cli := redis.NewClusterClient(opt)
+	cli.AddHook(TracingHook{})
+	if err := metrics.Register(metrics.NewRedisPoolCollector(strings.Join(opt.Addrs, ","), 0, cli.PoolStats)); err != nil {
+		log.Error(err)
+	}
 	_, err := cli.Ping(context.Background()).Result()
 	if err != nil {
 		log.Error(err)
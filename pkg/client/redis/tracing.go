@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/ydssx/kratos-kit/pkg/tracing"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingHook 为Redis命令创建span,只在上下文中已有span时记录
+type TracingHook struct{}
+
+var _ redis.Hook = TracingHook{}
+
+func (TracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (TracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !tracing.Recording(ctx) {
+			return next(ctx, cmd)
+		}
+		ctx, span := tracing.Start(ctx, "redis "+cmd.Name(),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemRedis, semconv.DBOperationName(cmd.Name())),
+		)
+		defer span.End()
+
+		err := next(ctx, cmd)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			tracing.RecordError(span, err)
+		}
+		return err
+	}
+}
+
+func (TracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !tracing.Recording(ctx) {
+			return next(ctx, cmds)
+		}
+		ctx, span := tracing.Start(ctx, "redis pipeline",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemRedis, attribute.Int("db.redis.num_cmd", len(cmds))),
+		)
+		defer span.End()
+
+		err := next(ctx, cmds)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			tracing.RecordError(span, err)
+		}
+		return err
+	}
+}
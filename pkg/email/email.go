@@ -45,12 +45,6 @@ func (e *Email) Send(to []string, subject, body string) error {
 }
 
 func (e *Email) SendVerificationCode(to string, code string, emailTemplate string) error {
-	// 解析 HTML 模板
-	tmpl, err := template.New("verificationEmail").Parse(emailTemplate)
-	if err != nil {
-		return fmt.Errorf("解析模板失败: %v", err)
-	}
-
 	// 准备模板数据
 	data := struct {
 		VerificationCode string
@@ -58,6 +52,17 @@ func (e *Email) SendVerificationCode(to string, code string, emailTemplate strin
 		VerificationCode: code,
 	}
 
+	return e.SendTemplate(to, "Email Verification", emailTemplate, data)
+}
+
+// SendTemplate 使用 HTML 模板渲染邮件内容并发送
+func (e *Email) SendTemplate(to string, subject string, emailTemplate string, data interface{}) error {
+	// 解析 HTML 模板
+	tmpl, err := template.New("email").Parse(emailTemplate)
+	if err != nil {
+		return fmt.Errorf("解析模板失败: %v", err)
+	}
+
 	// 执行模板
 	var body bytes.Buffer
 	if err := tmpl.Execute(&body, data); err != nil {
@@ -65,7 +70,6 @@ func (e *Email) SendVerificationCode(to string, code string, emailTemplate strin
 	}
 
 	// 发送邮件
-	subject := "Email Verification"
 	err = e.Send([]string{to}, subject, body.String())
 	if err != nil {
 		return fmt.Errorf("发送邮件失败: %v", err)
@@ -3,6 +3,9 @@ package errors
 import (
 	serrors "errors"
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/pkg/errors"
@@ -49,6 +52,18 @@ var (
 	ErrCreateSubscription = kerrors.New(-1, "create subscription failed", "Error message.Please re-enter.")
 	// 验证码已发送
 	ErrVerificationCodeSent = kerrors.New(413, "verification code sent", "Verification code has been sent")
+	// 失败次数过多,账号或IP已被临时锁定
+	ErrTooManyAttempts = kerrors.New(414, "too many attempts", "Too many failed attempts, please try again later")
+	// 验证码尝试次数过多,验证码已失效
+	ErrVerificationCodeAttemptsExceeded = kerrors.New(415, "verification code attempts exceeded", "Too many incorrect attempts, please request a new verification code")
+	// 验证码发送次数已达今日上限
+	ErrVerificationCodeDailyLimit = kerrors.New(416, "verification code daily limit", "Verification code limit reached for today, please try again tomorrow")
+	// 账号已被封禁
+	ErrUserBanned = kerrors.New(417, "user banned", "Your account has been suspended, please contact support")
+	// 重置密码邮件发送过于频繁
+	ErrPasswordResetLimit = kerrors.New(418, "password reset limit", "Too many password reset requests, please try again later")
+	// 没有操作权限
+	ErrPermissionDenied = kerrors.New(403, "permission denied", "You do not have permission to perform this action")
 
 	New       = errors.New
 	Join      = serrors.Join
@@ -61,6 +76,15 @@ var (
 	WithStack = errors.WithStack
 )
 
+// TooManyAttempts 返回带剩余锁定时长的 ErrTooManyAttempts,
+// 剩余秒数写入 metadata 的 retry_after 字段
+func TooManyAttempts(retryAfter time.Duration) *kerrors.Error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	return kerrors.Newf(int(ErrTooManyAttempts.Code), ErrTooManyAttempts.Reason,
+		"Too many failed attempts, please try again in %d seconds", seconds).
+		WithMetadata(map[string]string{"retry_after": strconv.FormatInt(seconds, 10)})
+}
+
 // UserError 表示用户级别的错误
 type UserError struct {
 	Ke *kerrors.Error
@@ -0,0 +1,141 @@
+package filescan
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+const (
+	// clamChunkSize INSTREAM 每次发送的数据块大小
+	clamChunkSize = 64 << 10
+	// defaultClamTimeout 默认单次扫描超时时间
+	defaultClamTimeout = time.Minute
+)
+
+// ClamAV 通过 clamd 的 INSTREAM 命令扫描文件,支持 unix socket 和 tcp 连接
+type ClamAV struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAV 创建 clamd 客户端,network 为空时使用 unix
+func NewClamAV(network, address string, timeout time.Duration) *ClamAV {
+	if network == "" {
+		network = "unix"
+	}
+	if timeout <= 0 {
+		timeout = defaultClamTimeout
+	}
+	return &ClamAV{network: network, address: address, timeout: timeout}
+}
+
+// Scan implements Scanner.
+func (c *ClamAV) Scan(ctx context.Context, r io.Reader) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return errors.Wrap(err, "发送扫描命令失败")
+	}
+	buf := make([]byte, 4+clamChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				// 超过 clamd 的 StreamMaxLength 时会关闭连接,读取返回的错误信息
+				if reply, rerr := readReply(conn); rerr == nil && reply != "" {
+					return errors.Errorf("clamd: %s", reply)
+				}
+				return errors.Wrap(err, "发送扫描内容失败")
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			return errors.Wrap(rerr, "读取文件失败")
+		}
+	}
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return errors.Wrap(err, "发送扫描内容失败")
+	}
+
+	reply, err := readReply(conn)
+	if err != nil {
+		return errors.Wrap(err, "读取扫描结果失败")
+	}
+	return parseReply(reply)
+}
+
+// Ping 检查 clamd 是否可用,用于健康检查
+func (c *ClamAV) Ping(ctx context.Context) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("zPING\x00")); err != nil {
+		return errors.Wrap(err, "发送PING失败")
+	}
+	reply, err := readReply(conn)
+	if err != nil {
+		return errors.Wrap(err, "读取PING结果失败")
+	}
+	if reply != "PONG" {
+		return errors.Errorf("clamd: unexpected reply %q", reply)
+	}
+	return nil
+}
+
+func (c *ClamAV) dial(ctx context.Context) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return nil, errors.Wrap(err, "连接clamd失败")
+	}
+	// 超时时间同时作用于整个扫描过程
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	return conn, nil
+}
+
+// readReply 读取以 \x00 结尾的响应
+func readReply(r io.Reader) (string, error) {
+	reply, err := io.ReadAll(r)
+	if err != nil && len(reply) == 0 {
+		return "", err
+	}
+	if i := bytes.IndexByte(reply, 0); i >= 0 {
+		reply = reply[:i]
+	}
+	return strings.TrimSpace(string(reply)), nil
+}
+
+// parseReply 解析扫描结果,如 "stream: OK"、"stream: Eicar-Signature FOUND"
+func parseReply(reply string) error {
+	result := strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case result == "OK":
+		return nil
+	case strings.HasSuffix(result, " FOUND"):
+		return &InfectedError{Signature: strings.TrimSuffix(result, " FOUND")}
+	default:
+		return errors.Errorf("clamd: %s", reply)
+	}
+}
@@ -0,0 +1,80 @@
+package filescan
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClamd 模拟 clamd,内容包含 EICAR 时返回 FOUND
+func fakeClamd(t *testing.T) string {
+	addr := filepath.Join(t.TempDir(), "clamd.sock")
+	ln, err := net.Listen("unix", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				cmd := make([]byte, 10)
+				if _, err := io.ReadFull(conn, cmd[:6]); err != nil {
+					return
+				}
+				if string(cmd[:6]) == "zPING\x00" {
+					conn.Write([]byte("PONG\x00"))
+					return
+				}
+				if _, err := io.ReadFull(conn, cmd[6:]); err != nil {
+					return
+				}
+				var body bytes.Buffer
+				for {
+					var size uint32
+					if err := binary.Read(conn, binary.BigEndian, &size); err != nil || size == 0 {
+						break
+					}
+					io.CopyN(&body, conn, int64(size))
+				}
+				if strings.Contains(body.String(), "EICAR") {
+					conn.Write([]byte("stream: Eicar-Signature FOUND\x00"))
+				} else {
+					conn.Write([]byte("stream: OK\x00"))
+				}
+			}(conn)
+		}
+	}()
+	return addr
+}
+
+func TestClamAV(t *testing.T) {
+	c := NewClamAV("unix", fakeClamd(t), time.Second)
+	ctx := context.Background()
+
+	if err := c.Ping(ctx); err != nil {
+		t.Fatal(err)
+	}
+	clean := bytes.Repeat([]byte("a"), clamChunkSize*2+10)
+	if err := c.Scan(ctx, bytes.NewReader(clean)); err != nil {
+		t.Fatalf("clean file: %v", err)
+	}
+
+	err := c.Scan(ctx, strings.NewReader("X5O!P%@AP-EICAR-STANDARD-ANTIVIRUS-TEST-FILE"))
+	var infected *InfectedError
+	if !errors.As(err, &infected) || infected.Signature != "Eicar-Signature" {
+		t.Fatalf("infected file: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package filescan
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/disintegration/imaging"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/webp"
+)
+
+var (
+	// ErrInvalidImage 内容不是支持的图片格式或无法解码
+	ErrInvalidImage = errors.New("invalid image")
+	// ErrImageTooLarge 图片像素数超过限制
+	ErrImageTooLarge = errors.New("image dimensions exceed limit")
+)
+
+// SanitizeImage 解码图片确认内容有效,去除 EXIF、GPS 等元数据后写入 w,返回图片格式。
+// 解码前先读取尺寸,像素数超过 maxPixels 时返回 ErrImageTooLarge,避免解压炸弹,maxPixels 为 0 时不限制。
+// JPEG 按 EXIF 方向旋转后重新编码,PNG 重新编码,WebP 删除 EXIF 和 XMP 块,GIF 和 BMP 不含 EXIF 保持原样
+func SanitizeImage(r io.ReadSeeker, w io.Writer, maxPixels int64) (string, error) {
+	cfg, format, err := image.DecodeConfig(r)
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidImage, err.Error())
+	}
+	if maxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return "", ErrImageTooLarge
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	switch format {
+	case "jpeg":
+		img, err := imaging.Decode(r, imaging.AutoOrientation(true))
+		if err != nil {
+			return "", errors.Wrap(ErrInvalidImage, err.Error())
+		}
+		return format, jpeg.Encode(w, img, &jpeg.Options{Quality: 95})
+	case "png":
+		img, err := png.Decode(r)
+		if err != nil {
+			return "", errors.Wrap(ErrInvalidImage, err.Error())
+		}
+		return format, png.Encode(w, img)
+	case "gif":
+		if _, err := gif.DecodeAll(r); err != nil {
+			return "", errors.Wrap(ErrInvalidImage, err.Error())
+		}
+	case "bmp":
+		if _, err := bmp.Decode(r); err != nil {
+			return "", errors.Wrap(ErrInvalidImage, err.Error())
+		}
+	case "webp":
+		if _, err := webp.Decode(r); err != nil {
+			return "", errors.Wrap(ErrInvalidImage, err.Error())
+		}
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		return format, stripWebPMetadata(r, w)
+	default:
+		return "", errors.Wrapf(ErrInvalidImage, "unsupported format %s", format)
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	_, err = io.Copy(w, r)
+	return format, err
+}
+
+// VP8X 块中表示包含 EXIF 和 XMP 的标志位
+const webpMetadataFlags = 0x08 | 0x04
+
+// stripWebPMetadata 删除 WebP 中的 EXIF 和 XMP 块并清除 VP8X 中对应的标志位
+func stripWebPMetadata(r io.Reader, w io.Writer) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return ErrInvalidImage
+	}
+
+	var out bytes.Buffer
+	for p := 12; p+8 <= len(data); {
+		fourCC := string(data[p : p+4])
+		size := int(binary.LittleEndian.Uint32(data[p+4 : p+8]))
+		end := p + 8 + size + size%2 // 块按偶数字节对齐
+		if end > len(data) {
+			end = len(data)
+		}
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := bytes.Clone(data[p:end])
+			if len(chunk) > 8 {
+				chunk[8] &^= webpMetadataFlags
+			}
+			out.Write(chunk)
+		default:
+			out.Write(data[p:end])
+		}
+		p = end
+	}
+
+	header := make([]byte, 12)
+	copy(header, "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(out.Len()+4))
+	copy(header[8:], "WEBP")
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = out.WriteTo(w)
+	return err
+}
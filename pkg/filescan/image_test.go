@@ -0,0 +1,82 @@
+package filescan
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"strings"
+	"testing"
+)
+
+// withExif 在 JPEG 的 SOI 之后插入包含 GPS 标记的 APP1 段
+func withExif(data []byte) []byte {
+	payload := []byte("Exif\x00\x00GPSLatitude")
+	seg := []byte{0xFF, 0xE1, byte((len(payload) + 2) >> 8), byte(len(payload) + 2)}
+	out := append([]byte{}, data[:2]...)
+	out = append(out, seg...)
+	out = append(out, payload...)
+	return append(out, data[2:]...)
+}
+
+func TestSanitizeImage(t *testing.T) {
+	var src bytes.Buffer
+	if err := jpeg.Encode(&src, image.NewRGBA(image.Rect(0, 0, 40, 30)), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := withExif(src.Bytes())
+
+	var out bytes.Buffer
+	format, err := SanitizeImage(bytes.NewReader(data), &out, 0)
+	if err != nil || format != "jpeg" {
+		t.Fatalf("format=%s err=%v", format, err)
+	}
+	if bytes.Contains(out.Bytes(), []byte("GPSLatitude")) {
+		t.Fatal("exif is not stripped")
+	}
+	if _, err := jpeg.Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := SanitizeImage(bytes.NewReader(data), &out, 40*30-1); !errors.Is(err, ErrImageTooLarge) {
+		t.Fatalf("pixel limit: %v", err)
+	}
+	if _, err := SanitizeImage(strings.NewReader("<?php echo 1; ?>"), &out, 0); !errors.Is(err, ErrInvalidImage) {
+		t.Fatalf("not an image: %v", err)
+	}
+}
+
+func TestStripWebPMetadata(t *testing.T) {
+	chunk := func(fourCC, payload string) string {
+		size := len(payload)
+		s := fourCC + string([]byte{byte(size), 0, 0, 0}) + payload
+		if size%2 == 1 {
+			s += "\x00"
+		}
+		return s
+	}
+	body := "WEBP" + chunk("VP8X", "\x0c\x00\x00\x00\x00\x00\x00\x00\x00\x00") + chunk("VP8L", "img") + chunk("EXIF", "gps") + chunk("XMP ", "xmp")
+	data := "RIFF" + string([]byte{byte(len(body)), 0, 0, 0}) + body
+
+	var out bytes.Buffer
+	if err := stripWebPMetadata(strings.NewReader(data), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := "WEBP" + chunk("VP8X", "\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00") + chunk("VP8L", "img")
+	if got := out.String(); got != "RIFF"+string([]byte{byte(len(want)), 0, 0, 0})+want {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
+
+func TestSniff(t *testing.T) {
+	var src bytes.Buffer
+	jpeg.Encode(&src, image.NewRGBA(image.Rect(0, 0, 1, 1)), nil)
+
+	ct, ok, err := Sniff(bytes.NewReader(src.Bytes()), []string{"image/png", "image/jpeg"})
+	if err != nil || !ok || ct != "image/jpeg" {
+		t.Fatalf("jpeg: ct=%s ok=%v err=%v", ct, ok, err)
+	}
+	if _, ok, _ := Sniff(strings.NewReader("<html><script>alert(1)</script>"), []string{"image/jpeg"}); ok {
+		t.Fatal("html should not be allowed as image")
+	}
+}
@@ -0,0 +1,21 @@
+package filescan
+
+import (
+	"context"
+	"io"
+)
+
+// Scanner 恶意文件扫描
+type Scanner interface {
+	// Scan 扫描 r 的全部内容,发现威胁时返回 *InfectedError
+	Scan(ctx context.Context, r io.Reader) error
+}
+
+// InfectedError 文件被识别为恶意文件
+type InfectedError struct {
+	Signature string // 命中的特征名称
+}
+
+func (e *InfectedError) Error() string {
+	return "infected file: " + e.Signature
+}
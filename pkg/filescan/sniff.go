@@ -0,0 +1,34 @@
+// Package filescan 上传文件的安全校验: 按文件头识别类型、校验图片并去除元数据、扫描恶意文件
+package filescan
+
+import (
+	"io"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+// Sniff 根据文件头识别 MIME 类型,类型或其父类型在 allow 中时返回识别出的类型,否则 ok 为 false。
+// 会从 r 读取最多 3KB 内容
+func Sniff(r io.Reader, allow []string) (contentType string, ok bool, err error) {
+	mt, err := mimetype.DetectReader(r)
+	if err != nil {
+		return "", false, errors.Wrap(err, "识别文件类型失败")
+	}
+	for m := mt; m != nil; m = m.Parent() {
+		for _, a := range allow {
+			if m.Is(a) {
+				return mt.String(), true, nil
+			}
+		}
+	}
+	return mt.String(), false, nil
+}
+
+// Extension 返回 Sniff 识别出的 MIME 类型对应的扩展名,未知类型返回空
+func Extension(contentType string) string {
+	if mt := mimetype.Lookup(contentType); mt != nil {
+		return mt.Extension()
+	}
+	return ""
+}
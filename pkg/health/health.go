@@ -2,21 +2,59 @@ package health
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/oschwald/geoip2-golang"
 	"github.com/redis/go-redis/v9"
 	"github.com/ydssx/kratos-kit/pkg/logger"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 )
 
+const (
+	// DefaultTimeout 单个检查的默认超时时间
+	DefaultTimeout = 2 * time.Second
+	// DefaultCacheTTL 检查结果的默认缓存时间
+	DefaultCacheTTL = 3 * time.Second
+)
+
+// 检查状态
+const (
+	StatusOK           = "ok"
+	StatusError        = "error"
+	StatusUnavailable  = "unavailable"
+	StatusShuttingDown = "shutting_down"
+)
+
 // HealthChecker 健康检查接口
 type HealthChecker interface {
 	Check(ctx context.Context) error
 	Name() string
 }
 
+// checkerFunc 函数形式的检查器
+type checkerFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// NewChecker 使用函数创建检查器
+func NewChecker(name string, check func(ctx context.Context) error) HealthChecker {
+	return &checkerFunc{name: name, check: check}
+}
+
+func (c *checkerFunc) Check(ctx context.Context) error { return c.check(ctx) }
+
+func (c *checkerFunc) Name() string { return c.name }
+
 // DatabaseHealthChecker 数据库健康检查
 type DatabaseHealthChecker struct {
 	db *gorm.DB
@@ -31,14 +69,9 @@ func (h *DatabaseHealthChecker) Check(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to get sql.DB: %w", err)
 	}
-	
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	
 	if err := sqlDB.PingContext(ctx); err != nil {
 		return fmt.Errorf("database ping failed: %w", err)
 	}
-	
 	return nil
 }
 
@@ -48,21 +81,17 @@ func (h *DatabaseHealthChecker) Name() string {
 
 // RedisHealthChecker Redis健康检查
 type RedisHealthChecker struct {
-	client *redis.Client
+	client redis.UniversalClient
 }
 
-func NewRedisHealthChecker(client *redis.Client) *RedisHealthChecker {
+func NewRedisHealthChecker(client redis.UniversalClient) *RedisHealthChecker {
 	return &RedisHealthChecker{client: client}
 }
 
 func (h *RedisHealthChecker) Check(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	
 	if err := h.client.Ping(ctx).Err(); err != nil {
 		return fmt.Errorf("redis ping failed: %w", err)
 	}
-	
 	return nil
 }
 
@@ -70,80 +99,252 @@ func (h *RedisHealthChecker) Name() string {
 	return "redis"
 }
 
-// HealthService 健康检查服务
+// MongoHealthChecker MongoDB健康检查
+type MongoHealthChecker struct {
+	client *mongo.Client
+}
+
+func NewMongoHealthChecker(client *mongo.Client) *MongoHealthChecker {
+	return &MongoHealthChecker{client: client}
+}
+
+func (h *MongoHealthChecker) Check(ctx context.Context) error {
+	if err := h.client.Ping(ctx, readpref.Primary()); err != nil {
+		return fmt.Errorf("mongo ping failed: %w", err)
+	}
+	return nil
+}
+
+func (h *MongoHealthChecker) Name() string {
+	return "mongo"
+}
+
+// GeoIPHealthChecker GeoIP数据库健康检查,数据库关闭后查询会失败
+type GeoIPHealthChecker struct {
+	reader *geoip2.Reader
+}
+
+func NewGeoIPHealthChecker(reader *geoip2.Reader) *GeoIPHealthChecker {
+	return &GeoIPHealthChecker{reader: reader}
+}
+
+func (h *GeoIPHealthChecker) Check(ctx context.Context) error {
+	if _, err := h.reader.Country(net.IPv4(1, 1, 1, 1)); err != nil {
+		return fmt.Errorf("geoip lookup failed: %w", err)
+	}
+	return nil
+}
+
+func (h *GeoIPHealthChecker) Name() string {
+	return "geoip"
+}
+
+// Pinger 可探测连通性的依赖,如对象存储
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// StorageHealthChecker 存储健康检查
+type StorageHealthChecker struct {
+	storage Pinger
+}
+
+func NewStorageHealthChecker(storage Pinger) *StorageHealthChecker {
+	return &StorageHealthChecker{storage: storage}
+}
+
+func (h *StorageHealthChecker) Check(ctx context.Context) error {
+	if err := h.storage.Ping(ctx); err != nil {
+		return fmt.Errorf("storage ping failed: %w", err)
+	}
+	return nil
+}
+
+func (h *StorageHealthChecker) Name() string {
+	return "storage"
+}
+
+// CheckResult 单个检查的结果
+type CheckResult struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// Report 健康检查报告
+type Report struct {
+	Status    string                 `json:"status"`
+	Timestamp time.Time              `json:"timestamp"`
+	Checks    map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Healthy 所有检查是否都通过
+func (r *Report) Healthy() bool {
+	return r.Status == StatusOK
+}
+
+// HealthService 健康检查服务,检查并发执行,结果缓存 cacheTTL 时间
 type HealthService struct {
 	checkers []HealthChecker
 	logger   *logger.Logger
+
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	shuttingDown atomic.Bool
+	group        singleflight.Group
+	mu           sync.RWMutex
+	cached       *Report
 }
 
 func NewHealthService(logger *logger.Logger, checkers ...HealthChecker) *HealthService {
 	return &HealthService{
 		checkers: checkers,
 		logger:   logger,
+		timeout:  DefaultTimeout,
+		cacheTTL: DefaultCacheTTL,
+	}
+}
+
+// WithTimeout 设置单个检查的超时时间
+func (h *HealthService) WithTimeout(d time.Duration) *HealthService {
+	h.timeout = d
+	return h
+}
+
+// WithCacheTTL 设置检查结果的缓存时间,为0时不缓存
+func (h *HealthService) WithCacheTTL(d time.Duration) *HealthService {
+	h.cacheTTL = d
+	return h
+}
+
+// Shutdown 标记服务正在关闭,之后就绪检查始终失败,使负载均衡摘除本实例
+func (h *HealthService) Shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+// Check 返回健康检查报告,缓存有效时直接返回缓存结果,并发请求只会执行一次检查
+func (h *HealthService) Check(ctx context.Context) *Report {
+	h.mu.RLock()
+	cached := h.cached
+	h.mu.RUnlock()
+	if cached != nil && time.Since(cached.Timestamp) < h.cacheTTL {
+		return cached
+	}
+
+	v, _, _ := h.group.Do("check", func() (interface{}, error) {
+		// 检查结果由所有等待的请求共享,不能因为某个请求取消而中断
+		report := h.run(context.WithoutCancel(ctx))
+		h.mu.Lock()
+		h.cached = report
+		h.mu.Unlock()
+		return report, nil
+	})
+	return v.(*Report)
+}
+
+// run 并发执行所有检查
+func (h *HealthService) run(ctx context.Context) *Report {
+	report := &Report{
+		Status:    StatusOK,
+		Timestamp: time.Now(),
+		Checks:    make(map[string]CheckResult, len(h.checkers)),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, checker := range h.checkers {
+		wg.Add(1)
+		go func(checker HealthChecker) {
+			defer wg.Done()
+			result := h.checkOne(ctx, checker)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[checker.Name()] = result
+			if result.Status != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}(checker)
 	}
+	wg.Wait()
+	return report
+}
+
+func (h *HealthService) checkOne(ctx context.Context, checker HealthChecker) (result CheckResult) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			result = CheckResult{Status: StatusError, Error: fmt.Sprintf("panic: %v", r)}
+		}
+		result.LatencyMs = time.Since(start).Milliseconds()
+		if result.Status != StatusOK {
+			logger.Errorf(ctx, "health check failed for %s: %s", checker.Name(), result.Error)
+		}
+	}()
+
+	if err := checker.Check(ctx); err != nil {
+		return CheckResult{Status: StatusError, Error: err.Error()}
+	}
+	return CheckResult{Status: StatusOK}
 }
 
 // CheckAll 检查所有服务
 func (h *HealthService) CheckAll(ctx context.Context) map[string]error {
-	results := make(map[string]error)
-	
-	for _, checker := range h.checkers {
-		if err := checker.Check(ctx); err != nil {
-			results[checker.Name()] = err
-			logger.Errorf(ctx, "health check failed for %s: %v", checker.Name(), err)
+	report := h.Check(ctx)
+	results := make(map[string]error, len(report.Checks))
+	for name, r := range report.Checks {
+		if r.Status == StatusOK {
+			results[name] = nil
 		} else {
-			results[checker.Name()] = nil
+			results[name] = fmt.Errorf("%s", r.Error)
 		}
 	}
-	
 	return results
 }
 
 // IsHealthy 检查整体健康状态
 func (h *HealthService) IsHealthy(ctx context.Context) bool {
-	results := h.CheckAll(ctx)
-	for _, err := range results {
-		if err != nil {
-			return false
-		}
+	return h.Check(ctx).Healthy()
+}
+
+// LivenessHandler 存活检查,进程能处理请求即返回200,不检查外部依赖
+func (h *HealthService) LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, &Report{Status: StatusOK, Timestamp: time.Now()})
 	}
-	return true
 }
 
-// HTTPHandler 返回HTTP健康检查处理器
-func (h *HealthService) HTTPHandler() http.HandlerFunc {
+// ReadinessHandler 就绪检查,所有依赖正常时返回200,否则返回503和各检查的详情
+func (h *HealthService) ReadinessHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		results := h.CheckAll(ctx)
-		
-		w.Header().Set("Content-Type", "application/json")
-		
-		healthy := true
-		for _, err := range results {
-			if err != nil {
-				healthy = false
-				break
-			}
+		if h.shuttingDown.Load() {
+			writeReport(w, http.StatusServiceUnavailable, &Report{Status: StatusShuttingDown, Timestamp: time.Now()})
+			return
 		}
-		
-		if healthy {
-			w.WriteHeader(http.StatusOK)
-			fmt.Fprintf(w, `{"status":"healthy","timestamp":"%s"}`, time.Now().Format(time.RFC3339))
-		} else {
-			w.WriteHeader(http.StatusServiceUnavailable)
-			fmt.Fprintf(w, `{"status":"unhealthy","timestamp":"%s","errors":{`, time.Now().Format(time.RFC3339))
-			
-			first := true
-			for name, err := range results {
-				if err != nil {
-					if !first {
-						fmt.Fprint(w, ",")
-					}
-					fmt.Fprintf(w, `"%s":"%s"`, name, err.Error())
-					first = false
-				}
-			}
-			fmt.Fprint(w, "}}")
+
+		report := h.Check(r.Context())
+		code := http.StatusOK
+		if !report.Healthy() {
+			code = http.StatusServiceUnavailable
 		}
+		writeReport(w, code, report)
 	}
 }
+
+// HTTPHandler 返回HTTP健康检查处理器,同 ReadinessHandler
+func (h *HealthService) HTTPHandler() http.HandlerFunc {
+	return h.ReadinessHandler()
+}
+
+func writeReport(w http.ResponseWriter, code int, report *Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	var calls atomic.Int32
+	slow := NewChecker("slow", func(ctx context.Context) error {
+		calls.Add(1)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	broken := NewChecker("broken", func(ctx context.Context) error {
+		return errors.New(`bad "quote"`)
+	})
+	ok := NewChecker("ok", func(ctx context.Context) error { return nil })
+
+	hs := NewHealthService(nil, slow, broken, ok).WithTimeout(50 * time.Millisecond).WithCacheTTL(time.Minute)
+
+	start := time.Now()
+	rec := httptest.NewRecorder()
+	hs.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("checks are not bounded by timeout, took %v", elapsed)
+	}
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rec.Code)
+	}
+
+	var report Report
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("invalid json %q: %v", rec.Body.String(), err)
+	}
+	if report.Checks["broken"].Error != `bad "quote"` {
+		t.Errorf("broken error = %q", report.Checks["broken"].Error)
+	}
+	if report.Checks["ok"].Status != StatusOK || report.Checks["slow"].Status != StatusError {
+		t.Errorf("unexpected checks: %+v", report.Checks)
+	}
+
+	// 缓存有效期内不会重复执行检查
+	hs.ReadinessHandler()(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if n := calls.Load(); n != 1 {
+		t.Errorf("checker called %d times, want 1", n)
+	}
+}
+
+func TestReadinessShuttingDown(t *testing.T) {
+	hs := NewHealthService(nil, NewChecker("ok", func(ctx context.Context) error { return nil }))
+
+	rec := httptest.NewRecorder()
+	hs.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+
+	hs.Shutdown()
+	rec = httptest.NewRecorder()
+	hs.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d after shutdown, want 503", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	hs.LivenessHandler()(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("liveness status = %d after shutdown, want 200", rec.Code)
+	}
+}
@@ -1,12 +1,14 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type HttpClient interface {
@@ -18,6 +20,7 @@ type HttpClient interface {
 
 // Request represents a HTTP request
 type Request struct {
+	ctx         context.Context
 	client      *resty.Client
 	headers     map[string]string
 	query       map[string]string
@@ -28,16 +31,23 @@ type Request struct {
 	retryDelay  time.Duration
 }
 
-// NewRequest creates a new Request instance with default settings
+// NewRequest creates a new Request instance with default settings.
+// Outgoing requests carry the W3C traceparent header of the context set by WithContext.
 func NewRequest() *Request {
 	return &Request{
-		client:   resty.New().SetTimeout(30 * time.Second),
+		client:   resty.New().SetTimeout(30 * time.Second).SetTransport(otelhttp.NewTransport(http.DefaultTransport)),
 		headers:  make(map[string]string),
 		query:    make(map[string]string),
 		formData: make(map[string]string),
 	}
 }
 
+// WithContext sets the context of the request, used for cancellation and tracing
+func (r *Request) WithContext(ctx context.Context) *Request {
+	r.ctx = ctx
+	return r
+}
+
 func (r *Request) SetRetryOptions(maxRetries int, retryDelay time.Duration) *Request {
 	r.maxRetries = maxRetries
 	r.retryDelay = retryDelay
@@ -122,6 +132,9 @@ func (r *Request) Delete(url string, result interface{}) error {
 // doRequest 执行 HTTP 请求
 func (r *Request) doRequest(method, url string, payload interface{}, result interface{}) error {
 	req := r.client.R()
+	if r.ctx != nil {
+		req.SetContext(r.ctx)
+	}
 	r.addHeaders(req)
 	r.addQueryParams(req)
 	r.setContentType(req)
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
@@ -30,6 +32,12 @@ func getSecretKey() []byte {
 	return []byte(secret)
 }
 
+// 令牌类型
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 // Config JWT配置
 type Config struct {
 	AccessSecret          string        `json:"access_secret"`
@@ -53,33 +61,59 @@ func NewManager(config Config) *Manager {
 
 // GenerateTokenPair 生成访问令牌和刷新令牌对
 func (m *Manager) GenerateTokenPair(userID int64, username, role string) (accessToken, refreshToken string, err error) {
+	pair, err := m.IssueTokenPair(Claims{Uid: userID, Username: username, Role: role})
+	if err != nil {
+		return "", "", err
+	}
+	return pair.AccessToken, pair.RefreshToken, nil
+}
+
+// TokenPair 令牌对及其jti、过期时间
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	AccessJti        string
+	RefreshJti       string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+// IssueTokenPair 以给定的claims为模板生成访问令牌和刷新令牌对,
+// 两个令牌分别携带各自的类型和jti。
+func (m *Manager) IssueTokenPair(claims Claims) (*TokenPair, error) {
+	now := time.Now()
+	pair := &TokenPair{
+		AccessJti:        uuid.NewString(),
+		RefreshJti:       uuid.NewString(),
+		AccessExpiresAt:  now.Add(m.config.AccessTokenDuration),
+		RefreshExpiresAt: now.Add(m.config.RefreshTokenDuration),
+	}
+
+	var err error
 	// 生成访问令牌
-	accessToken, err = m.generateToken(userID, username, role, "access", m.config.AccessSecret, m.config.AccessTokenDuration)
+	pair.AccessToken, err = m.generateToken(claims, TokenTypeAccess, pair.AccessJti, m.config.AccessSecret, now, pair.AccessExpiresAt)
 	if err != nil {
-		return "", "", fmt.Errorf("generate access token: %w", err)
+		return nil, fmt.Errorf("generate access token: %w", err)
 	}
 
 	// 生成刷新令牌
-	refreshToken, err = m.generateToken(userID, username, role, "refresh", m.config.RefreshSecret, m.config.RefreshTokenDuration)
+	pair.RefreshToken, err = m.generateToken(claims, TokenTypeRefresh, pair.RefreshJti, m.config.RefreshSecret, now, pair.RefreshExpiresAt)
 	if err != nil {
-		return "", "", fmt.Errorf("generate refresh token: %w", err)
+		return nil, fmt.Errorf("generate refresh token: %w", err)
 	}
 
-	return accessToken, refreshToken, nil
+	return pair, nil
 }
 
 // generateToken 生成指定类型的令牌
-func (m *Manager) generateToken(userID int64, username, role, tokenType, secret string, expiration time.Duration) (string, error) {
-	now := time.Now()
-	claims := Claims{
-		Uid:      userID,
-		Username: username,
-		Role:     role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(expiration)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-		},
+func (m *Manager) generateToken(claims Claims, tokenType, jti, secret string, issuedAt, expiresAt time.Time) (string, error) {
+	claims.TokenType = tokenType
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        jti,
+		Subject:   strconv.FormatInt(claims.Uid, 10),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		NotBefore: jwt.NewNumericDate(issuedAt),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -90,9 +124,9 @@ func (m *Manager) generateToken(userID int64, username, role, tokenType, secret
 func (m *Manager) ParseToken(tokenString, tokenType string) (*Claims, error) {
 	var secret string
 	switch tokenType {
-	case "access":
+	case TokenTypeAccess:
 		secret = m.config.AccessSecret
-	case "refresh":
+	case TokenTypeRefresh:
 		secret = m.config.RefreshSecret
 	default:
 		return nil, fmt.Errorf("unknown token type: %s", tokenType)
@@ -115,13 +149,32 @@ func (m *Manager) ParseToken(tokenString, tokenType string) (*Claims, error) {
 	if !ok || !token.Valid {
 		return nil, ErrInvalidToken
 	}
+	// 防止刷新令牌被当作访问令牌使用,反之亦然
+	if claims.TokenType != tokenType {
+		return nil, ErrInvalidToken
+	}
 
 	return claims, nil
 }
 
 // ValidateAccessToken 验证访问令牌
 func (m *Manager) ValidateAccessToken(tokenString string) (*Claims, error) {
-	return m.ParseToken(tokenString, "access")
+	return m.ParseToken(tokenString, TokenTypeAccess)
+}
+
+// ValidateRefreshToken 验证刷新令牌
+func (m *Manager) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	return m.ParseToken(tokenString, TokenTypeRefresh)
+}
+
+// AccessTokenDuration 返回访问令牌有效期
+func (m *Manager) AccessTokenDuration() time.Duration {
+	return m.config.AccessTokenDuration
+}
+
+// RefreshTokenDuration 返回刷新令牌有效期
+func (m *Manager) RefreshTokenDuration() time.Duration {
+	return m.config.RefreshTokenDuration
 }
 
 // ExtractTokenFromHeader 从Authorization header中提取令牌
@@ -145,6 +198,10 @@ type Claims struct {
 	SiteId      int    `json:"site_id"`
 	IsAdUser    bool   `json:"is_ad_user"`
 	Uuid        string `json:"uuid"`
+	TokenType   string `json:"token_type,omitempty"`
+	Sid         string `json:"sid,omitempty"` // 会话ID
+	MFA         bool   `json:"mfa,omitempty"` // 本次登录是否通过了两步验证
+	Imp         int64  `json:"imp,omitempty"` // 管理员以用户身份登录时为管理员ID
 	jwt.RegisteredClaims
 }
 
@@ -72,3 +72,37 @@ func TestDecodeJWT(t *testing.T) {
 	}
 }
 
+
+func TestIssueTokenPair(t *testing.T) {
+	manager := NewManager(Config{
+		AccessSecret:         "test-secret",
+		RefreshSecret:        "test-secret",
+		AccessTokenDuration:  time.Hour,
+		RefreshTokenDuration: 24 * time.Hour,
+	})
+
+	pair, err := manager.IssueTokenPair(Claims{Uid: 1, Username: "ydssx", Type: 2, Uuid: "u-1", Sid: "s-1"})
+	if err != nil {
+		t.Fatalf("IssueTokenPair() error = %v", err)
+	}
+	accessToken, refreshToken := pair.AccessToken, pair.RefreshToken
+
+	claims, err := manager.ValidateAccessToken(accessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken() error = %v", err)
+	}
+	if claims.Uid != 1 || claims.Type != 2 || claims.Uuid != "u-1" || claims.Sid != "s-1" || claims.ID != pair.AccessJti {
+		t.Errorf("ValidateAccessToken() claims = %+v", claims)
+	}
+
+	// 同一密钥下刷新令牌不能当作访问令牌使用,反之亦然
+	if _, err := manager.ValidateAccessToken(refreshToken); err == nil {
+		t.Error("ValidateAccessToken() accepted a refresh token")
+	}
+	if _, err := manager.ValidateRefreshToken(accessToken); err == nil {
+		t.Error("ValidateRefreshToken() accepted an access token")
+	}
+	if _, err := manager.ValidateRefreshToken(refreshToken); err != nil {
+		t.Errorf("ValidateRefreshToken() error = %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package limit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// AttemptPolicy 失败次数限制策略
+type AttemptPolicy struct {
+	MaxFailures int           // 窗口内允许的最大失败次数,达到后锁定
+	Window      time.Duration // 失败计数窗口
+	BaseLockout time.Duration // 首次锁定时长,之后每次锁定时长翻倍
+	MaxLockout  time.Duration // 最大锁定时长
+	LevelTTL    time.Duration // 锁定级别保留时长,超过该时长未再被锁定则级别清零
+}
+
+// AttemptLimiter 失败次数计数器,支持渐进式锁定
+type AttemptLimiter interface {
+	// Locked 返回key剩余的锁定时长,未锁定时返回0
+	Locked(ctx context.Context, key string) (time.Duration, error)
+	// Fail 记录一次失败,达到阈值时锁定并返回锁定时长,未锁定时返回0
+	Fail(ctx context.Context, key string) (time.Duration, error)
+	// Reset 清除失败计数(不清除锁定级别)
+	Reset(ctx context.Context, key string) error
+}
+
+// RedisAttemptLimiter 基于redis的失败次数计数器
+type RedisAttemptLimiter struct {
+	rdb    *redis.Client
+	policy AttemptPolicy
+}
+
+var _ AttemptLimiter = (*RedisAttemptLimiter)(nil)
+
+func NewRedisAttemptLimiter(rdb *redis.Client, policy AttemptPolicy) *RedisAttemptLimiter {
+	if policy.MaxFailures <= 0 {
+		policy.MaxFailures = 5
+	}
+	if policy.Window <= 0 {
+		policy.Window = 15 * time.Minute
+	}
+	if policy.BaseLockout <= 0 {
+		policy.BaseLockout = time.Minute
+	}
+	if policy.MaxLockout < policy.BaseLockout {
+		policy.MaxLockout = policy.BaseLockout
+	}
+	if policy.LevelTTL <= 0 {
+		policy.LevelTTL = 24 * time.Hour
+	}
+	return &RedisAttemptLimiter{rdb: rdb, policy: policy}
+}
+
+func (l *RedisAttemptLimiter) failKey(key string) string  { return "attempt:fail:" + key }
+func (l *RedisAttemptLimiter) lockKey(key string) string  { return "attempt:lock:" + key }
+func (l *RedisAttemptLimiter) levelKey(key string) string { return "attempt:level:" + key }
+
+// Locked 返回key剩余的锁定时长
+func (l *RedisAttemptLimiter) Locked(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := l.rdb.PTTL(ctx, l.lockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Fail 记录一次失败,失败次数达到阈值后锁定,锁定时长随锁定次数指数增长
+func (l *RedisAttemptLimiter) Fail(ctx context.Context, key string) (time.Duration, error) {
+	failures, err := l.rdb.Incr(ctx, l.failKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if failures == 1 {
+		l.rdb.Expire(ctx, l.failKey(key), l.policy.Window)
+	}
+	if failures < int64(l.policy.MaxFailures) {
+		return 0, nil
+	}
+
+	level, err := l.rdb.Incr(ctx, l.levelKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	lockout := l.lockoutFor(level)
+
+	pipe := l.rdb.TxPipeline()
+	pipe.Expire(ctx, l.levelKey(key), l.policy.LevelTTL)
+	pipe.Set(ctx, l.lockKey(key), level, lockout)
+	pipe.Del(ctx, l.failKey(key))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return lockout, nil
+}
+
+// Reset 清除失败计数,锁定级别保留至过期,避免成功一次后重新获得完整的尝试次数
+func (l *RedisAttemptLimiter) Reset(ctx context.Context, key string) error {
+	return l.rdb.Del(ctx, l.failKey(key)).Err()
+}
+
+// lockoutFor 计算第level次锁定的时长
+func (l *RedisAttemptLimiter) lockoutFor(level int64) time.Duration {
+	lockout := l.policy.BaseLockout
+	for i := int64(1); i < level; i++ {
+		lockout *= 2
+		if lockout >= l.policy.MaxLockout {
+			return l.policy.MaxLockout
+		}
+	}
+	return lockout
+}
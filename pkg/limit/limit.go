@@ -21,6 +21,7 @@ const (
 type option struct {
 	ratePerSecond int
 	burst         int
+	period        time.Duration
 }
 
 type Option func(*option)
@@ -38,6 +39,12 @@ func WithBurst(burst int) Option {
 	return func(o *option) { o.burst = burst }
 }
 
+// WithPeriod 设置限流周期,默认为1秒。配合WithRatePerSecond使用时,
+// rate表示每个周期内允许的请求数,例如每分钟1次、每天10次。
+func WithPeriod(period time.Duration) Option {
+	return func(o *option) { o.period = period }
+}
+
 type Limiter interface {
 	Allow(key string, opts ...Option) bool
 }
@@ -66,12 +73,12 @@ func (l *RedisLimiter) Limit(ctx context.Context) error {
 // It uses the redis client to check against the limiter and returns
 // whether the request is allowed.
 func (l *RedisLimiter) Allow(key string, opts ...Option) bool {
-	opt := &option{ratePerSecond: 10, burst: 20}
+	opt := &option{ratePerSecond: 10, burst: 20, period: time.Second}
 	for _, v := range opts {
 		v(opt)
 	}
 
-	r, err := l.Limiter.Allow(context.Background(), key, perSecond(opt.ratePerSecond, opt.burst))
+	r, err := l.Limiter.Allow(context.Background(), key, perPeriod(opt.ratePerSecond, opt.burst, opt.period))
 	if err != nil {
 		panic(err)
 	}
@@ -83,11 +90,14 @@ func (l *RedisLimiter) Reset(key string) {
 	_ = l.Limiter.Reset(context.Background(), key)
 }
 
-func perSecond(rate, burst int) redis_rate.Limit {
+func perPeriod(rate, burst int, period time.Duration) redis_rate.Limit {
 	burst = int(math.Max(float64(rate), float64(burst)))
+	if period <= 0 {
+		period = time.Second
+	}
 	return redis_rate.Limit{
 		Rate:   rate,
-		Period: time.Second,
+		Period: period,
 		Burst:  burst,
 	}
 }
@@ -0,0 +1,107 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+)
+
+const (
+	// defaultLeaderTTL 默认主节点锁的有效期
+	defaultLeaderTTL = 10 * time.Second
+	// leaderRetryInterval 续期失败后重试的最大间隔
+	leaderRetryInterval = time.Second
+)
+
+// Elector 基于 Redis 锁的选主,持有锁的实例为主节点。
+// 主节点每 ttl/3 续期一次,续期出错时在锁的剩余有效期内重试,锁已被其他实例持有或过期时放弃主节点身份;其他实例每 ttl/3 尝试获取一次锁,
+// 主节点正常退出时释放锁,其他实例在 ttl/3 内接管,异常退出时在 ttl+ttl/3 内接管
+type Elector struct {
+	client *redislock.Client
+	key    string
+	ttl    time.Duration
+}
+
+// NewElector 创建选主器,ttl 为 0 时使用默认值 10s
+func NewElector(locker *RedisLocker, key string, ttl time.Duration) *Elector {
+	if ttl <= 0 {
+		ttl = defaultLeaderTTL
+	}
+	return &Elector{client: locker.Client, key: key, ttl: ttl}
+}
+
+// Run 参与选主直到 ctx 结束,成为主节点后执行 lead,失去主节点身份时取消传给 lead 的 ctx,
+// lead 返回后释放锁并重新参与选主
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	interval := e.ttl / 3
+	for {
+		start := time.Now()
+		lock, err := e.client.Obtain(ctx, e.key, e.ttl, nil)
+		if err == nil {
+			logger.Infof(ctx, "became leader of %s", e.key)
+			e.lead(ctx, lock, start.Add(e.ttl), interval, lead)
+		} else if !errors.Is(err, redislock.ErrNotObtained) && ctx.Err() == nil {
+			logger.Warnf(ctx, "failed to obtain leader lock %s: %v", e.key, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// lead 执行 lead 并定期续期锁,expires 为锁的过期时间
+func (e *Elector) lead(ctx context.Context, lock *redislock.Lock, expires time.Time, interval time.Duration, lead func(ctx context.Context)) {
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-done:
+			break loop
+		case <-timer.C:
+		}
+
+		start := time.Now()
+		err := lock.Refresh(ctx, e.ttl, nil)
+		if err == nil {
+			expires = start.Add(e.ttl)
+			timer.Reset(interval)
+			continue
+		}
+		if ctx.Err() != nil {
+			break loop
+		}
+		if errors.Is(err, redislock.ErrNotObtained) {
+			logger.Errorf(ctx, "lost leadership of %s: lock expired or taken over", e.key)
+			break loop
+		}
+		remaining := time.Until(expires)
+		if remaining <= 0 {
+			logger.Errorf(ctx, "lost leadership of %s: failed to refresh lock before it expired: %v", e.key, err)
+			break loop
+		}
+		logger.Warnf(ctx, "failed to refresh leader lock %s, retrying within %s: %v", e.key, remaining, err)
+		timer.Reset(min(leaderRetryInterval, remaining))
+	}
+	cancel()
+	<-done
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), interval)
+	defer releaseCancel()
+	lock.Release(releaseCtx)
+}
@@ -0,0 +1,79 @@
+package lock
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElector(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	key := "test_leader"
+	ttl := 300 * time.Millisecond
+	var leaders, maxLeaders atomic.Int32
+
+	run := func(ctx context.Context) {
+		NewElector(NewLocker(rdb), key, ttl).Run(ctx, func(ctx context.Context) {
+			n := leaders.Add(1)
+			for m := maxLeaders.Load(); n > m && !maxLeaders.CompareAndSwap(m, n); m = maxLeaders.Load() {
+			}
+			<-ctx.Done()
+			leaders.Add(-1)
+		})
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	done1 := make(chan struct{})
+	go func() { run(ctx1); close(done1) }()
+	go run(ctx2)
+
+	time.Sleep(ttl)
+	assert.Equal(t, int32(1), leaders.Load())
+
+	// 主节点退出后另一个实例接管
+	cancel1()
+	<-done1
+	time.Sleep(ttl)
+	assert.Equal(t, int32(1), leaders.Load())
+	assert.Equal(t, int32(1), maxLeaders.Load())
+}
+
+func TestElectorRetryRefresh(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	ttl := 600 * time.Millisecond
+	var lost atomic.Bool
+	led := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go NewElector(NewLocker(rdb), "test_leader", ttl).Run(ctx, func(ctx context.Context) {
+		close(led)
+		<-ctx.Done()
+		lost.Store(true)
+	})
+	<-led
+
+	// 短暂的续期失败在锁过期前恢复,不失去主节点身份
+	mr.SetError("unavailable")
+	time.Sleep(ttl / 2)
+	mr.SetError("")
+	time.Sleep(ttl)
+	assert.False(t, lost.Load())
+
+	// 锁过期前一直续期失败,放弃主节点身份
+	mr.SetError("unavailable")
+	time.Sleep(ttl + ttl/2)
+	assert.True(t, lost.Load())
+}
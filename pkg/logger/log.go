@@ -19,10 +19,8 @@ var LogInject = func(ctx context.Context) (data []interface{}) {
 	if operation := kratos.OperationFromContext(ctx); operation != "" {
 		data = append(data, constants.LogKeyOperation, operation)
 	}
-	if claims := kratos.GetClaims(ctx); claims != nil {
-		if claims.Uid != 0 {
-			data = append(data, constants.LogKeyUserID, claims.Uid)
-		}
+	if uid := kratos.OriginUID(ctx); uid != 0 {
+		data = append(data, constants.LogKeyUserID, uid)
 	}
 	return data
 }
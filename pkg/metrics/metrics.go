@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// 所有指标注册到 prometheus 默认注册表,通过 /metrics 暴露
+var (
+	// ServerRequests 服务端请求数
+	ServerRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "server_requests_total",
+		Help: "Total number of requests handled by the server.",
+	}, []string{"kind", "operation", "code"})
+	// ServerRequestDuration 服务端请求耗时
+	ServerRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "server_request_duration_seconds",
+		Help:    "Server request latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind", "operation"})
+	// ServerRequestsInFlight 正在处理的请求数
+	ServerRequestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "server_requests_in_flight",
+		Help: "Number of requests currently being handled by the server.",
+	}, []string{"kind", "operation"})
+
+	// TasksProcessed 已处理的异步任务数,包含失败
+	TasksProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "asynq_tasks_processed_total",
+		Help: "Total number of asynq tasks processed.",
+	}, []string{"task_type"})
+	// TasksFailed 处理失败的异步任务数
+	TasksFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "asynq_tasks_failed_total",
+		Help: "Total number of asynq tasks that returned an error.",
+	}, []string{"task_type"})
+	// TasksRetried 重试执行的异步任务数
+	TasksRetried = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "asynq_tasks_retried_total",
+		Help: "Total number of asynq task executions that were retries.",
+	}, []string{"task_type"})
+	// TaskDuration 异步任务处理耗时
+	TaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "asynq_task_duration_seconds",
+		Help:    "Asynq task processing latency in seconds.",
+		Buckets: []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300},
+	}, []string{"task_type"})
+
+	// DBQueryDuration SQL执行耗时
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "SQL query latency in seconds.",
+		Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "status"})
+
+	// CacheRequests 缓存读取次数,result 为 hit/miss
+	CacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Total number of cache lookups by key prefix and result.",
+	}, []string{"prefix", "result"})
+
+	// WebSocketConnections 当前WebSocket连接数
+	WebSocketConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "websocket_connections",
+		Help: "Number of connected WebSocket clients.",
+	})
+	// SSEClients 当前SSE客户端数
+	SSEClients = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "sse_clients",
+		Help: "Number of connected SSE clients.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		ServerRequests,
+		ServerRequestDuration,
+		ServerRequestsInFlight,
+		TasksProcessed,
+		TasksFailed,
+		TasksRetried,
+		TaskDuration,
+		DBQueryDuration,
+		CacheRequests,
+		WebSocketConnections,
+		SSEClients,
+	)
+}
+
+// Register 注册采集器,重复注册时忽略
+func Register(c prometheus.Collector) error {
+	err := prometheus.Register(c)
+	var are prometheus.AlreadyRegisteredError
+	if errors.As(err, &are) {
+		return nil
+	}
+	return err
+}
+
+// RegisterDBStats 注册数据库连接池指标
+func RegisterDBStats(db *sql.DB, name string) error {
+	return Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// CacheKeyPrefix 返回缓存key第一个冒号前的部分作为指标标签,避免标签基数过大
+func CacheKeyPrefix(key string) string {
+	prefix, _, _ := strings.Cut(key, ":")
+	return prefix
+}
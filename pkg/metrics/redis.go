@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisPoolCollector 采集Redis连接池状态
+type redisPoolCollector struct {
+	stats func() *redis.PoolStats
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+// NewRedisPoolCollector 创建Redis连接池指标采集器,addr 和 db 作为标签区分不同的客户端
+func NewRedisPoolCollector(addr string, db int, stats func() *redis.PoolStats) prometheus.Collector {
+	labels := prometheus.Labels{"addr": addr, "db": strconv.Itoa(db)}
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("redis_pool_"+name, help, nil, labels)
+	}
+	return &redisPoolCollector{
+		stats:      stats,
+		hits:       desc("hits_total", "Number of times a free connection was found in the pool."),
+		misses:     desc("misses_total", "Number of times a free connection was NOT found in the pool."),
+		timeouts:   desc("timeouts_total", "Number of times a wait timeout occurred."),
+		totalConns: desc("total_connections", "Number of total connections in the pool."),
+		idleConns:  desc("idle_connections", "Number of idle connections in the pool."),
+		staleConns: desc("stale_connections_total", "Number of stale connections removed from the pool."),
+	}
+}
+
+func (c *redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+}
+
+func (c *redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.stats()
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(s.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(s.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(s.Timeouts))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(s.StaleConns))
+}
@@ -0,0 +1,31 @@
+package mgin
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics 记录gin路由的请求数、耗时和正在处理的请求数,operation 为 方法+路由模板
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		operation := c.Request.Method + " " + route
+
+		inFlight := metrics.ServerRequestsInFlight.WithLabelValues("http", operation)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		start := time.Now()
+		c.Next()
+
+		metrics.ServerRequests.WithLabelValues("http", operation, strconv.Itoa(c.Writer.Status())).Inc()
+		metrics.ServerRequestDuration.WithLabelValues("http", operation).Observe(time.Since(start).Seconds())
+	}
+}
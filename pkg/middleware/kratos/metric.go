@@ -1,18 +1,41 @@
 package kratos
 
 import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
-	"github.com/go-kratos/kratos/v2/middleware/metrics"
-	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// MetricServer 记录服务端请求数、耗时和正在处理的请求数,按 operation 和错误码区分
 func MetricServer() middleware.Middleware {
-	meterProvider := sdkmetric.NewMeterProvider()
-	_metricRequests, _ := metrics.DefaultRequestsCounter(meterProvider.Meter("server_requests_code_total"), "server_requests_code_total")
-	_metricSeconds, _ := metrics.DefaultSecondsHistogram(meterProvider.Meter("server_requests_duration_seconds"), "server_requests_duration_seconds")
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			kind, operation := "unknown", ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				kind, operation = tr.Kind().String(), tr.Operation()
+			}
+
+			inFlight := metrics.ServerRequestsInFlight.WithLabelValues(kind, operation)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
 
-	return metrics.Server(
-		metrics.WithRequests(_metricRequests),
-		metrics.WithSeconds(_metricSeconds),
-	)
+			code := http.StatusOK
+			if se := errors.FromError(err); se != nil {
+				code = int(se.Code)
+			}
+			metrics.ServerRequests.WithLabelValues(kind, operation, strconv.Itoa(code)).Inc()
+			metrics.ServerRequestDuration.WithLabelValues(kind, operation).Observe(time.Since(start).Seconds())
+			return reply, err
+		}
+	}
 }
@@ -0,0 +1,56 @@
+package kratos
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// 跨进程传递的上下文字段,同时用作 CloudEvent 扩展属性名和任务头,
+// 链路上下文使用 W3C 的 traceparent/tracestate/baggage
+const (
+	CarrierTraceID = "traceid"
+	CarrierUserID  = "userid"
+)
+
+type originUIDKey struct{}
+
+// WithOriginUID 保存发起方的用户ID,用于日志关联,不是登录信息
+func WithOriginUID(ctx context.Context, uid int64) context.Context {
+	return context.WithValue(ctx, originUIDKey{}, uid)
+}
+
+// OriginUID 返回发起方的用户ID,优先使用当前登录用户,其次使用从上游传递的用户ID
+func OriginUID(ctx context.Context) int64 {
+	if uid := GetClaims(ctx).Uid; uid != 0 {
+		return uid
+	}
+	uid, _ := ctx.Value(originUIDKey{}).(int64)
+	return uid
+}
+
+// InjectContext 将链路上下文、链路ID和发起方用户ID写入 carrier
+func InjectContext(ctx context.Context, carrier map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(carrier))
+	if traceID := TraceIDFromContext(ctx); traceID != "" {
+		carrier[CarrierTraceID] = traceID
+	}
+	if uid := OriginUID(ctx); uid != 0 {
+		carrier[CarrierUserID] = strconv.FormatInt(uid, 10)
+	}
+}
+
+// ExtractContext 从 carrier 恢复链路上下文、链路ID和发起方用户ID。
+// 任务头和事件属性可以被任意投递方设置,用户ID只通过 OriginUID 读取,不会生成登录信息
+func ExtractContext(ctx context.Context, carrier map[string]string) context.Context {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+	if traceID := carrier[CarrierTraceID]; traceID != "" {
+		ctx = NewTraceIDContext(ctx, traceID)
+	}
+	if uid, _ := strconv.ParseInt(carrier[CarrierUserID], 10, 64); uid != 0 {
+		ctx = WithOriginUID(ctx, uid)
+	}
+	return ctx
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TraceServer() middleware.Middleware {
@@ -26,7 +27,7 @@ func TraceServer() middleware.Middleware {
 			)
 
 			startTime := time.Now()
-			traceID := uuid.NewString()
+			traceID := newTraceID(ctx)
 			ctx = NewTraceIDContext(ctx, traceID)
 			if info, ok := http.RequestFromServerContext(ctx); ok {
 				operation = info.URL.Path
@@ -72,12 +73,23 @@ func NewTraceIDContext(ctx context.Context, id string) context.Context {
 	return context.WithValue(ctx, traceIDKey{}, id)
 }
 
+// TraceIDFromContext 返回请求的链路ID,未设置时使用上下文中 OpenTelemetry span 的 trace id
 func TraceIDFromContext(ctx context.Context) string {
-	id, ok := ctx.Value(traceIDKey{}).(string)
-	if !ok {
-		id = ""
+	if id, ok := ctx.Value(traceIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// newTraceID 优先使用 OpenTelemetry span 的 trace id,没有时生成随机ID
+func newTraceID(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
 	}
-	return id
+	return uuid.NewString()
 }
 
 func TraceID() log.Valuer {
@@ -0,0 +1,72 @@
+package oauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// AppleIssuer Sign in with Apple issuer
+const AppleIssuer = "https://appleid.apple.com"
+
+// NewAppleProvider Sign in with Apple。
+// Apple的client secret是使用.p8私钥签名的ES256 JWT,每次换取令牌时动态生成;
+// 申请name/email权限时Apple要求以form_post方式回调。
+func NewAppleProvider(cfg Config) (*OIDCProvider, error) {
+	if cfg.Issuer == "" {
+		cfg.Issuer = AppleIssuer
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "name", "email"}
+	}
+
+	p := NewOIDCProvider(cfg)
+	p.authParams = []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("response_mode", "form_post")}
+	if cfg.PrivateKey == "" {
+		return p, nil
+	}
+
+	key, err := parseECPrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("oauth provider %s: %w", cfg.Name, err)
+	}
+	p.clientSecret = func() (string, error) {
+		return appleClientSecret(cfg, key)
+	}
+	return p, nil
+}
+
+// appleClientSecret 生成Apple要求的client secret
+func appleClientSecret(cfg Config, key *ecdsa.PrivateKey) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    cfg.TeamID,
+		Subject:   cfg.ClientID,
+		Audience:  jwt.ClaimStrings{AppleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	})
+	token.Header["kid"] = cfg.KeyID
+	return token.SignedString(key)
+}
+
+func parseECPrivateKey(pemKey string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an ECDSA key")
+	}
+	return ecKey, nil
+}
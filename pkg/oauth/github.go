@@ -0,0 +1,108 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+const githubAPI = "https://api.github.com"
+
+// GitHubProvider GitHub登录,GitHub不支持OIDC,通过REST API获取用户信息
+type GitHubProvider struct {
+	name   string
+	conf   *oauth2.Config
+	apiURL string
+	client *http.Client
+}
+
+func NewGitHubProvider(cfg Config) *GitHubProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"read:user", "user:email"}
+	}
+	endpoint := github.Endpoint
+	apiURL := githubAPI
+	// 配置了issuer时视为GitHub Enterprise地址
+	if cfg.Issuer != "" {
+		endpoint = oauth2.Endpoint{
+			AuthURL:  cfg.Issuer + "/login/oauth/authorize",
+			TokenURL: cfg.Issuer + "/login/oauth/access_token",
+		}
+		apiURL = cfg.Issuer + "/api/v3"
+	}
+	return &GitHubProvider{
+		name: cfg.Name,
+		conf: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+			Endpoint:     endpoint,
+		},
+		apiURL: apiURL,
+		client: cfg.HTTPClient,
+	}
+}
+
+// Name implements Provider.
+func (p *GitHubProvider) Name() string {
+	return p.name
+}
+
+// AuthCodeURL implements Provider. GitHub不签发ID Token,nonce不使用。
+func (p *GitHubProvider) AuthCodeURL(ctx context.Context, state, codeVerifier, nonce string) (string, error) {
+	return p.conf.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange implements Provider.
+func (p *GitHubProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	token, err := p.conf.Exchange(withHTTPClient(ctx, p.client), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %w", err)
+	}
+
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, p.client, p.apiURL+"/user", token.AccessToken, &user); err != nil {
+		return nil, fmt.Errorf("get github user: %w", err)
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("get github user: missing id")
+	}
+
+	identity := &Identity{
+		Provider: p.name,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Name:     user.Name,
+		Picture:  user.AvatarURL,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+
+	// 公开资料中的邮箱未必经过验证,以 /user/emails 中的主邮箱为准
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, p.client, p.apiURL+"/user/emails", token.AccessToken, &emails); err != nil {
+		return nil, fmt.Errorf("get github emails: %w", err)
+	}
+	for _, e := range emails {
+		if e.Primary {
+			identity.Email = e.Email
+			identity.EmailVerified = e.Verified
+			break
+		}
+	}
+	return identity, nil
+}
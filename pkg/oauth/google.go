@@ -0,0 +1,14 @@
+package oauth
+
+// GoogleIssuer Google OIDC issuer
+const GoogleIssuer = "https://accounts.google.com"
+
+// NewGoogleProvider Google登录,基于OIDC发现
+func NewGoogleProvider(cfg Config) *OIDCProvider {
+	if cfg.Issuer == "" {
+		cfg.Issuer = GoogleIssuer
+	}
+	p := NewOIDCProvider(cfg)
+	p.issuerAliases = []string{"accounts.google.com"}
+	return p
+}
@@ -0,0 +1,155 @@
+// Package oauth 第三方登录(OAuth2/OIDC)提供方抽象。
+//
+// 每个提供方负责构造授权地址(附带state、PKCE和nonce)并用授权码换取第三方身份,
+// 调用方负责生成和校验state、保存code_verifier和nonce。
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	TypeGoogle = "google"
+	TypeGitHub = "github"
+	TypeApple  = "apple"
+	TypeOIDC   = "oidc"
+)
+
+var (
+	// ErrProviderNotFound 提供方未配置
+	ErrProviderNotFound = errors.New("oauth provider not found")
+	// ErrInvalidIDToken ID Token校验失败
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+// Identity 第三方账号身份
+type Identity struct {
+	Provider      string // 提供方名称
+	Subject       string // 第三方账号唯一标识
+	Email         string
+	EmailVerified bool // 邮箱是否已被提供方验证,只有已验证的邮箱才能用于关联已有账号
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Picture       string
+}
+
+// Provider 第三方登录提供方
+type Provider interface {
+	// Name 提供方名称,与路由中的 {provider} 对应
+	Name() string
+	// AuthCodeURL 构造授权地址,codeVerifier 用于生成PKCE challenge,nonce 写入ID Token
+	AuthCodeURL(ctx context.Context, state, codeVerifier, nonce string) (string, error)
+	// Exchange 使用授权码换取第三方身份,并校验PKCE和nonce
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// IDTokenVerifier 支持直接校验前端获取的ID Token(如Google One Tap)
+type IDTokenVerifier interface {
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Identity, error)
+}
+
+// Config 提供方配置
+type Config struct {
+	Name         string
+	Type         string // google/github/apple/oidc,为空时与Name相同
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Issuer       string // OIDC issuer,用于发现配置
+	Scopes       []string
+	TeamID       string // Apple Team ID
+	KeyID        string // Apple Key ID
+	PrivateKey   string // Apple .p8 私钥(PEM)
+	HTTPClient   *http.Client
+}
+
+// New 根据配置类型创建提供方
+func New(cfg Config) (Provider, error) {
+	typ := cfg.Type
+	if typ == "" {
+		typ = cfg.Name
+	}
+	if cfg.Name == "" {
+		cfg.Name = typ
+	}
+	switch strings.ToLower(typ) {
+	case TypeGoogle:
+		return NewGoogleProvider(cfg), nil
+	case TypeGitHub:
+		return NewGitHubProvider(cfg), nil
+	case TypeApple:
+		return NewAppleProvider(cfg)
+	case TypeOIDC:
+		if cfg.Issuer == "" {
+			return nil, fmt.Errorf("oauth provider %s: issuer is required", cfg.Name)
+		}
+		return NewOIDCProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("oauth provider %s: unsupported type %q", cfg.Name, typ)
+	}
+}
+
+// Registry 提供方注册表
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register 注册提供方,同名提供方会被覆盖
+func (r *Registry) Register(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+// Get 获取提供方,不存在时返回 ErrProviderNotFound
+func (r *Registry) Get(name string) (Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+	return p, nil
+}
+
+// Names 返回已注册的提供方名称
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenerateVerifier 生成PKCE code_verifier
+func GenerateVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+// withHTTPClient 让oauth2库使用自定义的http客户端
+func withHTTPClient(ctx context.Context, client *http.Client) context.Context {
+	if client == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, client)
+}
+
+func httpClient(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+	return client
+}
@@ -0,0 +1,348 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// jwksRefreshInterval 遇到未知kid时重新拉取JWKS的最小间隔,防止被恶意令牌放大请求
+const jwksRefreshInterval = time.Minute
+
+// discovery OIDC发现文档
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider 通用OIDC提供方,首次使用时通过 {issuer}/.well-known/openid-configuration 发现端点
+type OIDCProvider struct {
+	cfg Config
+
+	// clientSecret 动态生成client secret(Apple),为空时使用 cfg.ClientSecret
+	clientSecret func() (string, error)
+	// authParams 附加的授权参数
+	authParams []oauth2.AuthCodeOption
+	// issuerAliases ID Token中可接受的其他issuer写法(Google会返回不带https的issuer)
+	issuerAliases []string
+
+	mu          sync.Mutex
+	meta        *discovery
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+func NewOIDCProvider(cfg Config) *OIDCProvider {
+	cfg.Issuer = strings.TrimSuffix(cfg.Issuer, "/")
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &OIDCProvider{cfg: cfg}
+}
+
+// Name implements Provider.
+func (p *OIDCProvider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL implements Provider.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, codeVerifier, nonce string) (string, error) {
+	conf, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	opts := append([]oauth2.AuthCodeOption{
+		oauth2.S256ChallengeOption(codeVerifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	}, p.authParams...)
+	return conf.AuthCodeURL(state, opts...), nil
+}
+
+// Exchange implements Provider.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	conf, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.clientSecret != nil {
+		if conf.ClientSecret, err = p.clientSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := conf.Exchange(withHTTPClient(ctx, p.cfg.HTTPClient), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %w", err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("%w: missing id_token in token response", ErrInvalidIDToken)
+	}
+
+	identity, err := p.VerifyIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	// 部分提供方的ID Token不包含邮箱,从userinfo补全
+	if identity.Email == "" && p.meta.UserinfoEndpoint != "" {
+		if err := p.fillUserinfo(ctx, token, identity); err != nil {
+			return nil, err
+		}
+	}
+	return identity, nil
+}
+
+// idTokenClaims ID Token中使用到的声明
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // Apple返回字符串"true"
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Picture       string `json:"picture"`
+	jwt.RegisteredClaims
+}
+
+// VerifyIDToken 校验ID Token的签名、issuer、audience、有效期和nonce
+func (p *OIDCProvider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := new(idTokenClaims)
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384"}),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if !p.validIssuer(meta, claims.Issuer) {
+		return nil, fmt.Errorf("%w: issuer mismatch", ErrInvalidIDToken)
+	}
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	return &Identity{
+		Provider:      p.cfg.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+func (p *OIDCProvider) validIssuer(meta *discovery, iss string) bool {
+	if iss == meta.Issuer {
+		return true
+	}
+	for _, alias := range p.issuerAliases {
+		if iss == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// fillUserinfo 从userinfo端点补全身份信息
+func (p *OIDCProvider) fillUserinfo(ctx context.Context, token *oauth2.Token, identity *Identity) error {
+	var info struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"`
+		Name          string `json:"name"`
+		Picture       string `json:"picture"`
+	}
+	if err := getJSON(ctx, p.cfg.HTTPClient, p.meta.UserinfoEndpoint, token.AccessToken, &info); err != nil {
+		return fmt.Errorf("get userinfo: %w", err)
+	}
+	// userinfo的sub必须与ID Token一致,否则可能是令牌替换攻击
+	if info.Sub != identity.Subject {
+		return fmt.Errorf("%w: userinfo subject mismatch", ErrInvalidIDToken)
+	}
+	identity.Email = info.Email
+	identity.EmailVerified = info.EmailVerified == true || info.EmailVerified == "true"
+	if identity.Name == "" {
+		identity.Name = info.Name
+	}
+	if identity.Picture == "" {
+		identity.Picture = info.Picture
+	}
+	return nil
+}
+
+func (p *OIDCProvider) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}, nil
+}
+
+// discover 获取并缓存发现文档,失败时下次调用会重试
+func (p *OIDCProvider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	meta := new(discovery)
+	if err := getJSON(ctx, p.cfg.HTTPClient, p.cfg.Issuer+"/.well-known/openid-configuration", "", meta); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.cfg.Issuer {
+		return nil, fmt.Errorf("oidc discovery: issuer mismatch, want %s got %s", p.cfg.Issuer, meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JwksURI == "" {
+		return nil, fmt.Errorf("oidc discovery: incomplete provider metadata")
+	}
+	p.meta = meta
+	return meta, nil
+}
+
+// publicKey 根据kid查找签名公钥,未命中时刷新JWKS以支持密钥轮换
+func (p *OIDCProvider) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetched) < jwksRefreshInterval && p.keys != nil {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, p.cfg.HTTPClient, p.meta.JwksURI, "", &set); err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	p.keys = keys
+	p.keysFetched = time.Now()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// jwk JSON Web Key,只支持RSA和EC签名密钥
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	if k.Use != "" && k.Use != "sig" {
+		return nil, fmt.Errorf("unsupported key use %q", k.Use)
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// getJSON 发送GET请求并解析JSON响应,accessToken不为空时附带Bearer认证
+func getJSON(ctx context.Context, client *http.Client, url, accessToken string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	resp, err := httpClient(client).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,119 @@
+package oauth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ydssx/kratos-kit/pkg/oauth"
+	"github.com/ydssx/kratos-kit/pkg/oauth/oauthtest"
+)
+
+const redirectURL = "http://localhost/api/users/oauth/test/callback"
+
+func newTestProvider(t *testing.T) (*oauthtest.Server, oauth.Provider) {
+	t.Helper()
+	srv := oauthtest.NewServer("client-id", "client-secret")
+	t.Cleanup(srv.Close)
+
+	p, err := oauth.New(oauth.Config{
+		Name:         "test",
+		Type:         oauth.TypeOIDC,
+		Issuer:       srv.Issuer(),
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  redirectURL,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return srv, p
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newTestProvider(t)
+	srv.SetUser(oauthtest.User{Subject: "42", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+
+	verifier := oauth.GenerateVerifier()
+	authURL, err := p.AuthCodeURL(ctx, "state-1", verifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+	code, state, err := srv.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if state != "state-1" {
+		t.Errorf("state = %s, want state-1", state)
+	}
+
+	identity, err := p.Exchange(ctx, code, verifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if identity.Provider != "test" || identity.Subject != "42" || identity.Email != "alice@example.com" || !identity.EmailVerified {
+		t.Errorf("Exchange() = %+v", identity)
+	}
+
+	// 授权码只能使用一次
+	if _, err := p.Exchange(ctx, code, verifier, "nonce-1"); err == nil {
+		t.Error("Exchange() accepted a used code")
+	}
+}
+
+func TestOIDCProviderRejectsWrongVerifierAndNonce(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newTestProvider(t)
+
+	verifier := oauth.GenerateVerifier()
+	authURL, _ := p.AuthCodeURL(ctx, "state", verifier, "nonce")
+	code, _, err := srv.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if _, err := p.Exchange(ctx, code, oauth.GenerateVerifier(), "nonce"); err == nil {
+		t.Error("Exchange() accepted a wrong code_verifier")
+	}
+
+	authURL, _ = p.AuthCodeURL(ctx, "state", verifier, "nonce")
+	code, _, _ = srv.Authorize(authURL)
+	if _, err := p.Exchange(ctx, code, verifier, "other-nonce"); !errors.Is(err, oauth.ErrInvalidIDToken) {
+		t.Errorf("Exchange() error = %v, want ErrInvalidIDToken", err)
+	}
+}
+
+func TestOIDCProviderVerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newTestProvider(t)
+
+	verifier, ok := p.(oauth.IDTokenVerifier)
+	if !ok {
+		t.Fatal("OIDC provider does not implement IDTokenVerifier")
+	}
+	identity, err := verifier.VerifyIDToken(ctx, srv.IDToken(""), "")
+	if err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+	if identity.Subject != "user-1" {
+		t.Errorf("VerifyIDToken() subject = %s, want user-1", identity.Subject)
+	}
+
+	other := oauthtest.NewServer("client-id", "client-secret")
+	defer other.Close()
+	if _, err := verifier.VerifyIDToken(ctx, other.IDToken(""), ""); err == nil {
+		t.Error("VerifyIDToken() accepted a token signed by another issuer")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	_, p := newTestProvider(t)
+	r := oauth.NewRegistry(p)
+
+	if got, err := r.Get("test"); err != nil || got != p {
+		t.Errorf("Get(test) = %v, %v", got, err)
+	}
+	if _, err := r.Get("missing"); !errors.Is(err, oauth.ErrProviderNotFound) {
+		t.Errorf("Get(missing) error = %v, want ErrProviderNotFound", err)
+	}
+}
@@ -0,0 +1,135 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+// Argon2Params argon2id 参数
+type Argon2Params struct {
+	Memory      uint32 // 内存开销,单位KiB
+	Iterations  uint32 // 迭代次数
+	Parallelism uint8  // 并行度
+	SaltLength  uint32 // 盐长度
+	KeyLength   uint32 // 输出长度
+}
+
+// DefaultArgon2Params 默认参数,参考 OWASP 推荐配置
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Argon2idHasher argon2id 哈希器,输出 PHC 格式:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+var _ Hasher = (*Argon2idHasher)(nil)
+
+// NewArgon2idHasher 创建 argon2id 哈希器,未设置的参数使用默认值
+func NewArgon2idHasher(params Argon2Params) *Argon2idHasher {
+	def := DefaultArgon2Params()
+	if params.Memory == 0 {
+		params.Memory = def.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = def.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = def.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = def.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = def.KeyLength
+	}
+	return &Argon2idHasher{params: params}
+}
+
+func (h *Argon2idHasher) Hash(plain string) (string, error) {
+	if plain == "" {
+		return "", ErrEmptyPassword
+	}
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(plain), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version,
+		h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *Argon2idHasher) Verify(encoded, plain string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+	other := argon2.IDKey([]byte(plain), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, salt, _, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		uint32(len(salt)) != h.params.SaltLength
+}
+
+func (h *Argon2idHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+// decodeArgon2id 解析 PHC 格式的 argon2id 哈希
+func decodeArgon2id(encoded string) (params Argon2Params, salt, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrUnknownScheme
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("incompatible argon2id version: %d", version)
+	}
+
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id params: %w", err)
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id hash: %w", err)
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
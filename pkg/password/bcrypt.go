@@ -0,0 +1,51 @@
+package password
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher bcrypt 哈希器,哈希本身包含 cost 参数
+type BcryptHasher struct {
+	cost int
+}
+
+var _ Hasher = (*BcryptHasher)(nil)
+
+// NewBcryptHasher 创建 bcrypt 哈希器,cost 非法时使用 bcrypt.DefaultCost
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+func (h *BcryptHasher) Hash(plain string) (string, error) {
+	if plain == "" {
+		return "", ErrEmptyPassword
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+func (h *BcryptHasher) Verify(encoded, plain string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(plain))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != h.cost
+}
+
+func (h *BcryptHasher) Match(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
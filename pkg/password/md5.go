@@ -0,0 +1,44 @@
+package password
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+)
+
+// MD5Hasher 历史遗留的无盐 md5 哈希,仅用于校验旧数据,
+// 校验通过后应使用默认哈希器重新计算。
+type MD5Hasher struct{}
+
+var _ Hasher = (*MD5Hasher)(nil)
+
+func NewMD5Hasher() *MD5Hasher {
+	return &MD5Hasher{}
+}
+
+func (h *MD5Hasher) Hash(plain string) (string, error) {
+	return "", errors.New("md5 password hashing is no longer supported")
+}
+
+func (h *MD5Hasher) Verify(encoded, plain string) (bool, error) {
+	sum := md5.Sum([]byte(plain))
+	return subtle.ConstantTimeCompare([]byte(encoded), []byte(hex.EncodeToString(sum[:]))) == 1, nil
+}
+
+func (h *MD5Hasher) NeedsRehash(encoded string) bool {
+	return true
+}
+
+// Match md5 哈希为32位小写十六进制字符串
+func (h *MD5Hasher) Match(encoded string) bool {
+	if len(encoded) != md5.Size*2 {
+		return false
+	}
+	for _, c := range encoded {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package password
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrEmptyPassword 密码为空
+	ErrEmptyPassword = errors.New("password cannot be empty")
+	// ErrUnknownScheme 无法识别的哈希格式
+	ErrUnknownScheme = errors.New("unknown password hash scheme")
+)
+
+// Hasher 密码哈希器。
+// 哈希结果自带算法标识和参数,校验时无需额外的配置。
+type Hasher interface {
+	// Hash 计算密码哈希
+	Hash(plain string) (string, error)
+	// Verify 校验密码是否与哈希匹配
+	Verify(encoded, plain string) (bool, error)
+	// NeedsRehash 判断哈希是否需要按当前算法/参数重新计算
+	NeedsRehash(encoded string) bool
+	// Match 判断哈希是否由该算法生成
+	Match(encoded string) bool
+}
+
+// MultiHasher 组合多个哈希器:使用默认哈希器生成新哈希,
+// 校验时根据哈希格式选择对应的哈希器,从而兼容历史数据。
+type MultiHasher struct {
+	primary Hasher
+	legacy  []Hasher
+}
+
+var _ Hasher = (*MultiHasher)(nil)
+
+// NewMultiHasher 创建组合哈希器,primary 用于生成新哈希,legacy 仅用于校验旧哈希
+func NewMultiHasher(primary Hasher, legacy ...Hasher) *MultiHasher {
+	return &MultiHasher{primary: primary, legacy: legacy}
+}
+
+// Hash 使用默认哈希器计算密码哈希
+func (m *MultiHasher) Hash(plain string) (string, error) {
+	return m.primary.Hash(plain)
+}
+
+// Verify 根据哈希格式选择哈希器进行校验
+func (m *MultiHasher) Verify(encoded, plain string) (bool, error) {
+	h := m.find(encoded)
+	if h == nil {
+		return false, ErrUnknownScheme
+	}
+	return h.Verify(encoded, plain)
+}
+
+// NeedsRehash 非默认算法生成的哈希,或默认算法参数已变化时需要重新计算
+func (m *MultiHasher) NeedsRehash(encoded string) bool {
+	if !m.primary.Match(encoded) {
+		return true
+	}
+	return m.primary.NeedsRehash(encoded)
+}
+
+// Match 判断哈希是否能被任一哈希器识别
+func (m *MultiHasher) Match(encoded string) bool {
+	return m.find(encoded) != nil
+}
+
+func (m *MultiHasher) find(encoded string) Hasher {
+	if m.primary.Match(encoded) {
+		return m.primary
+	}
+	for _, h := range m.legacy {
+		if h.Match(encoded) {
+			return h
+		}
+	}
+	return nil
+}
+
+// New 根据算法名创建哈希器,支持 argon2id(默认) 和 bcrypt。
+// 返回的哈希器能够校验 argon2id、bcrypt 以及历史的 md5 哈希。
+func New(algorithm string, argon2Params Argon2Params, bcryptCost int) *MultiHasher {
+	argon2Hasher := NewArgon2idHasher(argon2Params)
+	bcryptHasher := NewBcryptHasher(bcryptCost)
+	legacyMD5 := NewMD5Hasher()
+
+	if strings.EqualFold(algorithm, "bcrypt") {
+		return NewMultiHasher(bcryptHasher, argon2Hasher, legacyMD5)
+	}
+	return NewMultiHasher(argon2Hasher, bcryptHasher, legacyMD5)
+}
@@ -0,0 +1,73 @@
+package password
+
+import (
+	"strings"
+	"testing"
+)
+
+// 测试用的低开销参数
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestArgon2idHasher(t *testing.T) {
+	h := NewArgon2idHasher(testArgon2Params)
+
+	encoded, err := h.Hash("secret123")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("Hash() = %s, want encoded params", encoded)
+	}
+
+	ok, err := h.Verify(encoded, "secret123")
+	if err != nil || !ok {
+		t.Errorf("Verify() = %v, %v, want true", ok, err)
+	}
+	ok, _ = h.Verify(encoded, "wrong")
+	if ok {
+		t.Error("Verify() accepted a wrong password")
+	}
+
+	if h.NeedsRehash(encoded) {
+		t.Error("NeedsRehash() = true for current params")
+	}
+	if !NewArgon2idHasher(Argon2Params{Memory: 2048, Iterations: 1, Parallelism: 1}).NeedsRehash(encoded) {
+		t.Error("NeedsRehash() = false after params changed")
+	}
+}
+
+func TestMultiHasher(t *testing.T) {
+	h := New("argon2id", testArgon2Params, 4)
+
+	tests := []struct {
+		name        string
+		encoded     func() string
+		plain       string
+		want        bool
+		needsRehash bool
+	}{
+		{"argon2id", func() string { s, _ := h.Hash("secret123"); return s }, "secret123", true, false},
+		{"bcrypt", func() string { s, _ := NewBcryptHasher(4).Hash("secret123"); return s }, "secret123", true, true},
+		{"legacy md5", func() string { return "e10adc3949ba59abbe56e057f20f883e" }, "123456", true, true},
+		{"legacy md5 wrong", func() string { return "e10adc3949ba59abbe56e057f20f883e" }, "1234567", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := tt.encoded()
+			got, err := h.Verify(encoded, tt.plain)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+			if h.NeedsRehash(encoded) != tt.needsRehash {
+				t.Errorf("NeedsRehash() = %v, want %v", !tt.needsRehash, tt.needsRehash)
+			}
+		})
+	}
+
+	if _, err := h.Verify("plaintext", "plaintext"); err != ErrUnknownScheme {
+		t.Errorf("Verify() error = %v, want ErrUnknownScheme", err)
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"time"
 
 	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/tracing"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type (
@@ -36,7 +40,6 @@ type Event struct {
 	contentType ContentType
 	source      string
 	eventType   string
-	traceID     string
 }
 
 type ContentType string
@@ -47,16 +50,11 @@ const (
 	TextPlain       ContentType = cloudevents.TextPlain
 )
 
-func getTraceIDFromCtx(ctx context.Context) string {
-	return kratos.TraceIDFromContext(ctx)
-}
-
 func newDefaultEvent(ctx context.Context) *Event {
 	return &Event{
 		contentType: ApplicationJSON,
 		source:      EventSourceFromCtx(ctx),
 		eventType:   EventTypeFromCtx(ctx),
-		traceID:     getTraceIDFromCtx(ctx),
 	}
 }
 
@@ -85,8 +83,41 @@ func NewEvent(ctx context.Context, payload interface{}, opts ...Option) (event.E
 	e.SetType(ev.eventType)
 	e.SetTime(time.Now().Local())
 	e.SetSource(ev.source)
-	e.SetExtension("traceid", ev.traceID)
+	// 链路上下文和用户ID写入扩展属性,订阅端通过 ContextFromEvent 恢复
+	carrier := make(map[string]string)
+	kratos.InjectContext(ctx, carrier)
+	for k, v := range carrier {
+		e.SetExtension(k, v)
+	}
 	err := e.SetData(string(ev.contentType), payload)
 
 	return e, err
 }
+
+// ContextFromEvent 从事件扩展属性中恢复链路上下文、链路ID和用户ID
+func ContextFromEvent(ctx context.Context, e *CloudEvent) context.Context {
+	carrier := make(map[string]string)
+	for k, v := range e.Extensions() {
+		if s, ok := v.(string); ok {
+			carrier[k] = s
+		}
+	}
+	return kratos.ExtractContext(ctx, carrier)
+}
+
+// handleEvent 在恢复的上下文中调用处理函数,并为处理过程创建span
+func handleEvent(ctx context.Context, subject string, handler EventHandler, e *CloudEvent) error {
+	ctx, span := tracing.Start(ContextFromEvent(ctx, e), "pubsub handle "+subject,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingDestinationName(subject),
+			semconv.MessagingMessageID(e.ID()),
+			attribute.String("cloudevents.event_type", e.Type()),
+		),
+	)
+	defer span.End()
+
+	err := handler(ctx, e)
+	tracing.RecordError(span, err)
+	return err
+}
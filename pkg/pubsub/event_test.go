@@ -0,0 +1,39 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ydssx/kratos-kit/pkg/jwt"
+	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+)
+
+func TestContextFromEvent(t *testing.T) {
+	ctx := kratos.NewTraceIDContext(context.Background(), "trace-1")
+	ctx = kratos.NewContext(ctx, &jwt.Claims{Uid: 42, Username: "alice"})
+
+	e, err := NewEvent(ctx, map[string]string{"k": "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := e.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := new(CloudEvent)
+	if err := received.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+
+	got := ContextFromEvent(context.Background(), received)
+	if id := kratos.TraceIDFromContext(got); id != "trace-1" {
+		t.Errorf("trace id = %q, want %q", id, "trace-1")
+	}
+	if uid := kratos.OriginUID(got); uid != 42 {
+		t.Errorf("uid = %d, want 42", uid)
+	}
+	// 事件中的用户ID不能作为登录信息
+	if uid := kratos.GetClaims(got).Uid; uid != 0 {
+		t.Errorf("claims uid = %d, want 0", uid)
+	}
+}
@@ -67,7 +67,9 @@ func (ps *RedisPubSub) SubscribeToTopic(ctx context.Context, topic string, handl
 						return
 					}
 
-					handler(ctx, data)
+					if err := handleEvent(ctx, topic, handler, data); err != nil {
+						logger.Errorf(ContextFromEvent(ctx, data), "Failed to handle message on topic [%s]: %v", topic, err)
+					}
 				}(msg)
 			default:
 				// 如果无法立即获取信号量，记录日志并继续
@@ -117,7 +119,9 @@ func (ps *RedisPubSub) SubscribeToQueue(ctx context.Context, queue string, handl
 					continue
 				}
 
-				handler(ctx, data)
+				if err := handleEvent(ctx, queue, handler, data); err != nil {
+					logger.Errorf(ContextFromEvent(ctx, data), "Failed to handle message on queue [%s]: %v", queue, err)
+				}
 				// 将 CloudEvent 实例放回对象池
 				pool.Put(data)
 			}
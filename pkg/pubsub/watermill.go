@@ -7,6 +7,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill-redisstream/pkg/redisstream"
 	"github.com/redis/go-redis/v9"
+	"github.com/ydssx/kratos-kit/pkg/logger"
 )
 
 type WatermillPubSub struct {
@@ -61,11 +62,18 @@ func (ps *WatermillPubSub) Subscribe(ctx context.Context, subject string, handle
 			event := new(CloudEvent)
 			err := event.UnmarshalJSON(msg.Payload)
 			if err != nil {
-				// 处理解码错误
+				// 无法解码的消息重试也不会成功,确认后丢弃
+				logger.Errorf(ctx, "Failed to decode message on topic [%s]: %v", subject, err)
+				msg.Ack()
 				continue
 			}
 
-			handler(ctx, event)
+			// 处理失败时 Nack,消息稍后重新投递
+			if err := handleEvent(ctx, subject, handler, event); err != nil {
+				logger.Errorf(ContextFromEvent(ctx, event), "Failed to handle message on topic [%s]: %v", subject, err)
+				msg.Nack()
+				continue
+			}
 			msg.Ack()
 		}
 	}()
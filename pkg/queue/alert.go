@@ -0,0 +1,196 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+)
+
+const (
+	// defaultAlertWindow 默认的失败率统计周期
+	defaultAlertWindow = 5 * time.Minute
+	// archivedAlertInterval 同一任务类型归档告警的最小间隔,间隔内的告警合并到下一次发送
+	archivedAlertInterval = time.Minute
+)
+
+// Notifier 发送告警消息,如 *webhook.Webhook
+type Notifier interface {
+	SendMessageContext(ctx context.Context, msg string) error
+}
+
+// AlertConfig 任务告警配置
+type AlertConfig struct {
+	// Webhook 告警通知,为 nil 时不告警
+	Webhook Notifier
+	// FailureRate 队列失败率的告警阈值,取值 (0, 1],为 0 时不检查失败率
+	FailureRate float64
+	// MinProcessed 统计周期内执行的任务数不少于该值时才检查失败率
+	MinProcessed int
+	// Window 失败率的统计周期,为 0 时使用默认值 5 分钟
+	Window time.Duration
+}
+
+// reportError 记录任务失败,任务重试次数用尽或不再重试时归档并告警
+func (c *Server) reportError(ctx context.Context, task *asynq.Task, err error) {
+	ctx = kratos.ExtractContext(ctx, task.Headers())
+	if !willArchive(ctx, err) {
+		logger.Warnf(ctx, "执行任务失败,task_type:%s ,err: %v", task.Type(), err)
+		return
+	}
+
+	id, _ := asynq.GetTaskID(ctx)
+	qname, _ := asynq.GetQueueName(ctx)
+	retried, _ := asynq.GetRetryCount(ctx)
+	logger.Errorf(ctx, "任务已归档,task_type:%s ,task_id:%s ,queue:%s ,retried:%d ,err: %v", task.Type(), id, qname, retried, err)
+	c.alerts.archived(ctx, task.Type(), fmt.Sprintf("任务已归档\n队列：%s\n类型：%s\n任务ID：%s\n已重试：%d\n错误：%v", qname, task.Type(), id, retried, err))
+}
+
+// willArchive 判断失败的任务是否会被归档,与 asynq 的处理一致
+func willArchive(ctx context.Context, err error) bool {
+	if errors.Is(err, asynq.RevokeTask) {
+		return false
+	}
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return retried >= maxRetry || errors.Is(err, asynq.SkipRetry)
+}
+
+// alerter 发送任务告警,同一任务类型的归档告警限制发送频率
+type alerter struct {
+	hook Notifier
+
+	mu         sync.Mutex
+	last       map[string]time.Time
+	suppressed map[string]int
+}
+
+func newAlerter(hook Notifier) *alerter {
+	return &alerter{hook: hook, last: map[string]time.Time{}, suppressed: map[string]int{}}
+}
+
+// archived 发送任务归档告警,距离上次同类型告警不足 archivedAlertInterval 时只计数
+func (a *alerter) archived(ctx context.Context, taskType, msg string) {
+	if a.hook == nil {
+		return
+	}
+	a.mu.Lock()
+	if time.Since(a.last[taskType]) < archivedAlertInterval {
+		a.suppressed[taskType]++
+		a.mu.Unlock()
+		return
+	}
+	if n := a.suppressed[taskType]; n > 0 {
+		msg += fmt.Sprintf("\n此前 %d 个同类型任务已归档", n)
+	}
+	a.last[taskType] = time.Now()
+	a.suppressed[taskType] = 0
+	a.mu.Unlock()
+
+	a.send(ctx, msg)
+}
+
+func (a *alerter) send(ctx context.Context, msg string) {
+	if a.hook == nil {
+		return
+	}
+	// 告警在后台发送,不随任务或请求结束而取消,保留链路追踪信息
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := a.hook.SendMessageContext(ctx, msg); err != nil {
+			logger.Errorf(ctx, "failed to send task alert: %v", err)
+		}
+	}()
+}
+
+// FailureMonitor 按统计周期检查任务服务器处理的队列的失败率,超过阈值时告警。
+// 失败率为周期内失败的执行次数(包括重试)占执行次数的比例;多个实例同时运行会重复告警,应只在主节点运行
+type FailureMonitor struct {
+	inspector *asynq.Inspector
+	alerts    *alerter
+	cfg       AlertConfig
+}
+
+// FailureMonitor 创建服务器队列的失败率监控
+func (c *Server) FailureMonitor() *FailureMonitor {
+	cfg := c.alertCfg
+	if cfg.Window <= 0 {
+		cfg.Window = defaultAlertWindow
+	}
+	return &FailureMonitor{inspector: c.client.inspector, alerts: c.alerts, cfg: cfg}
+}
+
+// queueCounter 队列累计的执行和失败次数
+type queueCounter struct {
+	processed, failed int
+}
+
+// Run 检查失败率直到 ctx 结束,未配置告警时直接返回
+func (m *FailureMonitor) Run(ctx context.Context) {
+	if m.cfg.FailureRate <= 0 || m.alerts.hook == nil {
+		return
+	}
+	ticker := time.NewTicker(m.cfg.Window)
+	defer ticker.Stop()
+
+	prev := m.counters(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		cur := m.counters(ctx)
+		m.check(ctx, prev, cur)
+		prev = cur
+	}
+}
+
+// check 比较两次统计之间各队列的失败率
+func (m *FailureMonitor) check(ctx context.Context, prev, cur map[string]queueCounter) {
+	names := make([]string, 0, len(cur))
+	for name := range cur {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		before, ok := prev[name]
+		if !ok {
+			continue
+		}
+		processed := cur[name].processed - before.processed
+		failed := cur[name].failed - before.failed
+		// 计数被重置时跳过本周期
+		if processed <= 0 || failed < 0 || processed < m.cfg.MinProcessed {
+			continue
+		}
+		if rate := float64(failed) / float64(processed); rate >= m.cfg.FailureRate {
+			logger.Warnf(ctx, "queue %s failure rate %.2f exceeds threshold %.2f", name, rate, m.cfg.FailureRate)
+			m.alerts.send(ctx, fmt.Sprintf("队列失败率过高\n队列：%s\n最近 %s 执行：%d\n失败：%d\n失败率：%.1f%%\n阈值：%.1f%%",
+				name, m.cfg.Window, processed, failed, rate*100, m.cfg.FailureRate*100))
+		}
+	}
+}
+
+// counters 获取各队列累计的执行和失败次数,还没有任务的队列不返回
+func (m *FailureMonitor) counters(ctx context.Context) map[string]queueCounter {
+	counters := make(map[string]queueCounter, len(serverQueues))
+	for name := range serverQueues {
+		info, err := m.inspector.GetQueueInfo(name)
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			continue
+		}
+		if err != nil {
+			logger.Errorf(ctx, "failed to get queue %s info: %v", name, err)
+			continue
+		}
+		counters[name] = queueCounter{processed: info.ProcessedTotal, failed: info.FailedTotal}
+	}
+	return counters
+}
@@ -0,0 +1,53 @@
+package queue
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type fakeWebhook chan string
+
+func (w fakeWebhook) SendMessageContext(_ context.Context, msg string) error {
+	w <- msg
+	return nil
+}
+
+func TestFailureMonitorCheck(t *testing.T) {
+	hook := make(fakeWebhook, 10)
+	m := &FailureMonitor{alerts: newAlerter(hook), cfg: AlertConfig{FailureRate: 0.5, MinProcessed: 10}}
+
+	prev := map[string]queueCounter{
+		QueueCritical: {processed: 100, failed: 10},
+		QueueDefault:  {processed: 100, failed: 10},
+		QueueLow:      {processed: 100, failed: 10},
+	}
+	cur := map[string]queueCounter{
+		QueueCritical: {processed: 120, failed: 25}, // 75%
+		QueueDefault:  {processed: 120, failed: 15}, // 25%
+		QueueLow:      {processed: 105, failed: 15}, // 样本不足
+	}
+	m.check(context.Background(), prev, cur)
+
+	msg := <-hook
+	if !strings.Contains(msg, QueueCritical) {
+		t.Fatalf("unexpected alert: %s", msg)
+	}
+	select {
+	case msg := <-hook:
+		t.Fatalf("unexpected alert: %s", msg)
+	default:
+	}
+}
+
+func TestArchivedAlertThrottle(t *testing.T) {
+	hook := make(fakeWebhook, 10)
+	a := newAlerter(hook)
+	for i := 0; i < 3; i++ {
+		a.archived(context.Background(), "TEST", "archived")
+	}
+	<-hook
+	if a.suppressed["TEST"] != 2 {
+		t.Fatalf("suppressed = %d, want 2", a.suppressed["TEST"])
+	}
+}
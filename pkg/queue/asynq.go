@@ -2,20 +2,37 @@ package queue
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/ydssx/kratos-kit/pkg/errors"
-	"github.com/ydssx/kratos-kit/pkg/logger"
+	"github.com/ydssx/kratos-kit/pkg/tracing"
+	"google.golang.org/protobuf/proto"
 )
 
-// Server wraps asynq client
+// serverQueues 任务服务器处理的队列及其优先级
+var serverQueues = map[string]int{
+	QueueCritical: 6,
+	QueueDefault:  3,
+	QueueLow:      1,
+}
+
+// IsServerQueue 队列是否由任务服务器处理,提交到其他队列的任务不会被执行
+func IsServerQueue(name string) bool {
+	_, ok := serverQueues[name]
+	return ok
+}
+
+// Server wraps asynq server, periodic tasks are scheduled by the Scheduler it creates
 type Server struct {
-	client    *asynq.Client
-	srv       *asynq.Server
-	mux       *asynq.ServeMux
-	scheduler *asynq.Scheduler
+	client *Client
+	srv    *asynq.Server
+	mux    *asynq.ServeMux
+
+	backoff  Backoff            // 默认的重试间隔
+	backoffs map[string]Backoff // 按任务类型配置的重试间隔
+	alerts   *alerter
+	alertCfg AlertConfig
 }
 
 type ConnConfig struct {
@@ -33,6 +50,10 @@ type Config struct {
 	// Queue configurations
 	Concurrency int
 	RetryLimit  int
+	// Backoff 默认的重试间隔,未设置的字段使用 DefaultBackoff
+	Backoff Backoff
+	// Alert 任务归档和队列失败率告警
+	Alert AlertConfig
 
 	BaseContext func() context.Context
 }
@@ -51,35 +72,26 @@ func NewServer(cfg *Config) (*Server, error) {
 		WriteTimeout: cfg.WriteTimeout,
 	}
 
-	client := asynq.NewClient(redisOpt)
-	srv := asynq.NewServer(
+	s := &Server{
+		client:   NewClient(&cfg.ConnConfig),
+		mux:      asynq.NewServeMux(),
+		backoff:  cfg.Backoff.withDefaults(DefaultBackoff),
+		backoffs: map[string]Backoff{},
+		alerts:   newAlerter(cfg.Alert.Webhook),
+		alertCfg: cfg.Alert,
+	}
+	s.srv = asynq.NewServer(
 		redisOpt,
 		asynq.Config{
-			Concurrency: cfg.Concurrency,
-			Queues: map[string]int{
-				"critical": 6,
-				"default":  3,
-				"low":      1,
-			},
-			BaseContext:  cfg.BaseContext,
-			ErrorHandler: asynq.ErrorHandlerFunc(reportError),
-			RetryDelayFunc: func(n int, e error, t *asynq.Task) time.Duration {
-				return time.Duration(n) * time.Minute
-			},
+			Concurrency:    cfg.Concurrency,
+			Queues:         serverQueues,
+			BaseContext:    cfg.BaseContext,
+			ErrorHandler:   asynq.ErrorHandlerFunc(s.reportError),
+			RetryDelayFunc: s.retryDelay,
 		},
 	)
-	mux := asynq.NewServeMux()
-	scheduler := asynq.NewScheduler(
-		redisOpt,
-		&asynq.SchedulerOpts{Location: time.Local},
-	)
-
-	return &Server{
-		client:    client,
-		srv:       srv,
-		mux:       mux,
-		scheduler: scheduler,
-	}, nil
+	s.mux.Use(tracingMiddleware, metricsMiddleware, classifyError)
+	return s, nil
 }
 
 // Close closes the client
@@ -91,16 +103,15 @@ func (c *Server) Close() error {
 		c.srv.Stop()
 		c.srv.Shutdown()
 	}
-	if c.scheduler != nil {
-		c.scheduler.Shutdown()
-	}
 	return nil
 }
 
-// Task represents an async task
+// Task represents an async task, the payload is protobuf encoded
 type Task struct {
 	TypeName string
-	Payload  interface{}
+	Payload  proto.Message
+	// Headers are carried in the task headers along with the trace context
+	Headers map[string]string
 }
 
 // HandleFunc represents a task handler function
@@ -117,31 +128,46 @@ func (c *Server) Start() error {
 	if err != nil {
 		return errors.Errorf("failed to start task processor: %v", err)
 	}
-	err = c.scheduler.Start()
-	if err != nil {
-		return errors.Errorf("failed to start scheduler: %v", err)
-	}
 	return nil
 }
 
-// EnqueuePeriodicTask enqueues a periodic task
-func (c *Server) EnqueuePeriodicTask(ctx context.Context, task *Task, spec string) error {
-	payload, err := json.Marshal(task.Payload)
-	if err != nil {
-		return errors.Errorf("failed to marshal task payload: %v", err)
-	}
+// Use appends middlewares to the task handlers
+func (c *Server) Use(mws ...asynq.MiddlewareFunc) {
+	c.mux.Use(mws...)
+}
 
-	t := asynq.NewTask(task.TypeName, payload)
-	_, err = c.scheduler.Register(spec, t)
-	if err != nil {
-		return errors.Errorf("failed to register periodic task: %v", err)
-	}
+// Scheduler creates a scheduler that enqueues the periodic tasks loaded from source to the server's redis
+func (c *Server) Scheduler(source CronSource) *Scheduler {
+	return NewScheduler(c.client, source, time.Local)
+}
 
-	return nil
+// Register registers the handlers and retry backoffs of the registry, it must be called before Start
+func (c *Server) Register(r *Registry) {
+	for typeName, handler := range r.Handlers() {
+		c.RegisterHandler(typeName, handler)
+	}
+	for typeName, b := range r.Backoffs() {
+		c.backoffs[typeName] = b.withDefaults(c.backoff)
+	}
 }
 
-func reportError(ctx context.Context, task *asynq.Task, err error) {
-	logger.Errorf(ctx, "执行任务失败,task_type:%s ,err: %v", task.Type(), err)
+var (
+	// ErrTaskNotFound is returned when the task or its queue does not exist
+	ErrTaskNotFound = errors.New("task not found")
+	// ErrDuplicateTask is returned when a unique task is enqueued again before its uniqueness lock expires
+	ErrDuplicateTask = errors.New("task already exists")
+)
+
+// marshalPayload encodes the task payload, a nil payload is encoded as empty bytes
+func marshalPayload(payload proto.Message) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, errors.Errorf("failed to marshal task payload: %v", err)
+	}
+	return data, nil
 }
 
 // Client wraps asynq client
@@ -165,23 +191,122 @@ func NewClient(cfg *ConnConfig) *Client {
 	}
 }
 
-// EnqueueTask enqueues a task
-func (c *Client) EnqueueTask(ctx context.Context, task *Task, opts ...asynq.Option) error {
-	payload, err := json.Marshal(task.Payload)
+// EnqueueTask enqueues a task and returns the task ID, the trace context and user ID of ctx are carried in the task headers
+func (c *Client) EnqueueTask(ctx context.Context, task *Task, opts ...asynq.Option) (id string, err error) {
+	ctx, span := enqueueSpan(ctx, task.TypeName)
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	payload, err := marshalPayload(task.Payload)
 	if err != nil {
-		return errors.Errorf("failed to marshal task payload: %v", err)
+		return "", err
 	}
 
-	t := asynq.NewTask(task.TypeName, payload)
-	_, err = c.client.EnqueueContext(ctx, t, opts...)
+	t := newTracedTask(ctx, task.TypeName, payload, task.Headers)
+	info, err := c.client.EnqueueContext(ctx, t, opts...)
+	if errors.Is(err, asynq.ErrDuplicateTask) || errors.Is(err, asynq.ErrTaskIDConflict) {
+		return "", ErrDuplicateTask
+	}
 	if err != nil {
-		return errors.Errorf("failed to enqueue task: %v", err)
+		return "", errors.Errorf("failed to enqueue task: %v", err)
 	}
 
-	return nil
+	return info.ID, nil
 }
 
 // EnqueueTaskWithDelay enqueues a task with delay
-func (c *Client) EnqueueTaskWithDelay(ctx context.Context, task *Task, delay time.Duration) error {
+func (c *Client) EnqueueTaskWithDelay(ctx context.Context, task *Task, delay time.Duration) (string, error) {
 	return c.EnqueueTask(ctx, task, asynq.ProcessIn(delay))
 }
+
+// GetTaskInfo returns the task of the queue, ErrTaskNotFound is returned if the task does not exist
+func (c *Client) GetTaskInfo(queue, id string) (*asynq.TaskInfo, error) {
+	info, err := c.inspector.GetTaskInfo(queue, id)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return info, nil
+}
+
+// CancelTask sends a cancellation signal to an active task, tasks that are not active are deleted
+func (c *Client) CancelTask(queue, id string) error {
+	info, err := c.GetTaskInfo(queue, id)
+	if err != nil {
+		return err
+	}
+	if info.State == asynq.TaskStateActive {
+		return c.inspector.CancelProcessing(id)
+	}
+	return taskError(c.inspector.DeleteTask(queue, id))
+}
+
+// RunTask moves a scheduled, retry or archived task to pending so that it is processed immediately
+func (c *Client) RunTask(queue, id string) error {
+	return taskError(c.inspector.RunTask(queue, id))
+}
+
+// ListArchivedTasks returns a page of the archived tasks of the queue and the total number of archived tasks, page starts from 1
+func (c *Client) ListArchivedTasks(queue string, page, pageSize int) ([]*asynq.TaskInfo, int, error) {
+	info, err := c.inspector.GetQueueInfo(queue)
+	if errors.Is(err, asynq.ErrQueueNotFound) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	tasks, err := c.inspector.ListArchivedTasks(queue, asynq.Page(page), asynq.PageSize(pageSize))
+	if err != nil {
+		return nil, 0, taskError(err)
+	}
+	return tasks, info.Archived, nil
+}
+
+// RequeueTask enqueues the task again as a new task with a fresh retry budget and returns the new task ID,
+// the new task keeps the payload, headers, queue, max retry, timeout and retention of the task
+func (c *Client) RequeueTask(ctx context.Context, info *asynq.TaskInfo) (string, error) {
+	opts := []asynq.Option{asynq.Queue(info.Queue), asynq.MaxRetry(info.MaxRetry)}
+	if info.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(info.Timeout))
+	}
+	if info.Retention > 0 {
+		opts = append(opts, asynq.Retention(info.Retention))
+	}
+	t := newTracedTask(ctx, info.Type, info.Payload, info.Headers)
+	newInfo, err := c.client.EnqueueContext(ctx, t, opts...)
+	if err != nil {
+		return "", errors.Errorf("failed to requeue task: %v", err)
+	}
+	return newInfo.ID, nil
+}
+
+// DeleteTask deletes a task that is not active
+func (c *Client) DeleteTask(queue, id string) error {
+	return taskError(c.inspector.DeleteTask(queue, id))
+}
+
+// QueueLatency returns how long the oldest pending task of the queue has been waiting
+func (c *Client) QueueLatency(queue string) (time.Duration, error) {
+	info, err := c.inspector.GetQueueInfo(queue)
+	if err != nil {
+		return 0, taskError(err)
+	}
+	return info.Latency, nil
+}
+
+// taskError converts asynq not found errors to ErrTaskNotFound
+func taskError(err error) error {
+	if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+		return ErrTaskNotFound
+	}
+	return err
+}
+
+// Close closes the connections to redis
+func (c *Client) Close() error {
+	if err := c.inspector.Close(); err != nil {
+		return err
+	}
+	return c.client.Close()
+}
@@ -0,0 +1,29 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/metrics"
+
+	"github.com/hibiken/asynq"
+)
+
+// metricsMiddleware 按任务类型记录处理数、失败数、重试数和耗时
+func metricsMiddleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		taskType := t.Type()
+		if n, _ := asynq.GetRetryCount(ctx); n > 0 {
+			metrics.TasksRetried.WithLabelValues(taskType).Inc()
+		}
+
+		start := time.Now()
+		err := next.ProcessTask(ctx, t)
+		metrics.TaskDuration.WithLabelValues(taskType).Observe(time.Since(start).Seconds())
+		metrics.TasksProcessed.WithLabelValues(taskType).Inc()
+		if err != nil {
+			metrics.TasksFailed.WithLabelValues(taskType).Inc()
+		}
+		return err
+	})
+}
@@ -0,0 +1,115 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Registry 任务处理函数和定时任务注册表
+type Registry struct {
+	defs     TaskTypes
+	handlers map[string]HandleFunc
+	backoffs map[string]Backoff
+	crons    []CronEntry
+	errs     []string
+}
+
+// CronEntry 定时任务
+type CronEntry struct {
+	// ID 定时任务标识,用于生成每次执行的任务ID,为空时使用 cron 表达式
+	ID   string
+	Spec string
+	// Location 计算执行时间使用的时区,为 nil 时使用调度器的时区
+	Location *time.Location
+	Def      TaskDef
+	Payload  proto.Message
+}
+
+func NewRegistry() *Registry {
+	return &Registry{defs: TaskTypes{}, handlers: map[string]HandleFunc{}, backoffs: map[string]Backoff{}}
+}
+
+// Handle 注册任务处理函数,处理函数接收解码后的任务参数
+func Handle[P proto.Message](r *Registry, t *TaskType[P], fn func(context.Context, P) error) {
+	if _, ok := r.handlers[t.Name()]; ok {
+		r.errs = append(r.errs, "duplicate handler for task "+t.Name())
+		return
+	}
+	r.defs[t.Name()] = t
+	r.handlers[t.Name()] = t.handler(fn)
+	if t.opts.Backoff != nil {
+		r.backoffs[t.Name()] = *t.opts.Backoff
+	}
+}
+
+// Cron 注册定时任务,任务类型需要注册处理函数
+func Cron[P proto.Message](r *Registry, spec string, t *TaskType[P], payload P) {
+	r.crons = append(r.crons, CronEntry{Spec: spec, Def: t, Payload: payload})
+}
+
+// Handlers 已注册的任务处理函数,按类型名索引
+func (r *Registry) Handlers() map[string]HandleFunc {
+	return r.handlers
+}
+
+// Crons 已注册的定时任务
+func (r *Registry) Crons() []CronEntry {
+	return r.crons
+}
+
+// Backoffs 单独配置了重试间隔的任务类型,按类型名索引
+func (r *Registry) Backoffs() map[string]Backoff {
+	return r.backoffs
+}
+
+// TaskTypes 已注册处理函数的任务类型
+func (r *Registry) TaskTypes() TaskTypes {
+	return r.defs
+}
+
+// Validate 校验注册表: enum 的每个值都需要注册处理函数,定时任务的类型需要注册处理函数,
+// 任务所在队列需要由任务服务器处理。enum 为 nil 时不校验处理函数是否完整
+func (r *Registry) Validate(enum protoreflect.EnumDescriptor) error {
+	errs := append([]string{}, r.errs...)
+
+	if enum != nil {
+		var missing []string
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			if name := string(values.Get(i).Name()); r.handlers[name] == nil {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			errs = append(errs, "job types without handler: "+strings.Join(missing, ", "))
+		}
+	}
+
+	for _, c := range r.crons {
+		if r.handlers[c.Def.Name()] == nil {
+			errs = append(errs, "cron job "+c.Def.Name()+" does not have any registered handlers")
+		}
+	}
+
+	names := make([]string, 0, len(r.defs))
+	for name := range r.defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := serverQueues[r.defs[name].Queue()]; !ok {
+			errs = append(errs, "task "+name+" uses unknown queue "+r.defs[name].Queue())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("invalid task registry: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRegistry(t *testing.T) {
+	nullValue := structpb.NullValue(0).Descriptor()
+	echo := NewTaskType[*wrapperspb.StringValue]("NULL_VALUE", TaskOptions{})
+
+	r := NewRegistry()
+	if err := r.Validate(nullValue); err == nil || !strings.Contains(err.Error(), "NULL_VALUE") {
+		t.Fatalf("missing handler: %v", err)
+	}
+
+	var got string
+	Handle(r, echo, func(_ context.Context, p *wrapperspb.StringValue) error {
+		got = p.Value
+		return nil
+	})
+	Cron(r, "@every 1m", echo, wrapperspb.String("cron"))
+	if err := r.Validate(nullValue); err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := proto.Marshal(wrapperspb.String("hello"))
+	handler := r.Handlers()["NULL_VALUE"]
+	if err := handler(context.Background(), asynq.NewTask("NULL_VALUE", data)); err != nil || got != "hello" {
+		t.Fatalf("got=%q err=%v", got, err)
+	}
+	if err := handler(context.Background(), asynq.NewTask("NULL_VALUE", []byte{0xff})); !errors.Is(err, asynq.SkipRetry) {
+		t.Fatalf("invalid payload should skip retry: %v", err)
+	}
+
+	Cron(r, "@every 1m", NewTaskType[*wrapperspb.StringValue]("OTHER", TaskOptions{Queue: "unknown"}), nil)
+	if err := r.Validate(nil); err == nil || !strings.Contains(err.Error(), "cron job OTHER") {
+		t.Fatalf("cron without handler: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+// NoJitter 设置为 Backoff.Jitter 时不随机减少重试间隔
+const NoJitter = -1
+
+// DefaultBackoff 默认的重试间隔
+var DefaultBackoff = Backoff{Base: 30 * time.Second, Max: time.Hour, Jitter: 0.2}
+
+// Backoff 指数退避的重试间隔,第 n 次重试前等待 Base*2^n,不超过 Max,
+// 再随机减少不超过 Jitter 比例的时间,避免同时失败的任务同时重试
+type Backoff struct {
+	Base time.Duration
+	Max  time.Duration
+	// Jitter 随机减少的最大比例,取值 (0, 1],为 0 时使用默认值,为 NoJitter 时不随机
+	Jitter float64
+}
+
+// withDefaults 未设置的字段使用 def 的值
+func (b Backoff) withDefaults(def Backoff) Backoff {
+	if b.Base <= 0 {
+		b.Base = def.Base
+	}
+	if b.Max <= 0 {
+		b.Max = def.Max
+	}
+	if b.Jitter == 0 {
+		b.Jitter = def.Jitter
+	}
+	b.Jitter = min(max(b.Jitter, 0), 1)
+	if b.Max < b.Base {
+		b.Max = b.Base
+	}
+	return b
+}
+
+// Delay 返回已重试 n 次的任务下次重试前的等待时间
+func (b Backoff) Delay(n int) time.Duration {
+	d := b.Max
+	if n < 62 {
+		if exp := b.Base << n; exp > 0 && exp < b.Max {
+			d = exp
+		}
+	}
+	if b.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * b.Jitter * float64(d))
+	}
+	return d
+}
+
+// retryDelay 按任务类型的退避策略计算重试间隔,未注册的任务类型使用服务器的默认值
+func (c *Server) retryDelay(n int, _ error, t *asynq.Task) time.Duration {
+	if b, ok := c.backoffs[t.Type()]; ok {
+		return b.Delay(n)
+	}
+	return c.backoff.Delay(n)
+}
+
+// classifyError 用户错误(参数或状态不合法等)重试也不会成功,不再重试直接归档
+func classifyError(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		err := next.ProcessTask(ctx, t)
+		if err != nil && errors.IsUserError(err) && !errors.Is(err, asynq.SkipRetry) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return err
+	})
+}
@@ -0,0 +1,64 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	kerrors "github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	b := Backoff{Base: time.Second, Max: 10 * time.Second}.withDefaults(Backoff{})
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second}
+	for n, d := range want {
+		if got := b.Delay(n); got != d {
+			t.Errorf("Delay(%d) = %v, want %v", n, got, d)
+		}
+	}
+	if got := b.Delay(100); got != 10*time.Second {
+		t.Errorf("Delay(100) = %v, want max", got)
+	}
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := b.Delay(3); got < 4*time.Second || got > 8*time.Second {
+			t.Fatalf("Delay with jitter = %v, want [4s, 8s]", got)
+		}
+	}
+
+	def := Backoff{Base: time.Minute, Jitter: 2}.withDefaults(DefaultBackoff)
+	if def.Max != DefaultBackoff.Max || def.Jitter != 1 {
+		t.Errorf("withDefaults = %+v", def)
+	}
+	if b := (Backoff{Jitter: NoJitter}).withDefaults(DefaultBackoff); b.Jitter != 0 || b.Delay(1) != 2*DefaultBackoff.Base {
+		t.Errorf("NoJitter withDefaults = %+v", b)
+	}
+	if b := (Backoff{}).withDefaults(Backoff{Jitter: NoJitter}); b.Jitter != 0 {
+		t.Errorf("default NoJitter withDefaults = %+v", b)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		err  error
+		skip bool
+	}{
+		{nil, false},
+		{errors.New("timeout"), false},
+		{kerrors.NewUserError("invalid file"), true},
+		{kerrors.Wrap(kerrors.NewUserError("invalid file"), "process"), true},
+	}
+	for _, c := range cases {
+		h := classifyError(asynq.HandlerFunc(func(context.Context, *asynq.Task) error { return c.err }))
+		err := h.ProcessTask(context.Background(), asynq.NewTask("test", nil))
+		if got := errors.Is(err, asynq.SkipRetry); got != c.skip {
+			t.Errorf("%v: skip retry = %v, want %v", c.err, got, c.skip)
+		}
+		if !errors.Is(err, c.err) {
+			t.Errorf("%v: original error lost: %v", c.err, err)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// cronRunRetention 定时任务执行记录的保留时间,保留期内相同的执行不会重复投递
+	cronRunRetention = 24 * time.Hour
+	// cronReloadInterval 定时任务的重新加载间隔,用于没有收到变更通知时兜底
+	cronReloadInterval = time.Minute
+	// cronCatchUpWindow 调度器启动时补投该时间内错过的最近一次执行,覆盖主节点切换的间隔
+	cronCatchUpWindow = time.Minute
+)
+
+// HeaderCronID 定时任务投递的任务头,值为 CronEntry.ID
+const HeaderCronID = "cron_id"
+
+// CronSource 加载定时任务
+type CronSource func(ctx context.Context) ([]CronEntry, error)
+
+// StaticCrons 返回固定的定时任务
+func StaticCrons(entries []CronEntry) CronSource {
+	return func(context.Context) ([]CronEntry, error) { return entries, nil }
+}
+
+// ParseCronSpec 校验 cron 表达式
+func ParseCronSpec(spec string) error {
+	_, err := cron.ParseStandard(spec)
+	return err
+}
+
+// CronID 返回定时任务投递的任务对应的 CronEntry.ID,不是定时任务时返回空
+func CronID(t *asynq.Task) string {
+	return t.Headers()[HeaderCronID]
+}
+
+// Scheduler 定时任务调度器,按 cron 表达式投递任务。
+// 每次执行的任务ID由任务类型、定时任务标识和计划执行时间决定,多个调度器同时投递同一次执行时只有一个生效
+type Scheduler struct {
+	client *Client
+	source CronSource
+	loc    *time.Location
+}
+
+// NewScheduler 创建调度器,loc 为 nil 时使用本地时区
+func NewScheduler(client *Client, source CronSource, loc *time.Location) *Scheduler {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &Scheduler{client: client, source: source, loc: loc}
+}
+
+// Run 调度定时任务直到 ctx 结束。启动时和每次收到 reload 信号时重新加载定时任务,
+// 没有变化的定时任务继续按原计划执行。
+// 启动时补投 cronCatchUpWindow 内错过的最近一次执行,主节点切换期间到期的执行不会丢失,
+// 原主节点已投递时任务ID相同不会重复执行;停止超过 cronCatchUpWindow 时更早的执行不再补投
+func (s *Scheduler) Run(ctx context.Context, reload <-chan struct{}) {
+	var wg sync.WaitGroup
+	running := map[string]context.CancelFunc{}
+	defer func() {
+		for _, cancel := range running {
+			cancel()
+		}
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(cronReloadInterval)
+	defer ticker.Stop()
+	// 只补投启动时已有的定时任务,之后新增或修改的定时任务从下一次执行开始
+	catchUp := time.Now()
+	for {
+		entries, err := s.source(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "failed to load cron jobs: %v", err)
+		} else {
+			s.apply(ctx, &wg, running, entries, catchUp)
+			catchUp = time.Time{}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case _, ok := <-reload:
+			if !ok {
+				reload = nil
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// apply 启动新增和变更的定时任务,停止已删除的定时任务,catchUp 不为零时补投该时间前 cronCatchUpWindow 内错过的最近一次执行
+func (s *Scheduler) apply(ctx context.Context, wg *sync.WaitGroup, running map[string]context.CancelFunc, entries []CronEntry, catchUp time.Time) {
+	keep := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		key, err := e.key()
+		if err != nil {
+			logger.Errorf(ctx, "invalid cron job %s: %v", e.Def.Name(), err)
+			continue
+		}
+		keep[key] = true
+		if running[key] != nil {
+			continue
+		}
+		schedule, err := cron.ParseStandard(e.Spec)
+		if err != nil {
+			logger.Errorf(ctx, "invalid cron spec %q of task %s: %v", e.Spec, e.Def.Name(), err)
+			continue
+		}
+
+		entryCtx, cancel := context.WithCancel(ctx)
+		running[key] = cancel
+		wg.Add(1)
+		go func(e CronEntry) {
+			defer wg.Done()
+			s.run(entryCtx, e, schedule, catchUp)
+		}(e)
+	}
+	for key, cancel := range running {
+		if !keep[key] {
+			cancel()
+			delete(running, key)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, e CronEntry, schedule cron.Schedule, catchUp time.Time) {
+	loc := e.Location
+	if loc == nil {
+		loc = s.loc
+	}
+	if !catchUp.IsZero() {
+		if at := lastRun(schedule, catchUp.In(loc), cronCatchUpWindow); !at.IsZero() {
+			s.enqueue(ctx, e, at)
+		}
+	}
+	next := schedule.Next(time.Now().In(loc))
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		s.enqueue(ctx, e, next)
+		next = schedule.Next(next)
+	}
+}
+
+func (s *Scheduler) enqueue(ctx context.Context, e CronEntry, at time.Time) {
+	opts := append(e.Def.Options(), asynq.TaskID(e.taskID(at)), asynq.Retention(cronRunRetention))
+	task := &Task{TypeName: e.Def.Name(), Payload: e.Payload}
+	if e.ID != "" {
+		task.Headers = map[string]string{HeaderCronID: e.ID}
+	}
+	_, err := s.client.EnqueueTask(ctx, task, opts...)
+	if errors.Is(err, ErrDuplicateTask) {
+		return
+	}
+	if err != nil {
+		logger.Errorf(ctx, "failed to enqueue cron task %s: %v", e.Def.Name(), err)
+	}
+}
+
+// lastRun 返回 now 前 window 内最近一次计划执行的时间,没有时返回零值
+func lastRun(schedule cron.Schedule, now time.Time, window time.Duration) time.Time {
+	var last time.Time
+	for at := schedule.Next(now.Add(-window)); !at.IsZero() && !at.After(now); at = schedule.Next(at) {
+		last = at
+	}
+	return last
+}
+
+// taskID 定时任务某次执行的任务ID
+func (e CronEntry) taskID(at time.Time) string {
+	id := e.ID
+	if id == "" {
+		h := fnv.New32a()
+		h.Write([]byte(e.Spec))
+		id = fmt.Sprintf("%08x", h.Sum32())
+	}
+	return fmt.Sprintf("cron:%s:%s:%d", e.Def.Name(), id, at.Unix())
+}
+
+// key 定时任务的内容标识,内容变化时重新调度
+func (e CronEntry) key() (string, error) {
+	var payload []byte
+	if e.Payload != nil {
+		var err error
+		payload, err = proto.MarshalOptions{Deterministic: true}.Marshal(e.Payload)
+		if err != nil {
+			return "", err
+		}
+	}
+	loc := ""
+	if e.Location != nil {
+		loc = e.Location.String()
+	}
+	return fmt.Sprintf("%s|%s|%s|%s|%x", e.ID, e.Def.Name(), e.Spec, loc, payload), nil
+}
@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCronTaskID(t *testing.T) {
+	def := NewTaskType[*emptypb.Empty]("CLEAN", TaskOptions{})
+	at := time.Date(2024, 1, 1, 0, 10, 0, 0, time.Local)
+
+	daily := CronEntry{Spec: "10 0 * * *", Def: def}
+	if daily.taskID(at) != daily.taskID(at) {
+		t.Fatal("the same run should have the same task id")
+	}
+	if daily.taskID(at) == daily.taskID(at.Add(24*time.Hour)) {
+		t.Fatal("different runs should have different task ids")
+	}
+	if daily.taskID(at) == (CronEntry{Spec: "*/10 * * * *", Def: def}).taskID(at) {
+		t.Fatal("different specs should have different task ids")
+	}
+	if (CronEntry{ID: "1", Spec: "10 0 * * *", Def: def}).taskID(at) == (CronEntry{ID: "2", Spec: "10 0 * * *", Def: def}).taskID(at) {
+		t.Fatal("different cron jobs should have different task ids")
+	}
+
+	if err := ParseCronSpec("bad spec"); err == nil {
+		t.Fatal("invalid spec should be rejected")
+	}
+}
+
+func TestCronLastRun(t *testing.T) {
+	schedule, _ := cron.ParseStandard("*/10 * * * *")
+	now := time.Date(2024, 1, 1, 0, 10, 30, 0, time.Local)
+
+	// 主节点切换期间错过的执行
+	if at := lastRun(schedule, now, time.Minute); !at.Equal(now.Add(-30 * time.Second)) {
+		t.Fatalf("last run=%v", at)
+	}
+	if at := lastRun(schedule, now, 20*time.Second); !at.IsZero() {
+		t.Fatalf("run outside the window should not be caught up: %v", at)
+	}
+	if at := lastRun(schedule, now, time.Hour); !at.Equal(now.Add(-30 * time.Second)) {
+		t.Fatalf("only the latest run should be caught up: %v", at)
+	}
+}
+
+func TestCronEntryKey(t *testing.T) {
+	def := NewTaskType[*wrapperspb.StringValue]("ECHO", TaskOptions{})
+	e := CronEntry{ID: "1", Spec: "* * * * *", Def: def, Payload: wrapperspb.String("a")}
+	key, _ := e.key()
+
+	same := e
+	same.Payload = wrapperspb.String("a")
+	if k, _ := same.key(); k != key {
+		t.Fatal("unchanged entry should keep its key")
+	}
+	changed := e
+	changed.Payload = wrapperspb.String("b")
+	if k, _ := changed.key(); k == key {
+		t.Fatal("payload change should change the key")
+	}
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	changed = e
+	changed.Location = shanghai
+	if k, _ := changed.key(); k == key {
+		t.Fatal("timezone change should change the key")
+	}
+}
+
+func TestSchedulerApply(t *testing.T) {
+	def := NewTaskType[*wrapperspb.StringValue]("ECHO", TaskOptions{})
+	s := NewScheduler(nil, nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	running := map[string]context.CancelFunc{}
+	a := CronEntry{ID: "1", Spec: "0 0 1 1 *", Def: def}
+	b := CronEntry{ID: "2", Spec: "0 0 1 1 *", Def: def}
+	s.apply(ctx, &wg, running, []CronEntry{a, b, {ID: "3", Spec: "bad", Def: def}}, time.Time{})
+	if len(running) != 2 {
+		t.Fatalf("running=%d", len(running))
+	}
+	keyA, _ := a.key()
+	cancelA := running[keyA]
+
+	b.Spec = "0 0 2 1 *"
+	s.apply(ctx, &wg, running, []CronEntry{a, b}, time.Time{})
+	if len(running) != 2 {
+		t.Fatalf("running=%d", len(running))
+	}
+	if fmt.Sprintf("%p", running[keyA]) != fmt.Sprintf("%p", cancelA) {
+		t.Fatal("unchanged entry should not be rescheduled")
+	}
+
+	s.apply(ctx, &wg, running, nil, time.Time{})
+	wg.Wait()
+	if len(running) != 0 {
+		t.Fatalf("running=%d", len(running))
+	}
+}
@@ -0,0 +1,122 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// 任务服务器处理的队列
+const (
+	QueueCritical = "critical"
+	QueueDefault  = "default"
+	QueueLow      = "low"
+)
+
+// TaskOptions 任务类型的默认投递选项
+type TaskOptions struct {
+	// Queue 任务所在队列,为空时使用 QueueDefault
+	Queue string
+	// MaxRetry 最大重试次数,为 0 时使用 asynq 的默认值
+	MaxRetry int
+	// Timeout 单次执行超时时间,为 0 时使用 asynq 的默认值
+	Timeout time.Duration
+	// Unique 任务唯一性的有效期,有效期内相同类型和参数的任务只会投递一次,为 0 时不限制
+	Unique time.Duration
+	// Backoff 重试间隔,为 nil 时使用任务服务器的默认值,未设置的字段同样使用默认值
+	Backoff *Backoff
+}
+
+// TaskDef 任务类型定义,用于按类型名处理任务
+type TaskDef interface {
+	// Name 任务类型名
+	Name() string
+	// Queue 任务所在队列
+	Queue() string
+	// Options 任务的默认投递选项
+	Options() []asynq.Option
+	// NewPayload 创建空的任务参数,用于解码
+	NewPayload() proto.Message
+}
+
+// TaskType 任务类型,P 为任务参数,投递和处理时按 protobuf 编码
+type TaskType[P proto.Message] struct {
+	name string
+	opts TaskOptions
+}
+
+// NewTaskType 创建任务类型
+func NewTaskType[P proto.Message](name string, opts TaskOptions) *TaskType[P] {
+	if opts.Queue == "" {
+		opts.Queue = QueueDefault
+	}
+	return &TaskType[P]{name: name, opts: opts}
+}
+
+// Name implements TaskDef.
+func (t *TaskType[P]) Name() string { return t.name }
+
+// Queue implements TaskDef.
+func (t *TaskType[P]) Queue() string { return t.opts.Queue }
+
+// Options implements TaskDef.
+func (t *TaskType[P]) Options() []asynq.Option {
+	opts := []asynq.Option{asynq.Queue(t.opts.Queue)}
+	if t.opts.MaxRetry > 0 {
+		opts = append(opts, asynq.MaxRetry(t.opts.MaxRetry))
+	}
+	if t.opts.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(t.opts.Timeout))
+	}
+	if t.opts.Unique > 0 {
+		opts = append(opts, asynq.Unique(t.opts.Unique))
+	}
+	return opts
+}
+
+// NewPayload implements TaskDef.
+func (t *TaskType[P]) NewPayload() proto.Message {
+	var p P
+	return p.ProtoReflect().New().Interface()
+}
+
+// Enqueue 投递任务,opts 会覆盖任务类型的默认选项
+func (t *TaskType[P]) Enqueue(ctx context.Context, c *Client, payload P, opts ...asynq.Option) (string, error) {
+	return c.EnqueueTask(ctx, &Task{TypeName: t.name, Payload: payload}, append(t.Options(), opts...)...)
+}
+
+// Decode 解码任务参数
+func (t *TaskType[P]) Decode(data []byte) (P, error) {
+	p := t.NewPayload().(P)
+	if err := proto.Unmarshal(data, p); err != nil {
+		return p, errors.Wrapf(err, "failed to decode payload of task %s", t.name)
+	}
+	return p, nil
+}
+
+// handler 将类型化的处理函数转换为 HandleFunc,参数无法解码时不再重试
+func (t *TaskType[P]) handler(fn func(context.Context, P) error) HandleFunc {
+	return func(ctx context.Context, task *asynq.Task) error {
+		p, err := t.Decode(task.Payload())
+		if err != nil {
+			return fmt.Errorf("%v: %w", err, asynq.SkipRetry)
+		}
+		return fn(ctx, p)
+	}
+}
+
+// TaskTypes 按类型名索引的任务类型
+type TaskTypes map[string]TaskDef
+
+// NewTaskTypes 创建任务类型索引
+func NewTaskTypes(defs ...TaskDef) TaskTypes {
+	types := make(TaskTypes, len(defs))
+	for _, def := range defs {
+		types[def.Name()] = def
+	}
+	return types
+}
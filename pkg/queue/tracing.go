@@ -0,0 +1,63 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/ydssx/kratos-kit/pkg/middleware/kratos"
+	"github.com/ydssx/kratos-kit/pkg/tracing"
+
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const messagingSystem = "asynq"
+
+// newTracedTask 创建任务并将 extra、链路上下文、链路ID和当前用户ID写入任务头
+func newTracedTask(ctx context.Context, typeName string, payload []byte, extra map[string]string) *asynq.Task {
+	headers := make(map[string]string, len(extra))
+	for k, v := range extra {
+		headers[k] = v
+	}
+	kratos.InjectContext(ctx, headers)
+	return asynq.NewTaskWithHeaders(typeName, payload, headers)
+}
+
+// enqueueSpan 创建投递任务的span
+func enqueueSpan(ctx context.Context, typeName string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "asynq enqueue "+typeName,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String(messagingSystem),
+			semconv.MessagingOperationTypePublish,
+			attribute.String("asynq.task.type", typeName),
+		),
+	)
+}
+
+// tracingMiddleware 从任务头恢复链路上下文、链路ID和用户ID,并为任务处理创建span
+func tracingMiddleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		ctx = kratos.ExtractContext(ctx, t.Headers())
+		taskID, _ := asynq.GetTaskID(ctx)
+		queueName, _ := asynq.GetQueueName(ctx)
+		retryCount, _ := asynq.GetRetryCount(ctx)
+		ctx, span := tracing.Start(ctx, "asynq handle "+t.Type(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKey.String(messagingSystem),
+				semconv.MessagingOperationTypeDeliver,
+				semconv.MessagingMessageID(taskID),
+				semconv.MessagingDestinationName(queueName),
+				attribute.String("asynq.task.type", t.Type()),
+				attribute.Int("asynq.task.retry_count", retryCount),
+			),
+		)
+		defer span.End()
+
+		err := next.ProcessTask(ctx, t)
+		tracing.RecordError(span, err)
+		return err
+	})
+}
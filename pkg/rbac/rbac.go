@@ -0,0 +1,125 @@
+// Package rbac 提供基于角色的权限模型及权限判定。
+//
+// 权限由资源和操作组成,如 user:read;角色拥有若干权限,用户拥有若干角色。
+// 资源和操作均支持通配符 *。
+package rbac
+
+import (
+	"sort"
+	"strings"
+)
+
+// Wildcard 匹配任意资源或操作
+const Wildcard = "*"
+
+// Permission 权限,由资源和操作组成
+type Permission struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+// P 创建权限
+func P(resource, action string) Permission {
+	return Permission{Resource: resource, Action: action}
+}
+
+// Parse 解析 resource:action 格式的权限
+func Parse(s string) (Permission, bool) {
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok || resource == "" || action == "" {
+		return Permission{}, false
+	}
+	return Permission{Resource: resource, Action: action}, true
+}
+
+func (p Permission) String() string {
+	return p.Resource + ":" + p.Action
+}
+
+// Match 判断当前权限(可含通配符)是否覆盖所需权限
+func (p Permission) Match(required Permission) bool {
+	return match(p.Resource, required.Resource) && match(p.Action, required.Action)
+}
+
+func match(pattern, s string) bool {
+	return pattern == Wildcard || pattern == s
+}
+
+// Grant 角色授予的权限
+type Grant struct {
+	Role string `json:"role"`
+	Permission
+}
+
+// Policy 用户的权限策略,包含用户拥有的角色及这些角色授予的权限
+type Policy struct {
+	Roles  []string `json:"roles"`
+	Grants []Grant  `json:"grants"`
+}
+
+// Allow 判断策略是否允许所需权限
+func (p *Policy) Allow(required Permission) bool {
+	if p == nil {
+		return false
+	}
+	for _, g := range p.Grants {
+		if g.Match(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole 判断策略中是否包含指定角色
+func (p *Policy) HasRole(role string) bool {
+	if p == nil {
+		return false
+	}
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource 按资源汇总的权限
+type Resource struct {
+	Resource string
+	Actions  []string
+	Roles    []string
+}
+
+// Resources 按资源汇总权限,资源、操作和角色均去重并排序
+func (p *Policy) Resources() []Resource {
+	if p == nil {
+		return nil
+	}
+	type set struct{ actions, roles map[string]struct{} }
+	byResource := make(map[string]*set)
+	for _, g := range p.Grants {
+		s, ok := byResource[g.Resource]
+		if !ok {
+			s = &set{actions: make(map[string]struct{}), roles: make(map[string]struct{})}
+			byResource[g.Resource] = s
+		}
+		s.actions[g.Action] = struct{}{}
+		s.roles[g.Role] = struct{}{}
+	}
+
+	res := make([]Resource, 0, len(byResource))
+	for resource, s := range byResource {
+		res = append(res, Resource{Resource: resource, Actions: sortedKeys(s.actions), Roles: sortedKeys(s.roles)})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Resource < res[j].Resource })
+	return res
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,67 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPolicyAllow(t *testing.T) {
+	p := &Policy{
+		Roles: []string{"user", "auditor"},
+		Grants: []Grant{
+			{Role: "user", Permission: P("user", "read")},
+			{Role: "auditor", Permission: P("audit_log", Wildcard)},
+		},
+	}
+
+	tests := []struct {
+		required Permission
+		want     bool
+	}{
+		{P("user", "read"), true},
+		{P("user", "update"), false},
+		{P("audit_log", "export"), true},
+		{P("session", "read"), false},
+	}
+	for _, tt := range tests {
+		if got := p.Allow(tt.required); got != tt.want {
+			t.Errorf("Allow(%s) = %v, want %v", tt.required, got, tt.want)
+		}
+	}
+
+	admin := &Policy{Grants: []Grant{{Role: "admin", Permission: P(Wildcard, Wildcard)}}}
+	if !admin.Allow(P("anything", "delete")) {
+		t.Error("wildcard grant should allow any permission")
+	}
+	var empty *Policy
+	if empty.Allow(P("user", "read")) {
+		t.Error("nil policy should deny")
+	}
+}
+
+func TestParse(t *testing.T) {
+	if p, ok := Parse("user:read"); !ok || p != P("user", "read") {
+		t.Errorf("Parse(user:read) = %v, %v", p, ok)
+	}
+	for _, s := range []string{"", "user", ":read", "user:"} {
+		if _, ok := Parse(s); ok {
+			t.Errorf("Parse(%q) should fail", s)
+		}
+	}
+}
+
+func TestPolicyResources(t *testing.T) {
+	p := &Policy{Grants: []Grant{
+		{Role: "user", Permission: P("user", "read")},
+		{Role: "editor", Permission: P("user", "update")},
+		{Role: "editor", Permission: P("user", "read")},
+		{Role: "user", Permission: P("session", "read")},
+	}}
+	want := []Resource{
+		{Resource: "session", Actions: []string{"read"}, Roles: []string{"user"}},
+		{Resource: "user", Actions: []string{"read", "update"}, Roles: []string{"editor", "user"}},
+	}
+	if got := p.Resources(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Resources() = %+v, want %+v", got, want)
+	}
+}
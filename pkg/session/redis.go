@@ -0,0 +1,233 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ Store = (*RedisStore)(nil)
+
+const (
+	sessionPrefix = "session:"
+	// touchInterval 最后活跃时间的最小更新间隔,避免每个请求都写一次redis
+	touchInterval = time.Minute
+)
+
+// RedisStore 基于redis的会话存储。
+// 每个会话保存为一个独立的key,同时用一个set维护用户下的会话ID列表。
+type RedisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func sessionKey(userID int64, sid string) string {
+	return fmt.Sprintf("%s%d:%s", sessionPrefix, userID, sid)
+}
+
+func userSessionsKey(userID int64) string {
+	return fmt.Sprintf("%suser:%d", sessionPrefix, userID)
+}
+
+// seenKey 会话最后活跃时间(毫秒时间戳),与会话内容分开保存,更新活跃时间不会覆盖并发轮换的令牌
+func seenKey(userID int64, sid string) string {
+	return sessionKey(userID, sid) + ":seen"
+}
+
+// rotateScript 比较会话当前的刷新令牌jti,一致时保存新的会话内容
+var rotateScript = redis.NewScript(`
+local cur = redis.call('GET', KEYS[1])
+if not cur then
+	return -1
+end
+if cjson.decode(cur).refresh_jti ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+redis.call('SADD', KEYS[2], ARGV[4])
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+redis.call('PEXPIRE', KEYS[3], ARGV[3])
+return 1
+`)
+
+// touchScript 会话存在且距上次更新不少于间隔时更新最后活跃时间,过期时间与会话一致
+var touchScript = redis.NewScript(`
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl <= 0 then
+	return 0
+end
+local last = tonumber(redis.call('GET', KEYS[2]) or '0')
+if tonumber(ARGV[1]) - last < tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[1], 'PX', ttl)
+return 1
+`)
+
+// Save 保存会话,并把会话ID加入用户的会话集合
+func (r *RedisStore) Save(ctx context.Context, s *Session) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "marshal session error")
+	}
+	ttl := time.Until(s.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("session already expired")
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, sessionKey(s.UserID, s.ID), data, ttl)
+	pipe.SAdd(ctx, userSessionsKey(s.UserID), s.ID)
+	// 会话有效期相同,最近保存的会话总是最晚过期,集合的过期时间跟随它即可
+	pipe.Expire(ctx, userSessionsKey(s.UserID), ttl)
+	pipe.Expire(ctx, seenKey(s.UserID, s.ID), ttl)
+	_, err = pipe.Exec(ctx)
+	return errors.Wrap(err, "save session error")
+}
+
+// Rotate 使用 Lua 脚本比较刷新令牌jti并保存,并发刷新同一会话时只有一个请求成功
+func (r *RedisStore) Rotate(ctx context.Context, s *Session, refreshJti string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "marshal session error")
+	}
+	ttl := time.Until(s.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("session already expired")
+	}
+
+	keys := []string{sessionKey(s.UserID, s.ID), userSessionsKey(s.UserID), seenKey(s.UserID, s.ID)}
+	res, err := rotateScript.Run(ctx, r.client, keys, refreshJti, data, ttl.Milliseconds(), s.ID).Int()
+	if err != nil {
+		return errors.Wrap(err, "rotate session error")
+	}
+	switch res {
+	case -1:
+		return ErrSessionNotFound
+	case 0:
+		return ErrRefreshTokenReused
+	}
+	return nil
+}
+
+// Get 获取会话
+func (r *RedisStore) Get(ctx context.Context, userID int64, sid string) (*Session, error) {
+	vals, err := r.client.MGet(ctx, sessionKey(userID, sid), seenKey(userID, sid)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "get session error")
+	}
+	str, ok := vals[0].(string)
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	s := new(Session)
+	if err = json.Unmarshal([]byte(str), s); err != nil {
+		return nil, errors.Wrap(err, "unmarshal session error")
+	}
+	setLastSeen(s, vals[1])
+	return s, nil
+}
+
+// setLastSeen 使用单独保存的最后活跃时间
+func setLastSeen(s *Session, val interface{}) {
+	str, ok := val.(string)
+	if !ok {
+		return
+	}
+	if ms, err := strconv.ParseInt(str, 10, 64); err == nil && ms > s.LastSeenAt.UnixMilli() {
+		s.LastSeenAt = time.UnixMilli(ms)
+	}
+}
+
+// List 获取用户的所有有效会话,顺带清理集合中已过期的会话ID
+func (r *RedisStore) List(ctx context.Context, userID int64) ([]*Session, error) {
+	sids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "list session ids error")
+	}
+	if len(sids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, 2*len(sids))
+	for _, sid := range sids {
+		keys = append(keys, sessionKey(userID, sid))
+	}
+	for _, sid := range sids {
+		keys = append(keys, seenKey(userID, sid))
+	}
+	vals, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "get sessions error")
+	}
+
+	sessions := make([]*Session, 0, len(vals))
+	var expired []interface{}
+	for i, val := range vals[:len(sids)] {
+		str, ok := val.(string)
+		if !ok {
+			expired = append(expired, sids[i])
+			continue
+		}
+		s := new(Session)
+		if err := json.Unmarshal([]byte(str), s); err != nil {
+			continue
+		}
+		setLastSeen(s, vals[len(sids)+i])
+		sessions = append(sessions, s)
+	}
+	if len(expired) > 0 {
+		r.client.SRem(ctx, userSessionsKey(userID), expired...)
+	}
+	return sessions, nil
+}
+
+// Touch 更新会话最后活跃时间,间隔不足 touchInterval 时忽略。
+// 活跃时间单独保存,不读写会话内容,不会与令牌轮换互相覆盖
+func (r *RedisStore) Touch(ctx context.Context, userID int64, sid string, at time.Time) error {
+	keys := []string{sessionKey(userID, sid), seenKey(userID, sid)}
+	err := touchScript.Run(ctx, r.client, keys, at.UnixMilli(), touchInterval.Milliseconds()).Err()
+	return errors.Wrap(err, "touch session error")
+}
+
+// Revoke 撤销指定会话
+func (r *RedisStore) Revoke(ctx context.Context, userID int64, sid string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, sessionKey(userID, sid), seenKey(userID, sid))
+	pipe.SRem(ctx, userSessionsKey(userID), sid)
+	_, err := pipe.Exec(ctx)
+	return errors.Wrap(err, "revoke session error")
+}
+
+// RevokeAll 撤销用户的所有会话,except 中的会话会被保留
+func (r *RedisStore) RevokeAll(ctx context.Context, userID int64, except ...string) error {
+	sids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return errors.Wrap(err, "list session ids error")
+	}
+
+	keep := make(map[string]struct{}, len(except))
+	for _, sid := range except {
+		keep[sid] = struct{}{}
+	}
+
+	pipe := r.client.TxPipeline()
+	for _, sid := range sids {
+		if _, ok := keep[sid]; ok {
+			continue
+		}
+		pipe.Del(ctx, sessionKey(userID, sid), seenKey(userID, sid))
+		pipe.SRem(ctx, userSessionsKey(userID), sid)
+	}
+	_, err = pipe.Exec(ctx)
+	return errors.Wrap(err, "revoke sessions error")
+}
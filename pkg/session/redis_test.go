@@ -0,0 +1,59 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStoreRotate(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+	store := NewRedisStore(rdb)
+	ctx := context.Background()
+
+	now := time.Now()
+	s := &Session{ID: "s1", UserID: 1, AccessJti: "a1", RefreshJti: "r1", LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := store.Save(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+
+	// 更新活跃时间不覆盖会话内容
+	seen := now.Add(2 * touchInterval)
+	if err := store.Touch(ctx, 1, "s1", seen); err != nil {
+		t.Fatal(err)
+	}
+	rotated := *s
+	rotated.AccessJti, rotated.RefreshJti = "a2", "r2"
+	if err := store.Rotate(ctx, &rotated, "r1"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Get(ctx, 1, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.RefreshJti != "r2" || got.AccessJti != "a2" || got.LastSeenAt.UnixMilli() != seen.UnixMilli() {
+		t.Fatalf("got %+v", got)
+	}
+
+	// 同一刷新令牌再次轮换失败
+	again := *s
+	again.RefreshJti = "r3"
+	if err := store.Rotate(ctx, &again, "r1"); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("reused refresh token: %v", err)
+	}
+	if err := store.Revoke(ctx, 1, "s1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Rotate(ctx, &again, "r2"); !errors.Is(err, ErrSessionNotFound) {
+		t.Fatalf("revoked session: %v", err)
+	}
+	if sessions, err := store.List(ctx, 1); err != nil || len(sessions) != 0 {
+		t.Fatalf("list: %v, %v", sessions, err)
+	}
+}
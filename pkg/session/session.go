@@ -0,0 +1,50 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrSessionNotFound 会话不存在(已过期或已被撤销)
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrRefreshTokenReused 会话的刷新令牌已被使用,令牌已轮换
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// Session 登录会话,一次登录对应一个会话,刷新令牌时会话ID保持不变
+type Session struct {
+	ID         string    `json:"id"`            // 会话ID,写入令牌的sid
+	UserID     int64     `json:"user_id"`       // 用户ID
+	AccessJti  string    `json:"access_jti"`    // 当前有效的访问令牌jti
+	RefreshJti string    `json:"refresh_jti"`   // 当前有效的刷新令牌jti
+	Device     string    `json:"device"`        // 设备/平台
+	IP         string    `json:"ip"`            // 登录IP
+	UserAgent  string    `json:"user_agent"`    // User-Agent
+	CreatedAt  time.Time `json:"created_at"`    // 创建时间
+	LastSeenAt time.Time `json:"last_seen_at"`  // 最后活跃时间
+	ExpiresAt  time.Time `json:"expires_at"`    // 过期时间,与刷新令牌一致
+	MFA        bool      `json:"mfa,omitempty"` // 是否通过两步验证登录,刷新令牌时保持不变
+	// ImpersonatorID 管理员以用户身份登录时为管理员ID,此类会话不能刷新令牌
+	ImpersonatorID int64 `json:"impersonator_id,omitempty"`
+}
+
+// Store 会话存储
+type Store interface {
+	// Save 保存会话,过期时间取 ExpiresAt
+	Save(ctx context.Context, s *Session) error
+	// Rotate 会话当前的刷新令牌jti为 refreshJti 时原子地保存轮换后的会话,
+	// 已被轮换时返回 ErrRefreshTokenReused,不存在时返回 ErrSessionNotFound
+	Rotate(ctx context.Context, s *Session, refreshJti string) error
+	// Get 获取会话,不存在时返回 ErrSessionNotFound
+	Get(ctx context.Context, userID int64, sid string) (*Session, error)
+	// List 获取用户的所有有效会话
+	List(ctx context.Context, userID int64) ([]*Session, error)
+	// Touch 更新会话最后活跃时间
+	Touch(ctx context.Context, userID int64, sid string, at time.Time) error
+	// Revoke 撤销指定会话
+	Revoke(ctx context.Context, userID int64, sid string) error
+	// RevokeAll 撤销用户的所有会话,except 中的会话会被保留
+	RevokeAll(ctx context.Context, userID int64, except ...string) error
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+
+	"github.com/ydssx/kratos-kit/pkg/metrics"
 )
 
 // Client represents a connected SSE client
@@ -44,6 +46,9 @@ func (b *Broker) Start(ctx context.Context) {
 			select {
 			case client := <-b.register:
 				b.mu.Lock()
+				if _, ok := b.clients[client.ID]; !ok {
+					metrics.SSEClients.Inc()
+				}
 				b.clients[client.ID] = client
 				b.mu.Unlock()
 
@@ -52,6 +57,7 @@ func (b *Broker) Start(ctx context.Context) {
 				if _, ok := b.clients[client.ID]; ok {
 					delete(b.clients, client.ID)
 					close(client.Messages)
+					metrics.SSEClients.Dec()
 				}
 				b.mu.Unlock()
 
@@ -63,6 +69,7 @@ func (b *Broker) Start(ctx context.Context) {
 				for _, client := range b.clients {
 					close(client.Messages)
 				}
+				metrics.SSEClients.Sub(float64(len(b.clients)))
 				b.clients = make(map[string]*Client)
 				b.mu.Unlock()
 				return
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Composer 支持在服务端按顺序合并多个对象的存储
+type Composer interface {
+	Compose(ctx context.Context, dst string, srcs []string, contentType string) error
+}
+
+// Compose 按顺序将 srcs 合并为 dst,存储支持服务端合并时直接合并,否则依次读取后流式写入
+func Compose(ctx context.Context, s Storage, dst string, srcs []string, contentType string) error {
+	if c, ok := s.(Composer); ok {
+		return c.Compose(ctx, dst, srcs, contentType)
+	}
+
+	r := &multiObjectReader{ctx: ctx, s: s, keys: srcs}
+	defer r.Close()
+	_, err := s.Put(ctx, dst, r, -1, contentType)
+	return err
+}
+
+// multiObjectReader 依次读取多个对象,同一时间只打开一个
+type multiObjectReader struct {
+	ctx  context.Context
+	s    Storage
+	keys []string
+	cur  io.ReadCloser
+}
+
+func (m *multiObjectReader) Read(p []byte) (int, error) {
+	for {
+		if m.cur == nil {
+			if len(m.keys) == 0 {
+				return 0, io.EOF
+			}
+			rc, err := m.s.Open(m.ctx, m.keys[0])
+			if err != nil {
+				return 0, err
+			}
+			m.cur, m.keys = rc, m.keys[1:]
+		}
+		n, err := m.cur.Read(p)
+		if err == io.EOF {
+			m.cur.Close()
+			m.cur = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (m *multiObjectReader) Close() error {
+	if m.cur != nil {
+		return m.cur.Close()
+	}
+	return nil
+}
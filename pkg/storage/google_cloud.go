@@ -2,32 +2,37 @@ package storage
 
 import (
 	"context"
+	"io"
+	"net/url"
 	"path/filepath"
+	"time"
 
 	"github.com/ydssx/kratos-kit/pkg/errors"
 	"github.com/ydssx/kratos-kit/pkg/util"
 
 	"cloud.google.com/go/storage"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
 type GoogleCloudStorage struct {
-	bucket *storage.BucketHandle
-	client *storage.Client
+	bucket     *storage.BucketHandle
+	bucketName string
+	client     *storage.Client
 }
 
-func NewGoogleCloudStorage(bucketName, projectID, credentialsFile string) (s *GoogleCloudStorage, cleanup func()) {
+func NewGoogleCloudStorage(bucketName, projectID, credentialsFile string) (s *GoogleCloudStorage, cleanup func(), err error) {
 	// 创建 Google Cloud Storage 客户端
 	ctx := context.Background()
 	client, err := storage.NewClient(ctx, option.WithCredentialsJSON([]byte(credentialsFile)))
 	if err != nil {
-		log.Fatalf("Failed to create Google Cloud Storage client: %v", err)
+		return nil, nil, errors.Wrap(err, "创建 Google Cloud Storage 客户端失败")
 	}
 	log.Info("Google Cloud Storage client created.")
 	cleanup = func() { client.Close() }
 
-	return &GoogleCloudStorage{bucket: client.Bucket(bucketName), client: client}, cleanup
+	return &GoogleCloudStorage{bucket: client.Bucket(bucketName), bucketName: bucketName, client: client}, cleanup, nil
 }
 
 func (g *GoogleCloudStorage) SaveFile(ctx context.Context, path, filename, contentType string, fileBytes []byte) (string, error) {
@@ -70,13 +75,146 @@ func (g *GoogleCloudStorage) SaveFile(ctx context.Context, path, filename, conte
 	return attrs.MediaLink, nil
 }
 
+// Put 流式上传对象
+func (g *GoogleCloudStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ObjectInfo, error) {
+	wc := g.bucket.Object(key).NewWriter(ctx)
+	if contentType != "" {
+		wc.ContentType = contentType
+	}
+	if _, err := io.Copy(wc, r); err != nil {
+		wc.Close()
+		return nil, errors.Wrap(err, "上传文件失败")
+	}
+	if err := wc.Close(); err != nil {
+		return nil, errors.Wrap(err, "关闭写入器失败")
+	}
+	return gcsObjectInfo(wc.Attrs()), nil
+}
+
+func (g *GoogleCloudStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := g.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, gcsError(err, "读取文件失败")
+	}
+	return r, nil
+}
+
+func (g *GoogleCloudStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	attrs, err := g.bucket.Object(key).Attrs(ctx)
+	if err != nil {
+		return nil, gcsError(err, "获取对象属性失败")
+	}
+	return gcsObjectInfo(attrs), nil
+}
+
+func (g *GoogleCloudStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	it := g.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "列出对象失败")
+		}
+		objects = append(objects, *gcsObjectInfo(attrs))
+	}
+	return objects, nil
+}
+
+func (g *GoogleCloudStorage) Copy(ctx context.Context, src, dst string) error {
+	if _, err := g.bucket.Object(dst).CopierFrom(g.bucket.Object(src)).Run(ctx); err != nil {
+		return gcsError(err, "复制对象失败")
+	}
+	return nil
+}
+
+// gcsMaxComposeSources 单次合并最多的源对象数
+const gcsMaxComposeSources = 32
+
+// Compose 服务端合并对象,超过32个源对象时分批合并到目标对象
+func (g *GoogleCloudStorage) Compose(ctx context.Context, dst string, srcs []string, contentType string) error {
+	if len(srcs) == 0 {
+		return errors.New("no objects to compose")
+	}
+	target := g.bucket.Object(dst)
+	var composed bool
+	for len(srcs) > 0 {
+		var sources []*storage.ObjectHandle
+		if composed {
+			sources = append(sources, target)
+		}
+		n := min(gcsMaxComposeSources-len(sources), len(srcs))
+		for _, key := range srcs[:n] {
+			sources = append(sources, g.bucket.Object(key))
+		}
+		srcs = srcs[n:]
+
+		c := target.ComposerFrom(sources...)
+		c.ContentType = contentType
+		if _, err := c.Run(ctx); err != nil {
+			return gcsError(err, "合并对象失败")
+		}
+		composed = true
+	}
+	return nil
+}
+
 func (g *GoogleCloudStorage) DeleteFile(ctx context.Context, filename string) error {
 	object := g.bucket.Object(filename)
 	err := object.Delete(ctx)
 	if err != nil {
-		return errors.Wrap(err, "删除文件失败")
+		return gcsError(err, "删除文件失败")
 	}
 
 	log.Info("Object deleted successfully. ", "object: ", filename)
 	return nil
 }
+
+// Ping 检查存储桶是否可访问,用于健康检查
+func (g *GoogleCloudStorage) Ping(ctx context.Context) error {
+	if _, err := g.bucket.Attrs(ctx); err != nil {
+		return errors.Wrap(err, "获取存储桶属性失败")
+	}
+	return nil
+}
+
+// URL 返回对象的公开访问地址
+func (g *GoogleCloudStorage) URL(key string) string {
+	return "https://storage.googleapis.com/" + g.bucketName + "/" + (&url.URL{Path: key}).EscapedPath()
+}
+
+// SignedURL 使用服务账号生成V4签名地址
+func (g *GoogleCloudStorage) SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := checkSignMethod(method); err != nil {
+		return "", err
+	}
+	u, err := g.bucket.SignedURL(key, &storage.SignedURLOptions{
+		Method:  method,
+		Expires: time.Now().Add(expires),
+		Scheme:  storage.SigningSchemeV4,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "生成签名地址失败")
+	}
+	return u, nil
+}
+
+func gcsObjectInfo(attrs *storage.ObjectAttrs) *ObjectInfo {
+	return &ObjectInfo{
+		Key:          attrs.Name,
+		Size:         attrs.Size,
+		ContentType:  attrs.ContentType,
+		ETag:         attrs.Etag,
+		LastModified: attrs.Updated,
+	}
+}
+
+// gcsError 将对象不存在转换为 ErrNotExist
+func gcsError(err error, msg string) error {
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return ErrNotExist
+	}
+	return errors.Wrap(err, msg)
+}
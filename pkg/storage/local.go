@@ -0,0 +1,368 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+	"github.com/ydssx/kratos-kit/pkg/util"
+)
+
+// DefaultLocalRoutePrefix 本地存储文件路由的默认前缀
+const DefaultLocalRoutePrefix = "/files"
+
+// localTempDir 写入中的临时文件目录,位于根目录下以便原子重命名
+const localTempDir = ".tmp"
+
+// inlineContentTypes 可以在浏览器中直接打开的内容类型,其他类型作为附件下载,
+// 避免上传的 HTML、SVG 等文件在本站域名下执行脚本
+var inlineContentTypes = map[string]bool{
+	"image/jpeg": true, "image/png": true, "image/gif": true, "image/webp": true, "image/bmp": true,
+	"video/mp4": true, "video/webm": true, "video/quicktime": true, "video/x-m4v": true, "video/ogg": true,
+	"audio/mpeg": true, "audio/wav": true, "audio/ogg": true, "audio/flac": true, "audio/aac": true, "audio/mp4": true,
+}
+
+// LocalConfig 本地磁盘存储配置
+type LocalConfig struct {
+	Root        string // 文件根目录
+	BaseURL     string // 访问文件的外部地址
+	RoutePrefix string // 文件路由前缀
+	SignKey     string // URL签名密钥
+}
+
+// LocalStorage 本地磁盘存储,文件通过带签名的路由访问,签名防止遍历和猜测文件地址
+type LocalStorage struct {
+	root    *os.Root
+	baseURL string
+	prefix  string
+	signKey []byte
+}
+
+func NewLocalStorage(cfg LocalConfig) (*LocalStorage, func(), error) {
+	if cfg.Root == "" {
+		return nil, nil, errors.New("local storage root is required")
+	}
+	if cfg.SignKey == "" {
+		return nil, nil, errors.New("local storage sign_key is required")
+	}
+	if err := os.MkdirAll(cfg.Root, 0o755); err != nil {
+		return nil, nil, errors.Wrap(err, "创建存储目录失败")
+	}
+	root, err := os.OpenRoot(cfg.Root)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "打开存储目录失败")
+	}
+
+	prefix := "/" + strings.Trim(cfg.RoutePrefix, "/")
+	if prefix == "/" {
+		prefix = DefaultLocalRoutePrefix
+	}
+	s := &LocalStorage{
+		root:    root,
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		prefix:  prefix,
+		signKey: []byte(cfg.SignKey),
+	}
+	log.Infof("Local storage opened at %s.", cfg.Root)
+	return s, func() { root.Close() }, nil
+}
+
+func (l *LocalStorage) SaveFile(ctx context.Context, dir, filename, contentType string, fileBytes []byte) (string, error) {
+	key := objectKey(dir, filename, fileBytes)
+
+	// 文件名由内容哈希生成,已存在时直接返回
+	if _, err := l.root.Stat(key); err == nil {
+		return l.URL(key), nil
+	}
+	if _, err := l.Put(ctx, key, bytes.NewReader(fileBytes), int64(len(fileBytes)), contentType); err != nil {
+		return "", err
+	}
+
+	log.Info("Object uploaded successfully. ", "object: ", key)
+	return l.URL(key), nil
+}
+
+// Put 先写入临时目录再重命名,避免并发读取到不完整的文件
+func (l *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ObjectInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if d := path.Dir(key); d != "." {
+		if err := l.root.MkdirAll(d, 0o755); err != nil {
+			return nil, errors.Wrap(err, "创建目录失败")
+		}
+	}
+	if err := l.root.MkdirAll(localTempDir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "创建临时目录失败")
+	}
+
+	tmp := path.Join(localTempDir, fmt.Sprintf("%d-%s", time.Now().UnixNano(), path.Base(key)))
+	f, err := l.root.OpenFile(tmp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, "上传文件失败")
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = l.root.Rename(tmp, key)
+	}
+	if err != nil {
+		l.root.Remove(tmp)
+		return nil, errors.Wrap(err, "上传文件失败")
+	}
+	return l.Stat(ctx, key)
+}
+
+func (l *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, err := l.root.Open(key)
+	if err != nil {
+		return nil, localError(err, "打开文件失败")
+	}
+	if info, err := f.Stat(); err != nil || info.IsDir() {
+		f.Close()
+		return nil, ErrNotExist
+	}
+	return f, nil
+}
+
+func (l *LocalStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	info, err := l.root.Stat(key)
+	if err != nil {
+		return nil, localError(err, "获取文件属性失败")
+	}
+	if info.IsDir() {
+		return nil, ErrNotExist
+	}
+	return l.objectInfo(key, info), nil
+}
+
+// List 遍历 prefix 所在目录,返回以 prefix 开头的文件
+func (l *LocalStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+	dir := "."
+	if i := strings.LastIndex(prefix, "/"); i > 0 {
+		dir = prefix[:i]
+	}
+
+	var objects []ObjectInfo
+	err := fs.WalkDir(l.root.FS(), dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			if p == localTempDir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(p, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, *l.objectInfo(p, info))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "列出文件失败")
+	}
+	return objects, nil
+}
+
+func (l *LocalStorage) Copy(ctx context.Context, src, dst string) error {
+	r, err := l.Open(ctx, src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = l.Put(ctx, dst, r, -1, "")
+	return err
+}
+
+func (l *LocalStorage) DeleteFile(ctx context.Context, filename string) error {
+	if err := l.root.Remove(filename); err != nil {
+		return localError(err, "删除文件失败")
+	}
+
+	log.Info("Object deleted successfully. ", "object: ", filename)
+	return nil
+}
+
+// Ping 检查存储目录是否可访问,用于健康检查
+func (l *LocalStorage) Ping(ctx context.Context) error {
+	if _, err := l.root.Stat("."); err != nil {
+		return errors.Wrap(err, "访问存储目录失败")
+	}
+	return nil
+}
+
+// URL 返回文件的永久访问地址
+func (l *LocalStorage) URL(key string) string {
+	return l.signedURL(http.MethodGet, key, time.Time{})
+}
+
+// SignedURL 返回限时的下载或上传地址,上传地址通过 PUT 请求写入文件内容
+func (l *LocalStorage) SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := checkSignMethod(method); err != nil {
+		return "", err
+	}
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return l.signedURL(method, key, time.Now().Add(expires)), nil
+}
+
+// signedURL 生成带签名的访问地址,expires 为零值时不过期
+func (l *LocalStorage) signedURL(method, key string, expires time.Time) string {
+	q := url.Values{}
+	var exp int64
+	if !expires.IsZero() {
+		exp = expires.Unix()
+		q.Set("expires", strconv.FormatInt(exp, 10))
+	}
+	q.Set("sign", l.sign(method, key, exp))
+	return l.baseURL + l.prefix + "/" + (&url.URL{Path: key}).EscapedPath() + "?" + q.Encode()
+}
+
+func (l *LocalStorage) sign(method, key string, expires int64) string {
+	mac := hmac.New(sha256.New, l.signKey)
+	mac.Write([]byte(method + "\n" + key + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify 校验签名和过期时间,上传地址必须有过期时间
+func (l *LocalStorage) verify(method, key string, q url.Values) bool {
+	var expires int64
+	if s := q.Get("expires"); s != "" {
+		var err error
+		if expires, err = strconv.ParseInt(s, 10, 64); err != nil || time.Now().Unix() > expires {
+			return false
+		}
+	} else if method != http.MethodGet {
+		return false
+	}
+	return hmac.Equal([]byte(q.Get("sign")), []byte(l.sign(method, key, expires)))
+}
+
+func (l *LocalStorage) objectInfo(key string, info fs.FileInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		ContentType:  mime.TypeByExtension(path.Ext(key)),
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+		LastModified: info.ModTime(),
+	}
+}
+
+// RoutePrefix 文件路由前缀
+func (l *LocalStorage) RoutePrefix() string {
+	return l.prefix
+}
+
+// ServeHTTP 校验签名后返回文件内容,或写入签名上传地址的请求体
+func (l *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, l.prefix+"/")
+	if !ok || key == "" || !l.verify(method, key, r.URL.Query()) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		if _, err := l.Put(r.Context(), key, r.Body, r.ContentLength, r.Header.Get("Content-Type")); err != nil {
+			log.Errorf("failed to write %s: %v", key, err)
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	f, err := l.root.Open(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	// 按文件内容识别类型,不使用对象名的扩展名,并禁止浏览器再次猜测
+	contentType := "application/octet-stream"
+	if mt, err := mimetype.DetectReader(f); err == nil {
+		contentType = mt.String()
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", contentType)
+	h.Set("X-Content-Type-Options", "nosniff")
+	if !inlineContentTypes[contentType] {
+		h.Set("Content-Disposition", "attachment")
+	}
+	h.Set("Cache-Control", "private, max-age=3600")
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
+
+// objectKey 使用内容的MD5作为对象名,相同内容只存储一份
+func objectKey(dir, filename string, fileBytes []byte) string {
+	key := util.MD5Bytes(fileBytes) + path.Ext(filename)
+	if dir != "" {
+		key = path.Join(dir, key)
+	}
+	return strings.TrimPrefix(key, "/")
+}
+
+// cleanKey 规范化对象名,拒绝目录穿越和临时目录
+func cleanKey(key string) (string, error) {
+	key = path.Clean("/" + key)[1:]
+	if key == "" || key == localTempDir || strings.HasPrefix(key, localTempDir+"/") {
+		return "", errors.Errorf("invalid object key: %q", key)
+	}
+	return key, nil
+}
+
+// localError 将文件不存在转换为 ErrNotExist
+func localError(err error, msg string) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotExist
+	}
+	return errors.Wrap(err, msg)
+}
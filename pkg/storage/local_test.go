@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStorage(t *testing.T) {
+	s, cleanup, err := NewLocalStorage(LocalConfig{Root: t.TempDir(), BaseURL: "http://example.com", SignKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	link, err := s.SaveFile(ctx, "avatar", "a.txt", "text/plain", []byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(link, "http://example.com/files/avatar/") {
+		t.Fatalf("unexpected url: %s", link)
+	}
+	if err := s.Ping(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		return w
+	}
+
+	path := strings.TrimPrefix(link, "http://example.com")
+	if w := get(path); w.Code != http.StatusOK || w.Body.String() != "hello" {
+		t.Fatalf("signed url: code=%d body=%q", w.Code, w.Body.String())
+	}
+	if w := get(path); w.Header().Get("X-Content-Type-Options") != "nosniff" || w.Header().Get("Content-Disposition") != "attachment" {
+		t.Fatalf("non-media file should be downloaded: %v", w.Header())
+	}
+
+	// 按内容识别类型,HTML 文件即使使用图片扩展名也不会作为网页打开
+	html, err := s.SaveFile(ctx, "media", "x.gif", "image/gif", []byte("<html><script>alert(1)</script></html>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := get(strings.TrimPrefix(html, "http://example.com")); !strings.HasPrefix(w.Header().Get("Content-Type"), "text/html") || w.Header().Get("Content-Disposition") != "attachment" {
+		t.Fatalf("html served as %v", w.Header())
+	}
+	gif, err := s.SaveFile(ctx, "media", "x.html", "text/html", []byte("GIF89a\x01\x00\x01\x00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := get(strings.TrimPrefix(gif, "http://example.com")); w.Header().Get("Content-Type") != "image/gif" || w.Header().Get("Content-Disposition") != "" {
+		t.Fatalf("gif served as %v", w.Header())
+	}
+
+	if w := get(path + "x"); w.Code != http.StatusForbidden {
+		t.Fatalf("tampered signature: code=%d", w.Code)
+	}
+	if w := get("/files/../../etc/passwd?sign=" + s.sign(http.MethodGet, "../../etc/passwd", 0)); w.Code != http.StatusNotFound {
+		t.Fatalf("path traversal: code=%d", w.Code)
+	}
+
+	key := strings.TrimPrefix(strings.SplitN(path, "?", 2)[0], "/files/")
+	expired := strings.TrimPrefix(s.signedURL(http.MethodGet, key, time.Now().Add(-time.Minute)), "http://example.com")
+	if w := get(expired); w.Code != http.StatusForbidden {
+		t.Fatalf("expired url: code=%d", w.Code)
+	}
+
+	put, err := s.SignedURL(ctx, http.MethodPut, "avatar/b.txt", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodPut, strings.TrimPrefix(put, "http://example.com"), strings.NewReader("world")))
+	if w.Code != http.StatusOK {
+		t.Fatalf("signed put: code=%d", w.Code)
+	}
+	if err := s.Copy(ctx, "avatar/b.txt", "avatar/c.txt"); err != nil {
+		t.Fatal(err)
+	}
+	objects, err := s.List(ctx, "avatar/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 3 {
+		t.Fatalf("list: got %d objects, want 3", len(objects))
+	}
+	if info, err := s.Stat(ctx, "avatar/c.txt"); err != nil || info.Size != 5 {
+		t.Fatalf("stat: %+v, %v", info, err)
+	}
+
+	if err := s.DeleteFile(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if w := get(path); w.Code != http.StatusNotFound {
+		t.Fatalf("deleted file: code=%d", w.Code)
+	}
+	if _, err := s.Stat(ctx, key); !errors.Is(err, ErrNotExist) {
+		t.Fatalf("stat deleted file: %v", err)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	s, cleanup, err := NewLocalStorage(LocalConfig{Root: t.TempDir(), SignKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	for i, part := range []string{"hello ", "", "world"} {
+		if _, err := s.Put(ctx, fmt.Sprintf("parts/%d", i), strings.NewReader(part), int64(len(part)), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Compose(ctx, s, "out.txt", []string{"parts/0", "parts/1", "parts/2"}, "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+	r, err := s.Open(ctx, "out.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	b, _ := io.ReadAll(r)
+	if string(b) != "hello world" {
+		t.Fatalf("composed content = %q", b)
+	}
+
+	if err := Compose(ctx, s, "missing.txt", []string{"parts/0", "parts/9"}, ""); !errors.Is(err, ErrNotExist) {
+		t.Fatalf("compose missing part: %v", err)
+	}
+}
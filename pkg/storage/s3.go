@@ -0,0 +1,216 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+// S3Config S3兼容存储配置
+type S3Config struct {
+	Endpoint  string // 服务地址,不带协议
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	PathStyle bool   // 使用路径风格访问存储桶,MinIO 需要开启
+	PublicURL string // 对外访问地址,为空时使用 endpoint/bucket
+}
+
+// S3Storage S3兼容存储,支持 AWS S3、MinIO 等
+type S3Storage struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+func NewS3Storage(cfg S3Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 endpoint and bucket are required")
+	}
+	lookup := minio.BucketLookupAuto
+	if cfg.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:       cfg.UseSSL,
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "创建S3客户端失败")
+	}
+	log.Info("S3 storage client created.")
+
+	publicURL := strings.TrimRight(cfg.PublicURL, "/")
+	if publicURL == "" {
+		publicURL = client.EndpointURL().String() + "/" + cfg.Bucket
+	}
+	return &S3Storage{client: client, bucket: cfg.Bucket, publicURL: publicURL}, nil
+}
+
+func (s *S3Storage) SaveFile(ctx context.Context, dir, filename, contentType string, fileBytes []byte) (string, error) {
+	key := objectKey(dir, filename, fileBytes)
+
+	// 检查对象是否存在
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err == nil {
+		return s.URL(key), nil
+	}
+	if _, err := s.Put(ctx, key, bytes.NewReader(fileBytes), int64(len(fileBytes)), contentType); err != nil {
+		return "", err
+	}
+
+	log.Info("Object uploaded successfully. ", "object: ", key)
+	return s.URL(key), nil
+}
+
+// Put 流式上传,size 为 -1 时使用分片上传
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ObjectInfo, error) {
+	info, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return nil, errors.Wrap(err, "上传文件失败")
+	}
+	return &ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  contentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s3Error(err, "读取文件失败")
+	}
+	// GetObject 不会发送请求,先获取属性以便及时返回对象不存在
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, s3Error(err, "读取文件失败")
+	}
+	return obj, nil
+}
+
+func (s *S3Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s3Error(err, "获取对象属性失败")
+	}
+	return s3ObjectInfo(info), nil
+}
+
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	for info := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if info.Err != nil {
+			return nil, errors.Wrap(info.Err, "列出对象失败")
+		}
+		objects = append(objects, *s3ObjectInfo(info))
+	}
+	return objects, nil
+}
+
+func (s *S3Storage) Copy(ctx context.Context, src, dst string) error {
+	_, err := s.client.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: s.bucket, Object: dst},
+		minio.CopySrcOptions{Bucket: s.bucket, Object: src},
+	)
+	if err != nil {
+		return s3Error(err, "复制对象失败")
+	}
+	return nil
+}
+
+// Compose 服务端合并对象,除最后一个外每个源对象不能小于5MB
+func (s *S3Storage) Compose(ctx context.Context, dst string, srcs []string, contentType string) error {
+	sources := make([]minio.CopySrcOptions, 0, len(srcs))
+	for _, key := range srcs {
+		sources = append(sources, minio.CopySrcOptions{Bucket: s.bucket, Object: key})
+	}
+	_, err := s.client.ComposeObject(ctx, minio.CopyDestOptions{
+		Bucket:          s.bucket,
+		Object:          dst,
+		UserMetadata:    map[string]string{"Content-Type": contentType},
+		ReplaceMetadata: contentType != "",
+	}, sources...)
+	if err != nil {
+		return s3Error(err, "合并对象失败")
+	}
+	return nil
+}
+
+func (s *S3Storage) DeleteFile(ctx context.Context, filename string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, filename, minio.RemoveObjectOptions{}); err != nil {
+		return errors.Wrap(err, "删除文件失败")
+	}
+
+	log.Info("Object deleted successfully. ", "object: ", filename)
+	return nil
+}
+
+// Ping 检查存储桶是否可访问,用于健康检查
+func (s *S3Storage) Ping(ctx context.Context) error {
+	ok, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return errors.Wrap(err, "访问存储桶失败")
+	}
+	if !ok {
+		return errors.Errorf("存储桶 %s 不存在", s.bucket)
+	}
+	return nil
+}
+
+// URL 返回对象的访问地址
+func (s *S3Storage) URL(key string) string {
+	return s.publicURL + "/" + (&url.URL{Path: key}).EscapedPath()
+}
+
+// SignedURL 返回预签名的下载或上传地址
+func (s *S3Storage) SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error) {
+	if err := checkSignMethod(method); err != nil {
+		return "", err
+	}
+	var (
+		u   *url.URL
+		err error
+	)
+	if method == http.MethodPut {
+		u, err = s.client.PresignedPutObject(ctx, s.bucket, key, expires)
+	} else {
+		u, err = s.client.PresignedGetObject(ctx, s.bucket, key, expires, nil)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "生成签名地址失败")
+	}
+	return u.String(), nil
+}
+
+func s3ObjectInfo(info minio.ObjectInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}
+}
+
+// s3Error 将对象不存在转换为 ErrNotExist
+func s3Error(err error, msg string) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrNotExist
+	}
+	return errors.Wrap(err, msg)
+}
@@ -1,8 +1,56 @@
 package storage
 
-import "context"
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ydssx/kratos-kit/pkg/errors"
+)
+
+// ErrNotExist 对象不存在
+var ErrNotExist = errors.New("object does not exist")
+
+// ObjectInfo 对象属性
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
 
 type Storage interface {
+	// SaveFile 以内容MD5作为对象名保存到 path 下,相同内容只存储一份,返回访问地址
 	SaveFile(ctx context.Context, path, filename, contentType string, fileBytes []byte) (string, error)
+	// Put 流式写入对象,size 未知时传 -1
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ObjectInfo, error)
+	// Open 读取对象内容,调用方负责关闭
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Stat 获取对象属性,对象不存在时返回 ErrNotExist
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// List 列出以 prefix 开头的对象
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// Copy 复制对象
+	Copy(ctx context.Context, src, dst string) error
 	DeleteFile(ctx context.Context, filename string) error
+	// URL 返回对象的永久访问地址
+	URL(key string) string
+	// SignedURL 返回有效期为 expires 的签名地址,method 为 GET 或 PUT
+	SignedURL(ctx context.Context, method, key string, expires time.Duration) (string, error)
+}
+
+// Servable 需要由本服务的HTTP路由提供文件访问的存储,如本地磁盘
+type Servable interface {
+	http.Handler
+	RoutePrefix() string
+}
+
+// checkSignMethod 签名地址只支持下载和上传
+func checkSignMethod(method string) error {
+	if method != http.MethodGet && method != http.MethodPut {
+		return errors.Errorf("unsupported signed url method: %s", method)
+	}
+	return nil
 }
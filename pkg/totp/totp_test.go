@@ -0,0 +1,63 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录B的SHA1测试密钥 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeAt(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := CodeAt(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("CodeAt(%d) error = %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("CodeAt(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	now := time.Now()
+
+	code, _ := CodeAt(secret, Step(now)-1)
+	step, ok := Validate(secret, code, now)
+	if !ok || step != Step(now)-1 {
+		t.Errorf("Validate() = %d, %v, want previous step accepted", step, ok)
+	}
+
+	code, _ = CodeAt(secret, Step(now)-3)
+	if _, ok := Validate(secret, code, now); ok {
+		t.Error("Validate() accepted a code outside the skew window")
+	}
+	if _, ok := Validate(secret, "12345", now); ok {
+		t.Error("Validate() accepted a short code")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("kratos kit", "a@b.com", rfcSecret)
+	if !strings.HasPrefix(uri, "otpauth://totp/kratos%20kit:a@b.com?") {
+		t.Errorf("URI() = %s", uri)
+	}
+	if !strings.Contains(uri, "secret="+rfcSecret) {
+		t.Errorf("URI() missing secret: %s", uri)
+	}
+}
@@ -0,0 +1,159 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/ydssx/kratos-kit/pkg/errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName 本项目创建span使用的 tracer 名称
+const InstrumentationName = "github.com/ydssx/kratos-kit"
+
+// 导出器类型
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+)
+
+// Config 链路追踪配置
+type Config struct {
+	ServiceName string
+	Environment string
+	Enable      bool
+	Exporter    string            // otlp(默认) | stdout | file
+	Endpoint    string            // OTLP gRPC 地址
+	Insecure    bool              // OTLP 不使用TLS
+	Headers     map[string]string // OTLP 请求头
+	SampleRatio float64           // 采样率,0 时全部采样
+	FilePath    string            // file 导出器的文件路径
+}
+
+// Setup 设置全局的 W3C traceparent/baggage 传播器和 TracerProvider,返回的函数用于导出剩余的span并关闭导出器。
+// 未启用时只设置传播器,上游的链路上下文仍会透传到下游。
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enable {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeExporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(cfg.ServiceName),
+		attribute.String("deployment.environment", cfg.Environment),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tracing resource")
+	}
+
+	ratio := cfg.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closeExporter != nil {
+			if cerr := closeExporter(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, func() error, error) {
+	switch cfg.Exporter {
+	case "", ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+		exp, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create otlp trace exporter")
+		}
+		return exp, nil, nil
+	case ExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create stdout trace exporter")
+		}
+		return exp, nil, nil
+	case ExporterFile:
+		if cfg.FilePath == "" {
+			return nil, nil, errors.New("tracing file_path is required for file exporter")
+		}
+		f, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to open trace file")
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, errors.Wrap(err, "failed to create file trace exporter")
+		}
+		return exp, f.Close, nil
+	default:
+		return nil, nil, errors.Errorf("unknown tracing exporter: %s", cfg.Exporter)
+	}
+}
+
+// Tracer 返回本项目使用的 tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Start 创建一个span
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// Recording 上下文中是否有正在记录的span,用于数据库、缓存等调用只在请求链路中创建子span
+func Recording(ctx context.Context) bool {
+	return trace.SpanFromContext(ctx).IsRecording()
+}
+
+// RecordError 记录错误并将span状态设为Error
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Inject 将链路上下文写入 carrier,用于消息头、任务头等
+func Inject(ctx context.Context, carrier map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(carrier))
+}
+
+// Extract 从 carrier 中恢复链路上下文
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
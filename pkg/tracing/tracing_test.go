@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectExtract(t *testing.T) {
+	if _, err := Setup(context.Background(), Config{}); err != nil {
+		t.Fatal(err)
+	}
+	tp := sdktrace.NewTracerProvider()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "parent")
+	defer span.End()
+
+	carrier := make(map[string]string)
+	Inject(ctx, carrier)
+	if carrier["traceparent"] == "" {
+		t.Fatalf("traceparent not injected: %v", carrier)
+	}
+
+	got := trace.SpanContextFromContext(Extract(context.Background(), carrier))
+	if got.TraceID() != span.SpanContext().TraceID() || got.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("Extract() = %v, want %v", got, span.SpanContext())
+	}
+	if !got.IsRemote() {
+		t.Error("extracted span context should be remote")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -55,6 +56,15 @@ func MD5Bytes(data []byte) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// MD5Reader 流式计算 r 中全部内容的MD5,用于大文件
+func MD5Reader(r io.Reader) (string, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // IsChinese checks if the given string contains only Chinese characters.
 //
 // Parameter:
@@ -2,6 +2,7 @@ package util
 
 import (
 	"regexp"
+	"strings"
 )
 
 func Mask(str string, start, end int) string {
@@ -66,3 +67,48 @@ func MaskIDCard(idCard string) string {
 		return s[:6] + Mask(s[6:], 1, len(s[6:])-1)
 	})
 }
+
+// sensitiveKeys 敏感字段名,字段名包含其中任意一项即视为敏感字段
+var sensitiveKeys = []string{"password", "secret", "token", "private_key", "recovery_code", "verification_code", "authorization"}
+
+// IsSensitiveKey 判断字段名是否为敏感字段,如密码、密钥、令牌、验证码
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if key == "code" || key == "otp" {
+		return true
+	}
+	for _, k := range sensitiveKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskSensitive 递归脱敏JSON对象中敏感字段的值,返回新的对象
+func MaskSensitive(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if s, ok := item.(string); ok && IsSensitiveKey(k) {
+				res[k] = Mask(s, 0, len(s))
+				continue
+			}
+			if item != nil && IsSensitiveKey(k) {
+				res[k] = "******"
+				continue
+			}
+			res[k] = MaskSensitive(item)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(val))
+		for i, item := range val {
+			res[i] = MaskSensitive(item)
+		}
+		return res
+	default:
+		return v
+	}
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaskSensitive(t *testing.T) {
+	in := map[string]interface{}{
+		"id":           "42",
+		"password":     "hunter2",
+		"country_code": "US",
+		"code":         "123456",
+		"user": map[string]interface{}{
+			"refresh_token": "abc",
+			"email":         "alice@example.com",
+		},
+		"items": []interface{}{map[string]interface{}{"client_secret": 1.0}},
+	}
+	want := map[string]interface{}{
+		"id":           "42",
+		"password":     "*******",
+		"country_code": "US",
+		"code":         "******",
+		"user": map[string]interface{}{
+			"refresh_token": "***",
+			"email":         "alice@example.com",
+		},
+		"items": []interface{}{map[string]interface{}{"client_secret": "******"}},
+	}
+	if got := MaskSensitive(in); !reflect.DeepEqual(got, want) {
+		t.Errorf("MaskSensitive() = %v, want %v", got, want)
+	}
+	if in["password"] != "hunter2" {
+		t.Error("MaskSensitive() modified the input")
+	}
+}
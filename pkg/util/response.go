@@ -47,6 +47,8 @@ func FailWithMsg(c *gin.Context, msg string) {
 }
 
 func FailWithError(c *gin.Context, err error) {
+	// 记录到上下文,便于中间件获取请求结果
+	_ = c.Error(err)
 	se := errors.FromError(err)
 	if se.Code != errors.UnknownCode {
 		result(c, http.StatusOK, int(se.Code), nil, se.Message, se.Reason)
@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	"context"
 	"os"
 
 	"github.com/ydssx/kratos-kit/pkg/http"
@@ -41,15 +42,20 @@ func NewWebhook(url string) *Webhook {
 }
 
 func (w *Webhook) SendMessage(msg string) error {
+	return w.SendMessageContext(context.Background(), msg)
+}
+
+// SendMessageContext 发送消息,ctx 用于取消请求和传递链路追踪信息
+func (w *Webhook) SendMessageContext(ctx context.Context, msg string) error {
 	switch {
 	case w.DingDingWebhook != "":
-		return w.sendDingDingMessage(msg)
+		return w.sendDingDingMessage(ctx, msg)
 	case w.WeChatWebhook != "":
-		return w.sendWeChatMessage(msg)
+		return w.sendWeChatMessage(ctx, msg)
 	case w.FeiShuWebhook != "":
-		return w.sendFeiShuMessage(msg)
+		return w.sendFeiShuMessage(ctx, msg)
 	case w.WeChatAppWebhook != "":
-		return w.sendWeChatAppMessage(msg)
+		return w.sendWeChatAppMessage(ctx, msg)
 	default:
 		return nil
 	}
@@ -59,21 +65,26 @@ func (w *Webhook) SendMessage(msg string) error {
 // msg 为消息内容,atMobiles 为要@的用户手机号列表。
 // 根据配置的不同,会调用不同机器人接口发送消息。
 func (w *Webhook) SendMessageWithAt(msg string, atMobiles ...string) error {
+	return w.SendMessageWithAtContext(context.Background(), msg, atMobiles...)
+}
+
+// SendMessageWithAtContext 发送@指定用户的消息,ctx 用于取消请求和传递链路追踪信息
+func (w *Webhook) SendMessageWithAtContext(ctx context.Context, msg string, atMobiles ...string) error {
 	switch {
 	case w.DingDingWebhook != "":
-		return w.sendDingDingMessageWithAt(msg, atMobiles...)
+		return w.sendDingDingMessageWithAt(ctx, msg, atMobiles...)
 	case w.WeChatWebhook != "":
-		return w.sendWeChatMessageWithAt(msg, atMobiles...)
+		return w.sendWeChatMessageWithAt(ctx, msg, atMobiles...)
 	case w.FeiShuWebhook != "":
-		return w.sendFeiShuMessageWithAt(msg, atMobiles...)
+		return w.sendFeiShuMessageWithAt(ctx, msg, atMobiles...)
 	case w.WeChatAppWebhook != "":
-		return w.sendWeChatAppMessageWithAt(msg, atMobiles...)
+		return w.sendWeChatAppMessageWithAt(ctx, msg, atMobiles...)
 	default:
 		return nil
 	}
 }
 
-func (w *Webhook) sendDingDingMessage(message string) error {
+func (w *Webhook) sendDingDingMessage(ctx context.Context, message string) error {
 	// 发送钉钉消息
 	// 构建消息内容
 	// message := fmt.Sprintf("级别：%s\n时间：%s\n：%s\n堆栈：%s", level, timestamp, errorMessage, stackTrace)
@@ -85,41 +96,41 @@ func (w *Webhook) sendDingDingMessage(message string) error {
 		},
 	}
 	var result interface{}
-	err := http.NewRequest().Post(w.DingDingWebhook, msg, &result)
+	err := http.NewRequest().WithContext(ctx).Post(w.DingDingWebhook, msg, &result)
 	return err
 }
 
-func (w *Webhook) sendWeChatMessage(msg string) error {
+func (w *Webhook) sendWeChatMessage(ctx context.Context, msg string) error {
 	// 发送微信消息
 	return nil
 }
 
-func (w *Webhook) sendFeiShuMessage(msg string) error {
+func (w *Webhook) sendFeiShuMessage(ctx context.Context, msg string) error {
 	// 发送飞书消息
 	return nil
 }
 
-func (w *Webhook) sendWeChatAppMessage(msg string) error {
+func (w *Webhook) sendWeChatAppMessage(ctx context.Context, msg string) error {
 	// 发送企业微信消息
 	return nil
 }
 
-func (w *Webhook) sendWeChatMessageWithAt(msg string, atMobiles ...string) error {
+func (w *Webhook) sendWeChatMessageWithAt(ctx context.Context, msg string, atMobiles ...string) error {
 	// 发送微信消息
 	return nil
 }
 
-func (w *Webhook) sendFeiShuMessageWithAt(msg string, atMobiles ...string) error {
+func (w *Webhook) sendFeiShuMessageWithAt(ctx context.Context, msg string, atMobiles ...string) error {
 	// 发送飞书消息
 	return nil
 }
 
-func (w *Webhook) sendWeChatAppMessageWithAt(msg string, atMobiles ...string) error {
+func (w *Webhook) sendWeChatAppMessageWithAt(ctx context.Context, msg string, atMobiles ...string) error {
 	// 发送企业微信消息
 	return nil
 }
 
-func (w *Webhook) sendDingDingMessageWithAt(msg string, atMobiles ...string) error {
+func (w *Webhook) sendDingDingMessageWithAt(ctx context.Context, msg string, atMobiles ...string) error {
 	// 发送钉钉消息
 	return nil
 }
@@ -0,0 +1,23 @@
+-- 第三方账号关联表,替代 users.google_id
+CREATE TABLE IF NOT EXISTS `user_identities` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `created_at` datetime(3) DEFAULT NULL,
+  `updated_at` datetime(3) DEFAULT NULL,
+  `user_id` bigint unsigned NOT NULL COMMENT '用户ID',
+  `provider` varchar(32) NOT NULL COMMENT '提供方,如google/github/apple',
+  `subject` varchar(255) NOT NULL COMMENT '第三方账号唯一标识',
+  `email` varchar(255) DEFAULT NULL COMMENT '第三方账号邮箱',
+  `name` varchar(255) DEFAULT NULL COMMENT '第三方账号昵称',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_provider_subject` (`provider`, `subject`),
+  KEY `idx_user_identities_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='第三方账号关联表';
+
+-- 回填已有的 Google 登录用户,Google 账号的 id 即 OIDC 的 sub,可重复执行
+INSERT IGNORE INTO `user_identities` (`created_at`, `updated_at`, `user_id`, `provider`, `subject`, `email`, `name`)
+SELECT NOW(3), NOW(3), `id`, 'google', `google_id`, `email`, `username`
+FROM `users`
+WHERE `google_id` IS NOT NULL AND `google_id` <> '' AND `deleted_at` IS NULL;
+
+-- 确认回填完成且新版本上线后再删除旧字段:
+-- ALTER TABLE `users` DROP COLUMN `google_id`;